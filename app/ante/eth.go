@@ -20,6 +20,8 @@ import (
 // EVMKeeper defines the expected keeper interface used on the Eth AnteHandler
 type EVMKeeper interface {
 	GetParams(ctx sdk.Context) evmtypes.Params
+	IsDeployerInWhitelist(ctx sdk.Context, deployerAddr sdk.AccAddress) bool
+	IsContractInBlockedList(ctx sdk.Context, contractAddr sdk.AccAddress) bool
 }
 
 // EthSetupContextDecorator sets the infinite GasMeter in the Context and wraps
@@ -157,6 +159,46 @@ func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, s
 	return next(ctx, msgEthTx, simulate)
 }
 
+// ContractPermissionDecorator rejects the contract deployment from the accounts which aren't in the contract
+// deployment whitelist if the deployment is disabled, and the calls to the contracts in the contract blocked list.
+// NOTE: This should only be run during a CheckTx mode, the permission is checked again in the state transition.
+type ContractPermissionDecorator struct {
+	evmKeeper EVMKeeper
+}
+
+// NewContractPermissionDecorator creates a new ContractPermissionDecorator
+func NewContractPermissionDecorator(ek EVMKeeper) ContractPermissionDecorator {
+	return ContractPermissionDecorator{
+		evmKeeper: ek,
+	}
+}
+
+// AnteHandle checks the permission of contract deployment or contract call
+func (cpd ContractPermissionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if !ctx.IsCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	msgEthTx, ok := tx.(evmtypes.MsgEthereumTx)
+	if !ok {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "invalid transaction type: %T", tx)
+	}
+
+	if recipient := msgEthTx.To(); recipient != nil {
+		if cpd.evmKeeper.IsContractInBlockedList(ctx, recipient.Bytes()) {
+			return ctx, sdkerrors.Wrapf(evmtypes.ErrCallBlockedContract, "contract %s", recipient.Hex())
+		}
+	} else if !cpd.evmKeeper.GetParams(ctx).EnableCreate {
+		// sender address should be in the tx cache from the previous AnteHandle call
+		sender := msgEthTx.From()
+		if !cpd.evmKeeper.IsDeployerInWhitelist(ctx, sender) {
+			return ctx, sdkerrors.Wrapf(evmtypes.ErrUnauthorizedAccount, "sender %s", sender)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
 // AccountVerificationDecorator validates an account balance checks
 type AccountVerificationDecorator struct {
 	ak        auth.AccountKeeper
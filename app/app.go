@@ -19,12 +19,17 @@ import (
 	"github.com/okex/okexchain/x/dex"
 	dexclient "github.com/okex/okexchain/x/dex/client"
 	distr "github.com/okex/okexchain/x/distribution"
+	"github.com/okex/okexchain/x/erc20"
+	erc20client "github.com/okex/okexchain/x/erc20/client"
 	"github.com/okex/okexchain/x/evidence"
 	"github.com/okex/okexchain/x/evm"
+	evmclient "github.com/okex/okexchain/x/evm/client"
+	"github.com/okex/okexchain/x/evm/precompile"
 	"github.com/okex/okexchain/x/farm"
 	farmclient "github.com/okex/okexchain/x/farm/client"
 	"github.com/okex/okexchain/x/genutil"
 	"github.com/okex/okexchain/x/gov"
+	govclient "github.com/okex/okexchain/x/gov/client"
 	"github.com/okex/okexchain/x/gov/keeper"
 	"github.com/okex/okexchain/x/order"
 	"github.com/okex/okexchain/x/params"
@@ -47,6 +52,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	"github.com/spf13/viper"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
@@ -83,8 +89,13 @@ var (
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
-			paramsclient.ProposalHandler, distr.ProposalHandler,
+			paramsclient.ProposalHandler, paramsclient.RevertProposalHandler, distr.ProposalHandler,
+			distr.GrantProposalHandler, distr.GrantReleaseProposalHandler, distr.GrantCancelProposalHandler,
 			dexclient.DelistProposalHandler, farmclient.ManageWhiteListProposalHandler,
+			evmclient.ManageContractDeploymentWhitelistProposalHandler,
+			evmclient.ManageContractBlockedListProposalHandler,
+			erc20client.TokenMappingProposalHandler,
+			govclient.ExecuteMsgsProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -101,6 +112,7 @@ var (
 		debug.AppModuleBasic{},
 		ammswap.AppModuleBasic{},
 		farm.AppModuleBasic{},
+		erc20.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -119,6 +131,7 @@ var (
 		farm.ModuleName:           nil,
 		farm.YieldFarmingAccount:  nil,
 		farm.MintFarmingAccount:   {supply.Burner},
+		erc20.ModuleName:          nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -164,6 +177,7 @@ type OKExChainApp struct {
 	OrderKeeper    order.Keeper
 	SwapKeeper     ammswap.Keeper
 	FarmKeeper     farm.Keeper
+	Erc20Keeper    erc20.Keeper
 	BackendKeeper  backend.Keeper
 	StreamKeeper   stream.Keeper
 
@@ -203,7 +217,7 @@ func NewOKExChainApp(
 		supply.StoreKey, mint.StoreKey, distr.StoreKey, slashing.StoreKey,
 		gov.StoreKey, params.StoreKey, upgrade.StoreKey, evidence.StoreKey,
 		evm.StoreKey, token.StoreKey, token.KeyLock, dex.StoreKey, dex.TokenPairStoreKey,
-		order.OrderStoreKey, ammswap.StoreKey, farm.StoreKey,
+		order.OrderStoreKey, ammswap.StoreKey, farm.StoreKey, erc20.StoreKey,
 	)
 
 	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey)
@@ -239,6 +253,12 @@ func NewOKExChainApp(
 	app.AccountKeeper = auth.NewAccountKeeper(
 		cdc, keys[auth.StoreKey], app.subspaces[auth.ModuleName], okexchain.ProtoAccount,
 	)
+	// the accounts written in the blocks are tracked for the evm archive index. The observer is set before the account
+	// keeper is copied into the other keepers
+	if viper.GetBool(evmtypes.FlagEnableArchiveIndex) {
+		evmtypes.InitArchiveIndex(evmtypes.ArchiveDb())
+		app.AccountKeeper.SetObserverKeeper(evmtypes.GetArchiveTracker())
+	}
 	app.BankKeeper = bank.NewBaseKeeper(
 		app.AccountKeeper, app.subspaces[bank.ModuleName], app.BlacklistedAccAddrs(),
 	)
@@ -285,8 +305,14 @@ func NewOKExChainApp(
 	app.FarmKeeper = farm.NewKeeper(auth.FeeCollectorName, app.SupplyKeeper, app.TokenKeeper, app.SwapKeeper, app.subspaces[farm.StoreKey],
 		app.keys[farm.StoreKey], app.cdc)
 
+	app.Erc20Keeper = erc20.NewKeeper(app.cdc, app.keys[erc20.StoreKey], app.SupplyKeeper, app.TokenKeeper, app.EvmKeeper)
+
 	app.StreamKeeper = stream.NewKeeper(app.OrderKeeper, app.TokenKeeper, &app.DexKeeper, &app.AccountKeeper, &app.SwapKeeper,
 		&app.FarmKeeper, app.cdc, logger, appConfig, streamMetrics)
+	// the stream keeper replaces the observer of the account keeper, so both of them observe the account writes
+	if tracker := evmtypes.GetArchiveTracker(); tracker != nil {
+		app.AccountKeeper.SetObserverKeeper(accountObservers{tracker, app.StreamKeeper})
+	}
 	app.BackendKeeper = backend.NewKeeper(app.OrderKeeper, app.TokenKeeper, &app.DexKeeper, &app.SwapKeeper, &app.FarmKeeper,
 		app.MintKeeper, app.StreamKeeper.GetMarketKeeper(), app.cdc, logger, appConfig.BackendConfig)
 
@@ -294,30 +320,40 @@ func NewOKExChainApp(
 	evidenceKeeper := evidence.NewKeeper(
 		cdc, keys[evidence.StoreKey], app.subspaces[evidence.ModuleName], &app.StakingKeeper, app.SlashingKeeper,
 	)
-	evidenceRouter := evidence.NewRouter()
+	evidenceRouter := evidence.NewRouter().
+		AddRoute(evidence.RouteLightClientAttack, evidence.NewLightClientAttackHandler(*evidenceKeeper)).
+		AddRoute(evidence.RouteOracleEquivocation, evidence.NewOracleEquivocationHandler(*evidenceKeeper))
 	evidenceKeeper.SetRouter(evidenceRouter)
 	app.EvidenceKeeper = *evidenceKeeper
 
 	// register the proposal types
 	// 3.register the proposal types
 	govRouter := gov.NewRouter()
-	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
+	govRouter.AddRoute(gov.RouterKey, gov.NewProposalHandler(&app.GovKeeper)).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(&app.ParamsKeeper)).
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(dex.RouterKey, dex.NewProposalHandler(&app.DexKeeper)).
-		AddRoute(farm.RouterKey, farm.NewManageWhiteListProposalHandler(&app.FarmKeeper))
+		AddRoute(farm.RouterKey, farm.NewManageWhiteListProposalHandler(&app.FarmKeeper)).
+		AddRoute(evm.RouterKey, evm.NewManageContractProposalHandler(app.EvmKeeper)).
+		AddRoute(erc20.RouterKey, erc20.NewTokenMappingProposalHandler(&app.Erc20Keeper))
 	govProposalHandlerRouter := keeper.NewProposalHandlerRouter()
 	govProposalHandlerRouter.AddRoute(params.RouterKey, &app.ParamsKeeper).
 		AddRoute(dex.RouterKey, &app.DexKeeper).
-		AddRoute(farm.RouterKey, &app.FarmKeeper)
+		AddRoute(farm.RouterKey, &app.FarmKeeper).
+		AddRoute(evm.RouterKey, app.EvmKeeper).
+		AddRoute(erc20.RouterKey, &app.Erc20Keeper)
 	app.GovKeeper = gov.NewKeeper(
 		app.cdc, app.keys[gov.StoreKey], app.ParamsKeeper, app.subspaces[gov.DefaultParamspace],
 		app.SupplyKeeper, &stakingKeeper, gov.DefaultParamspace, govRouter,
 		app.BankKeeper, govProposalHandlerRouter, auth.FeeCollectorName,
 	)
+	// the messages of the passed execute messages proposals are run through the router of the app
+	app.GovKeeper.SetMsgRouter(app.Router())
 	app.ParamsKeeper.SetGovKeeper(app.GovKeeper)
 	app.DexKeeper.SetGovKeeper(app.GovKeeper)
 	app.FarmKeeper.SetGovKeeper(app.GovKeeper)
+	app.EvmKeeper.SetGovKeeper(app.GovKeeper)
+	app.Erc20Keeper.SetGovKeeper(app.GovKeeper)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -325,6 +361,11 @@ func NewOKExChainApp(
 		staking.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
 	)
 
+	// register the native contracts of evm, which are backed by the keepers of native modules
+	app.EvmKeeper.SetNativeContracts(precompile.NewNativeContracts(app.SwapKeeper, app.TokenKeeper, app.StakingKeeper))
+	// the erc20 contracts mapped from native tokens are resolved by the erc20 keeper
+	app.EvmKeeper.SetNativeContractResolver(app.Erc20Keeper)
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(
@@ -345,6 +386,7 @@ func NewOKExChainApp(
 		order.NewAppModule(commonversion.ProtocolVersionV0, app.OrderKeeper, app.SupplyKeeper),
 		ammswap.NewAppModule(app.SwapKeeper),
 		farm.NewAppModule(app.FarmKeeper),
+		erc20.NewAppModule(app.Erc20Keeper),
 		backend.NewAppModule(app.BackendKeeper),
 		stream.NewAppModule(app.StreamKeeper),
 		params.NewAppModule(app.ParamsKeeper),
@@ -383,7 +425,7 @@ func NewOKExChainApp(
 		auth.ModuleName, distr.ModuleName, staking.ModuleName, bank.ModuleName,
 		slashing.ModuleName, gov.ModuleName, mint.ModuleName, supply.ModuleName,
 		token.ModuleName, dex.ModuleName, order.ModuleName, ammswap.ModuleName, farm.ModuleName,
-		evm.ModuleName, crisis.ModuleName, genutil.ModuleName, params.ModuleName, evidence.ModuleName,
+		evm.ModuleName, erc20.ModuleName, crisis.ModuleName, genutil.ModuleName, params.ModuleName, evidence.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
@@ -445,6 +487,11 @@ func (app *OKExChainApp) DeliverTx(req abci.RequestDeliverTx) (res abci.Response
 	defer perf.GetPerf().OnAppDeliverTxExit(app.LastBlockHeight()+1, seq)
 
 	resp := app.BaseApp.DeliverTx(req)
+	// the logs of the evm tx delivered are indexed with the block committed
+	if evmtypes.GetLogIndex() != nil && resp.IsOK() {
+		tx, _ := evm.TxDecoder(app.cdc)(req.Tx)
+		app.EvmKeeper.AddTxLogs(tx, resp.Data)
+	}
 	if (app.BackendKeeper.Config.EnableBackend || app.StreamKeeper.AnalysisEnable()) && resp.IsOK() {
 		app.syncTx(req.Tx)
 	}
@@ -550,9 +597,26 @@ func (app *OKExChainApp) Commit() abci.ResponseCommit {
 	seq := perf.GetPerf().OnCommitEnter(app.LastBlockHeight() + 1)
 	defer perf.GetPerf().OnCommitExit(app.LastBlockHeight()+1, seq, app.Logger())
 	res := app.BaseApp.Commit()
+
+	// record the committed block into the evm log index and archive index
+	if evmtypes.GetLogIndex() != nil || evmtypes.GetArchiveIndex() != nil {
+		ctx := app.BaseApp.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+		app.EvmKeeper.CommitLogIndex(ctx)
+		app.EvmKeeper.CommitArchive(ctx)
+	}
 	return res
 }
 
+// accountObservers notifies all the observers of the account writes
+type accountObservers []auth.ObserverI
+
+// OnAccountUpdated implements the observer of the account keeper
+func (observers accountObservers) OnAccountUpdated(acc auth.Account) {
+	for _, observer := range observers {
+		observer.OnAccountUpdated(acc)
+	}
+}
+
 // GetMaccPerms returns a copy of the module account permissions
 func GetMaccPerms() map[string][]string {
 	dupMaccPerms := make(map[string][]string)
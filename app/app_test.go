@@ -9,6 +9,13 @@ import (
 	"github.com/okex/okexchain/x/params"
 	"os"
 	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dextypes "github.com/okex/okexchain/x/dex/types"
+	"github.com/okex/okexchain/x/gov"
+	govtypes "github.com/okex/okexchain/x/gov/types"
+	ordertypes "github.com/okex/okexchain/x/order/types"
 
 	"github.com/stretchr/testify/require"
 
@@ -69,3 +76,74 @@ func TestProposalManager(t *testing.T) {
 	require.True(t, app.GovKeeper.ProposalHandleRouter().HasRoute(dex.RouterKey))
 	require.True(t, app.GovKeeper.ProposalHandleRouter().HasRoute(farm.RouterKey))
 }
+
+func TestExpeditedDelistProposalWaitingQueue(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewOKExChainApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, 0)
+	genesisState := ModuleBasics.DefaultGenesis()
+	stateBytes, err := codec.MarshalJSONIndent(app.cdc, genesisState)
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+
+	votingEndTime := time.Now().UTC()
+	newCtx := func(height int64, blockTime time.Time) sdk.Context {
+		return app.NewContext(false, abci.Header{Height: height, Time: blockTime})
+	}
+	waitingHeights := func(ctx sdk.Context) (heights []uint64) {
+		app.GovKeeper.IterateAllWaitingProposals(ctx, func(_ govtypes.Proposal, _, height uint64) bool {
+			heights = append(heights, height)
+			return false
+		})
+		return
+	}
+
+	owner := sdk.AccAddress([]byte("delist-pair-owner---"))
+	tokenPair := &dextypes.TokenPair{
+		BaseAssetSymbol:  "xxb",
+		QuoteAssetSymbol: sdk.DefaultBondDenom,
+		InitPrice:        sdk.OneDec(),
+		MaxPriceDigit:    8,
+		MaxQuantityDigit: 8,
+		MinQuantity:      sdk.OneDec(),
+		Owner:            owner,
+		Deposits:         sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.ZeroInt()),
+	}
+	content := dextypes.NewDelistProposal("delist", "delist xxb", owner, tokenPair.BaseAssetSymbol,
+		tokenPair.QuoteAssetSymbol)
+	content.Expedited = true
+	proposal := govtypes.Proposal{Content: content, ProposalID: 1, Status: govtypes.StatusPassed,
+		VotingEndTime: votingEndTime}
+
+	ctx := newCtx(2, votingEndTime)
+	require.NoError(t, app.DexKeeper.SaveTokenPair(ctx, tokenPair))
+	app.DexKeeper.LockTokenPair(ctx, tokenPair.Name(), &ordertypes.ProductLock{})
+	app.GovKeeper.SetProposal(ctx, proposal)
+	app.GovKeeper.InsertWaitingProposalQueue(ctx, 2, proposal.ProposalID)
+
+	// the delist is postponed to the next block while the pair is locked
+	gov.EndBlocker(ctx, app.GovKeeper)
+	require.Equal(t, []uint64{3}, waitingHeights(ctx))
+	require.NotNil(t, app.DexKeeper.GetTokenPair(ctx, tokenPair.Name()))
+
+	// a failed retry consumes its entry instead of being retried forever
+	ctx = newCtx(3, votingEndTime)
+	app.DexKeeper.DeleteTokenPairByName(ctx, owner, tokenPair.Name())
+	gov.EndBlocker(ctx, app.GovKeeper)
+	require.Empty(t, waitingHeights(ctx))
+
+	// the postponement is given up once its deadline has passed
+	ctx = newCtx(4, votingEndTime.Add(dextypes.MaxDelistPostponement+time.Second))
+	require.NoError(t, app.DexKeeper.SaveTokenPair(ctx, tokenPair))
+	app.GovKeeper.InsertWaitingProposalQueue(ctx, 4, proposal.ProposalID)
+	gov.EndBlocker(ctx, app.GovKeeper)
+	require.Empty(t, waitingHeights(ctx))
+	require.NotNil(t, app.DexKeeper.GetTokenPair(ctx, tokenPair.Name()))
+
+	// the pair is delisted once the lock is released
+	ctx = newCtx(5, votingEndTime)
+	app.GovKeeper.InsertWaitingProposalQueue(ctx, 5, proposal.ProposalID)
+	app.DexKeeper.UnlockTokenPair(ctx, tokenPair.Name())
+	gov.EndBlocker(ctx, app.GovKeeper)
+	require.Empty(t, waitingHeights(ctx))
+	require.Nil(t, app.DexKeeper.GetTokenPair(ctx, tokenPair.Name()))
+}
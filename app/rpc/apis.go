@@ -9,10 +9,12 @@ import (
 
 	"github.com/okex/okexchain/app/crypto/ethsecp256k1"
 	"github.com/okex/okexchain/app/rpc/backend"
+	"github.com/okex/okexchain/app/rpc/namespaces/debug"
 	"github.com/okex/okexchain/app/rpc/namespaces/eth"
 	"github.com/okex/okexchain/app/rpc/namespaces/eth/filters"
 	"github.com/okex/okexchain/app/rpc/namespaces/net"
 	"github.com/okex/okexchain/app/rpc/namespaces/personal"
+	"github.com/okex/okexchain/app/rpc/namespaces/txpool"
 	"github.com/okex/okexchain/app/rpc/namespaces/web3"
 	rpctypes "github.com/okex/okexchain/app/rpc/types"
 	"github.com/okex/okexchain/cmd/client"
@@ -24,6 +26,8 @@ const (
 	EthNamespace      = "eth"
 	PersonalNamespace = "personal"
 	NetNamespace      = "net"
+	DebugNamespace    = "debug"
+	TxPoolNamespace   = "txpool"
 
 	apiVersion = "1.0"
 )
@@ -67,6 +71,12 @@ func GetAPIs(clientCtx context.CLIContext, keys ...ethsecp256k1.PrivKey) []rpc.A
 			Service:   net.NewAPI(clientCtx),
 			Public:    true,
 		},
+		{
+			Namespace: TxPoolNamespace,
+			Version:   apiVersion,
+			Service:   txpool.NewAPI(clientCtx, ethBackend),
+			Public:    true,
+		},
 	}
 
 	if viper.GetBool(client.FlagPersonalAPI) {
@@ -77,5 +87,14 @@ func GetAPIs(clientCtx context.CLIContext, keys ...ethsecp256k1.PrivKey) []rpc.A
 			Public:    false,
 		})
 	}
+
+	if viper.GetBool(client.FlagDebugAPI) {
+		apis = append(apis, rpc.API{
+			Namespace: DebugNamespace,
+			Version:   apiVersion,
+			Service:   debug.NewAPI(clientCtx, ethBackend),
+			Public:    false,
+		})
+	}
 	return apis
 }
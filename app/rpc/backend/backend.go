@@ -1,9 +1,12 @@
 package backend
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"math/big"
 	"os"
+	"sync"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -37,15 +40,23 @@ type Backend interface {
 
 	// Used by pending transaction filter
 	PendingTransactions() ([]*rpctypes.Transaction, error)
+	PendingTransactionByHash(hash common.Hash) (*rpctypes.Transaction, error)
 
 	// Used by log filter
 	GetTransactionLogs(txHash common.Hash) ([]*ethtypes.Log, error)
 	BloomStatus() (uint64, uint64)
 	ServiceFilter(ctx context.Context, session *bloombits.MatcherSession)
+
+	// Used by gas price oracle
+	SuggestGasPrice() (*big.Int, error)
+	FeeHistory(blockCount uint64, lastBlock rpctypes.BlockNumber, rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error)
 }
 
 var _ Backend = (*EthermintBackend)(nil)
 
+// maxPendingTxs is the max number of unconfirmed transactions returned by the rpc of tendermint mempool
+const maxPendingTxs = 100
+
 // EthermintBackend implements the Backend interface
 type EthermintBackend struct {
 	ctx               context.Context
@@ -54,6 +65,11 @@ type EthermintBackend struct {
 	gasLimit          int64
 	bloomRequests     chan chan *bloombits.Retrieval
 	closeBloomHandler chan struct{}
+
+	// cache of the gas price oracle
+	gpoLock       sync.Mutex
+	gpoLastHeight int64
+	gpoLastPrice  *big.Int
 }
 
 // New creates a new EthermintBackend instance
@@ -204,7 +220,7 @@ func (b *EthermintBackend) GetTransactionLogs(txHash common.Hash) ([]*ethtypes.L
 // PendingTransactions returns the transactions that are in the transaction pool
 // and have a from address that is one of the accounts this node manages.
 func (b *EthermintBackend) PendingTransactions() ([]*rpctypes.Transaction, error) {
-	pendingTxs, err := b.clientCtx.Client.UnconfirmedTxs(-1)
+	pendingTxs, err := b.clientCtx.Client.UnconfirmedTxs(maxPendingTxs)
 	if err != nil {
 		return nil, err
 	}
@@ -229,6 +245,29 @@ func (b *EthermintBackend) PendingTransactions() ([]*rpctypes.Transaction, error
 	return transactions, nil
 }
 
+// PendingTransactionByHash returns the transaction in the transaction pool with the given hash,
+// nil if it's not found.
+func (b *EthermintBackend) PendingTransactionByHash(hash common.Hash) (*rpctypes.Transaction, error) {
+	pendingTxs, err := b.clientCtx.Client.UnconfirmedTxs(maxPendingTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range pendingTxs.Txs {
+		if !bytes.Equal(tx.Hash(), hash.Bytes()) {
+			continue
+		}
+
+		ethTx, err := rpctypes.RawTxToEthTx(b.clientCtx, tx)
+		if err != nil {
+			return nil, nil
+		}
+		return rpctypes.NewTransaction(ethTx, hash, common.Hash{}, 0, 0)
+	}
+
+	return nil, nil
+}
+
 // GetLogs returns all the logs from all the ethereum transactions in a block.
 func (b *EthermintBackend) GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error) {
 	res, _, err := b.clientCtx.Query(fmt.Sprintf("custom/%s/%s/%s", evmtypes.ModuleName, evmtypes.QueryHashToHeight, blockHash.Hex()))
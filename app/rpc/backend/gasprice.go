@@ -0,0 +1,211 @@
+package backend
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/viper"
+
+	rpctypes "github.com/okex/okexchain/app/rpc/types"
+)
+
+const (
+	// FlagGasPriceOracleBlocks is the number of recent blocks sampled by the gas price oracle
+	FlagGasPriceOracleBlocks = "gpo-blocks"
+	// FlagGasPriceOraclePercentile is the percentile of the sampled gas prices suggested by the gas price oracle
+	FlagGasPriceOraclePercentile = "gpo-percentile"
+
+	DefaultGasPriceOracleBlocks     = 20
+	DefaultGasPriceOraclePercentile = 60
+
+	// maxFeeHistory is the max number of blocks that can be queried by eth_feeHistory.
+	// Every block costs two RPC calls to the node, so the range is kept small
+	maxFeeHistory = 100
+)
+
+// txGasAndPrice is the gas used and the gas price of an ethereum transaction
+type txGasAndPrice struct {
+	gasUsed  uint64
+	gasPrice *big.Int
+}
+
+// blockFees is the gas usage of the ethereum transactions in a block, sorted by gas price in ascending order
+type blockFees struct {
+	gasUsed uint64
+	txs     []txGasAndPrice
+}
+
+// SuggestGasPrice returns the percentile of the gas prices of the ethereum transactions in the recent blocks,
+// which is never lower than the minimum gas price of the node.
+// The result is cached until a new block is committed
+func (b *EthermintBackend) SuggestGasPrice() (*big.Int, error) {
+	minGasPrice := rpctypes.ParseGasPrice().ToInt()
+
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	b.gpoLock.Lock()
+	defer b.gpoLock.Unlock()
+	if b.gpoLastHeight == int64(latest) && b.gpoLastPrice != nil {
+		return new(big.Int).Set(b.gpoLastPrice), nil
+	}
+
+	blocks := viper.GetInt64(FlagGasPriceOracleBlocks)
+	if blocks <= 0 {
+		blocks = DefaultGasPriceOracleBlocks
+	}
+	percentile := viper.GetFloat64(FlagGasPriceOraclePercentile)
+	if percentile < 0 || percentile > 100 {
+		percentile = DefaultGasPriceOraclePercentile
+	}
+
+	var prices []*big.Int
+	for height := int64(latest); height > int64(latest)-blocks && height > 0; height-- {
+		fees, err := b.getBlockFees(height)
+		if err != nil {
+			return nil, err
+		}
+		for _, tx := range fees.txs {
+			prices = append(prices, tx.gasPrice)
+		}
+	}
+
+	price := percentileGasPrice(prices, percentile, minGasPrice)
+	b.gpoLastHeight = int64(latest)
+	b.gpoLastPrice = price
+	return new(big.Int).Set(price), nil
+}
+
+// percentileGasPrice returns the gas price at the percentile of the prices, or the minimum gas price
+// if there is no price or the picked one is lower than it. The prices are sorted in place
+func percentileGasPrice(prices []*big.Int, percentile float64, minGasPrice *big.Int) *big.Int {
+	if len(prices) == 0 {
+		return minGasPrice
+	}
+	sort.Slice(prices, func(i, j int) bool { return prices[i].Cmp(prices[j]) < 0 })
+	if p := prices[int(float64(len(prices)-1)*percentile/100)]; p.Cmp(minGasPrice) > 0 {
+		return p
+	}
+	return minGasPrice
+}
+
+// FeeHistory returns the gas used ratio and the gas prices at the given percentiles of the blocks
+// in [lastBlock-blockCount+1, lastBlock]
+func (b *EthermintBackend) FeeHistory(blockCount uint64, lastBlock rpctypes.BlockNumber,
+	rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error) {
+	if blockCount == 0 {
+		return nil, fmt.Errorf("block count should be greater than 0")
+	}
+	if blockCount > maxFeeHistory {
+		blockCount = maxFeeHistory
+	}
+	for i, p := range rewardPercentiles {
+		if p < 0 || p > 100 {
+			return nil, fmt.Errorf("invalid reward percentile %f", p)
+		}
+		if i > 0 && p < rewardPercentiles[i-1] {
+			return nil, fmt.Errorf("reward percentiles are not in ascending order: %f, %f", rewardPercentiles[i-1], p)
+		}
+	}
+
+	latest, err := b.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	last := lastBlock.Int64()
+	if lastBlock == rpctypes.LatestBlockNumber || lastBlock == rpctypes.PendingBlockNumber || last > int64(latest) {
+		last = int64(latest)
+	}
+	oldest := last - int64(blockCount) + 1
+	if oldest < 1 {
+		oldest = 1
+	}
+	if last < oldest {
+		return nil, fmt.Errorf("no block to query fee history")
+	}
+
+	gasLimit, err := rpctypes.BlockMaxGasFromConsensusParams(b.ctx, b.clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &rpctypes.FeeHistoryResult{OldestBlock: (*hexutil.Big)(big.NewInt(oldest))}
+	for height := oldest; height <= last; height++ {
+		fees, err := b.getBlockFees(height)
+		if err != nil {
+			return nil, err
+		}
+
+		result.BaseFee = append(result.BaseFee, (*hexutil.Big)(new(big.Int)))
+		result.GasUsedRatio = append(result.GasUsedRatio, float64(fees.gasUsed)/float64(gasLimit))
+		if len(rewardPercentiles) != 0 {
+			result.Reward = append(result.Reward, fees.rewards(rewardPercentiles))
+		}
+	}
+	// the base fee of the next block is included as well
+	result.BaseFee = append(result.BaseFee, (*hexutil.Big)(new(big.Int)))
+	return result, nil
+}
+
+// getBlockFees returns the gas used and gas prices of the ethereum transactions in the block
+func (b *EthermintBackend) getBlockFees(height int64) (blockFees, error) {
+	resBlock, err := b.clientCtx.Client.Block(&height)
+	if err != nil {
+		return blockFees{}, err
+	}
+	resBlockResults, err := b.clientCtx.Client.BlockResults(&height)
+	if err != nil {
+		return blockFees{}, err
+	}
+
+	var fees blockFees
+	for i, tx := range resBlock.Block.Txs {
+		if i >= len(resBlockResults.TxsResults) {
+			break
+		}
+		gasUsed := uint64(resBlockResults.TxsResults[i].GasUsed)
+		fees.gasUsed += gasUsed
+
+		ethTx, err := rpctypes.RawTxToEthTx(b.clientCtx, tx)
+		if err != nil {
+			// ignore non Ethermint EVM transactions
+			continue
+		}
+		fees.txs = append(fees.txs, txGasAndPrice{gasUsed: gasUsed, gasPrice: ethTx.Data.Price})
+	}
+
+	sort.SliceStable(fees.txs, func(i, j int) bool { return fees.txs[i].gasPrice.Cmp(fees.txs[j].gasPrice) < 0 })
+	return fees, nil
+}
+
+// rewards returns the gas prices at the percentiles, which are weighted by the gas used of the transactions
+func (fees blockFees) rewards(percentiles []float64) []*hexutil.Big {
+	rewards := make([]*hexutil.Big, len(percentiles))
+	if len(fees.txs) == 0 {
+		for i := range rewards {
+			rewards[i] = (*hexutil.Big)(new(big.Int))
+		}
+		return rewards
+	}
+
+	var totalGasUsed uint64
+	for _, tx := range fees.txs {
+		totalGasUsed += tx.gasUsed
+	}
+
+	txIndex := 0
+	sumGasUsed := fees.txs[0].gasUsed
+	for i, p := range percentiles {
+		thresholdGasUsed := uint64(float64(totalGasUsed) * p / 100)
+		for sumGasUsed < thresholdGasUsed && txIndex < len(fees.txs)-1 {
+			txIndex++
+			sumGasUsed += fees.txs[txIndex].gasUsed
+		}
+		rewards[i] = (*hexutil.Big)(new(big.Int).Set(fees.txs[txIndex].gasPrice))
+	}
+	return rewards
+}
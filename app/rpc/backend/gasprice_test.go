@@ -0,0 +1,97 @@
+package backend
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func bigInts(values ...int64) []*big.Int {
+	ints := make([]*big.Int, len(values))
+	for i, v := range values {
+		ints[i] = big.NewInt(v)
+	}
+	return ints
+}
+
+func TestPercentileGasPrice(t *testing.T) {
+	minGasPrice := big.NewInt(10)
+	testCases := []struct {
+		name       string
+		prices     []*big.Int
+		percentile float64
+		expected   int64
+	}{
+		{"no price", nil, 60, 10},
+		{"all below min", bigInts(1, 5, 9), 100, 10},
+		{"percentile 0", bigInts(30, 20, 40, 50, 60), 0, 20},
+		{"percentile 50", bigInts(30, 20, 40, 50, 60), 50, 40},
+		{"percentile 60 rounds down", bigInts(30, 20, 40, 50, 60), 60, 40},
+		{"percentile 100", bigInts(30, 20, 40, 50, 60), 100, 60},
+		{"picked below min", bigInts(1, 2, 3, 100), 50, 10},
+		{"single price", bigInts(15), 60, 15},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			price := percentileGasPrice(tc.prices, tc.percentile, minGasPrice)
+			require.Equal(t, tc.expected, price.Int64())
+		})
+	}
+}
+
+func TestBlockFeesRewards(t *testing.T) {
+	testCases := []struct {
+		name        string
+		fees        blockFees
+		percentiles []float64
+		expected    []int64
+	}{
+		{
+			"no tx",
+			blockFees{},
+			[]float64{0, 50, 100},
+			[]int64{0, 0, 0},
+		},
+		{
+			"single tx",
+			blockFees{gasUsed: 21000, txs: []txGasAndPrice{{21000, big.NewInt(7)}}},
+			[]float64{0, 50, 100},
+			[]int64{7, 7, 7},
+		},
+		{
+			"equal gas used",
+			blockFees{gasUsed: 400, txs: []txGasAndPrice{
+				{100, big.NewInt(1)}, {100, big.NewInt(2)}, {100, big.NewInt(3)}, {100, big.NewInt(4)},
+			}},
+			[]float64{0, 25, 50, 75, 100},
+			[]int64{1, 1, 2, 3, 4},
+		},
+		{
+			"weighted by gas used",
+			blockFees{gasUsed: 1000, txs: []txGasAndPrice{
+				{100, big.NewInt(1)}, {800, big.NewInt(2)}, {100, big.NewInt(3)},
+			}},
+			[]float64{0, 10, 11, 50, 90, 91, 100},
+			[]int64{1, 1, 2, 2, 2, 3, 3},
+		},
+		{
+			"no percentile",
+			blockFees{gasUsed: 100, txs: []txGasAndPrice{{100, big.NewInt(1)}}},
+			nil,
+			[]int64{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rewards := tc.fees.rewards(tc.percentiles)
+			require.Len(t, rewards, len(tc.expected))
+			for i, expected := range tc.expected {
+				require.Equal(t, (*hexutil.Big)(big.NewInt(expected)).String(), rewards[i].String(), "percentile %v", tc.percentiles[i])
+			}
+		})
+	}
+}
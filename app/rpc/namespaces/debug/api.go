@@ -0,0 +1,204 @@
+package debug
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	clientcontext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/okex/okexchain/app/rpc/backend"
+	rpctypes "github.com/okex/okexchain/app/rpc/types"
+	ethermint "github.com/okex/okexchain/app/types"
+	evmtypes "github.com/okex/okexchain/x/evm/types"
+)
+
+// PrivateDebugAPI is the debug_ prefixed set of APIs in the Web3 JSON-RPC spec.
+// The transactions are re-executed by the node at their historical height with a tracer attached
+type PrivateDebugAPI struct {
+	clientCtx clientcontext.CLIContext
+	logger    log.Logger
+	backend   backend.Backend
+}
+
+// NewAPI creates an instance of the Debug API.
+func NewAPI(clientCtx clientcontext.CLIContext, backend backend.Backend) *PrivateDebugAPI {
+	return &PrivateDebugAPI{
+		clientCtx: clientCtx,
+		logger:    log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "json-rpc", "namespace", "debug"),
+		backend:   backend,
+	}
+}
+
+// TraceTransaction returns the structured logs created during the execution of EVM
+// and returns them as a JSON object.
+func (api *PrivateDebugAPI) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (json.RawMessage, error) {
+	api.logger.Debug("debug_traceTransaction", "hash", hash)
+	tx, err := api.clientCtx.Client.Tx(hash.Bytes(), false)
+	if err != nil {
+		return nil, fmt.Errorf("transaction %s not found", hash.Hex())
+	}
+
+	resBlock, err := api.clientCtx.Client.Block(&tx.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := resBlock.Block.Txs[:tx.Index+1]
+	results, err := api.traceBlock(resBlock.Block, txs, int(tx.Index), config)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("transaction %s is not an ethereum transaction", hash.Hex())
+	}
+
+	return getTraceResult(results[0])
+}
+
+// TraceBlockByNumber returns the structured logs created during the execution of
+// EVM and returns them as a JSON object.
+func (api *PrivateDebugAPI) TraceBlockByNumber(blockNum rpctypes.BlockNumber, config *evmtypes.TraceConfig) ([]evmtypes.TxTraceResult, error) {
+	api.logger.Debug("debug_traceBlockByNumber", "number", blockNum)
+	block, err := api.getBlock(blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.traceBlock(block, block.Txs, 0, config)
+}
+
+// TraceBlockByHash returns the structured logs created during the execution of
+// EVM and returns them as a JSON object.
+func (api *PrivateDebugAPI) TraceBlockByHash(hash common.Hash, config *evmtypes.TraceConfig) ([]evmtypes.TxTraceResult, error) {
+	api.logger.Debug("debug_traceBlockByHash", "hash", hash)
+	res, _, err := api.clientCtx.Query(fmt.Sprintf("custom/%s/%s/%s", evmtypes.ModuleName, evmtypes.QueryHashToHeight, hash.Hex()))
+	if err != nil {
+		return nil, fmt.Errorf("block %s not found", hash.Hex())
+	}
+
+	var out evmtypes.QueryResBlockNumber
+	if err := api.clientCtx.Codec.UnmarshalJSON(res, &out); err != nil {
+		return nil, err
+	}
+
+	return api.TraceBlockByNumber(rpctypes.BlockNumber(out.Number), config)
+}
+
+// TraceCall lets you trace a given eth_call. It collects the structured logs created during the execution of EVM
+// if the given transaction was added on top of the provided block and returns them as a JSON object.
+func (api *PrivateDebugAPI) TraceCall(args rpctypes.CallArgs, blockNum rpctypes.BlockNumber, config *evmtypes.TraceConfig) (json.RawMessage, error) {
+	api.logger.Debug("debug_traceCall", "args", args, "block number", blockNum)
+	block, err := api.getBlock(blockNum)
+	if err != nil {
+		return nil, err
+	}
+
+	call := &evmtypes.CallArgs{
+		To:       args.To,
+		Gas:      ethermint.DefaultRPCGasLimit,
+		GasPrice: args.GasPrice,
+		Value:    args.Value,
+	}
+	if args.From != nil {
+		call.From = *args.From
+	}
+	if args.Gas != nil {
+		call.Gas = *args.Gas
+	}
+	if args.Data != nil {
+		call.Data = *args.Data
+	}
+
+	params := evmtypes.QueryTraceParams{
+		BlockHeight:     block.Height,
+		BlockTime:       block.Time,
+		BlockHash:       common.BytesToHash(block.Hash()),
+		ParentHash:      common.BytesToHash(block.LastBlockID.Hash),
+		ProposerAddress: block.ProposerAddress,
+		Call:            call,
+		Config:          config,
+	}
+	results, err := api.queryTrace(block.Height, params)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) == 0 {
+		return nil, fmt.Errorf("failed to trace call")
+	}
+
+	return getTraceResult(results[0])
+}
+
+// traceBlock traces the ethereum transactions in txs of block with index >= traceFrom
+func (api *PrivateDebugAPI) traceBlock(block *tmtypes.Block, txs tmtypes.Txs, traceFrom int,
+	config *evmtypes.TraceConfig) ([]evmtypes.TxTraceResult, error) {
+	// the transactions are re-executed on the state of previous block, which isn't available for the first block
+	if block.Height <= 1 {
+		return nil, fmt.Errorf("block %d is not traceable", block.Height)
+	}
+
+	rawTxs := make([][]byte, len(txs))
+	for i, tx := range txs {
+		rawTxs[i] = tx
+	}
+
+	params := evmtypes.QueryTraceParams{
+		BlockHeight:     block.Height,
+		BlockTime:       block.Time,
+		BlockHash:       common.BytesToHash(block.Hash()),
+		ParentHash:      common.BytesToHash(block.LastBlockID.Hash),
+		ProposerAddress: block.ProposerAddress,
+		Txs:             rawTxs,
+		TraceFrom:       traceFrom,
+		Config:          config,
+	}
+	return api.queryTrace(block.Height-1, params)
+}
+
+func (api *PrivateDebugAPI) queryTrace(height int64, params evmtypes.QueryTraceParams) ([]evmtypes.TxTraceResult, error) {
+	bz, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, _, err := api.clientCtx.WithHeight(height).QueryWithData(
+		fmt.Sprintf("custom/%s/%s", evmtypes.ModuleName, evmtypes.QueryTrace), bz)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []evmtypes.TxTraceResult
+	if err := json.Unmarshal(res, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (api *PrivateDebugAPI) getBlock(blockNum rpctypes.BlockNumber) (*tmtypes.Block, error) {
+	height := blockNum.Int64()
+	if blockNum == rpctypes.LatestBlockNumber || blockNum == rpctypes.PendingBlockNumber {
+		latest, err := api.backend.LatestBlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		height = latest
+	}
+
+	resBlock, err := api.clientCtx.Client.Block(&height)
+	if err != nil {
+		return nil, err
+	}
+	return resBlock.Block, nil
+}
+
+func getTraceResult(result evmtypes.TxTraceResult) (json.RawMessage, error) {
+	if result.Error != "" {
+		return nil, errors.New(result.Error)
+	}
+	return result.Result, nil
+}
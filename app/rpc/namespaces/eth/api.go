@@ -3,6 +3,7 @@ package eth
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
@@ -23,16 +24,16 @@ import (
 	"github.com/okex/okexchain/app/utils"
 	evmtypes "github.com/okex/okexchain/x/evm/types"
 
+	"github.com/tendermint/iavl"
 	abci "github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/crypto/merkle"
 	"github.com/tendermint/tendermint/libs/log"
-	"github.com/tendermint/tendermint/rpc/client"
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 
 	clientcontext "github.com/cosmos/cosmos-sdk/client/context"
@@ -54,7 +55,6 @@ type PublicEthereumAPI struct {
 	keys         []ethsecp256k1.PrivKey // unlocked keys
 	nonceLock    *rpctypes.AddrLocker
 	keyringLock  sync.Mutex
-	gasPrice     *hexutil.Big
 }
 
 // NewAPI creates an instance of the public ETH Web3 API.
@@ -76,7 +76,6 @@ func NewAPI(
 		backend:      backend,
 		keys:         keys,
 		nonceLock:    nonceLock,
-		gasPrice:     ParseGasPrice(),
 	}
 
 	if err := api.GetKeyringInfo(); err != nil {
@@ -191,9 +190,21 @@ func (api *PublicEthereumAPI) Hashrate() hexutil.Uint64 {
 }
 
 // GasPrice returns the current gas price based on Ethermint's gas price oracle.
-func (api *PublicEthereumAPI) GasPrice() *hexutil.Big {
+func (api *PublicEthereumAPI) GasPrice() (*hexutil.Big, error) {
 	api.logger.Debug("eth_gasPrice")
-	return api.gasPrice
+	price, err := api.backend.SuggestGasPrice()
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(price), nil
+}
+
+// FeeHistory returns the gas used ratio and the gas prices at the given percentiles of the recent blocks,
+// so that the wallets could suggest the fees by the congestion of the chain.
+func (api *PublicEthereumAPI) FeeHistory(blockCount hexutil.Uint64, lastBlock rpctypes.BlockNumber,
+	rewardPercentiles []float64) (*rpctypes.FeeHistoryResult, error) {
+	api.logger.Debug("eth_feeHistory", "count", blockCount, "last block", lastBlock, "percentiles", rewardPercentiles)
+	return api.backend.FeeHistory(uint64(blockCount), lastBlock, rewardPercentiles)
 }
 
 // Accounts returns the list of accounts available to this node.
@@ -227,18 +238,21 @@ func (api *PublicEthereumAPI) BlockNumber() (hexutil.Uint64, error) {
 func (api *PublicEthereumAPI) GetBalance(address common.Address, blockNum rpctypes.BlockNumber) (*hexutil.Big, error) {
 	api.logger.Debug("eth_getBalance", "address", address, "block number", blockNum)
 
-	clientCtx := api.clientCtx
-	if !(blockNum == rpctypes.PendingBlockNumber || blockNum == rpctypes.LatestBlockNumber) {
-		clientCtx = api.clientCtx.WithHeight(blockNum.Int64())
-	}
-
-	res, _, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/balance/%s", evmtypes.ModuleName, address.Hex()), nil)
+	res, archived, err := api.queryState(blockNum,
+		fmt.Sprintf("custom/%s/balance/%s", evmtypes.ModuleName, address.Hex()),
+		fmt.Sprintf("custom/%s/%s/%s/%d", evmtypes.ModuleName, evmtypes.QueryArchiveAccount, address.Hex(), blockNum))
 	if err != nil {
 		return nil, err
 	}
 
 	var out evmtypes.QueryResBalance
-	api.clientCtx.Codec.MustUnmarshalJSON(res, &out)
+	if archived {
+		var account evmtypes.QueryResAccount
+		api.clientCtx.Codec.MustUnmarshalJSON(res, &account)
+		out.Balance = account.Balance
+	} else {
+		api.clientCtx.Codec.MustUnmarshalJSON(res, &out)
+	}
 	val, err := utils.UnmarshalBigInt(out.Balance)
 	if err != nil {
 		return nil, err
@@ -273,8 +287,10 @@ func (api *PublicEthereumAPI) GetBalance(address common.Address, blockNum rpctyp
 // GetStorageAt returns the contract storage at the given address, block number, and key.
 func (api *PublicEthereumAPI) GetStorageAt(address common.Address, key string, blockNum rpctypes.BlockNumber) (hexutil.Bytes, error) {
 	api.logger.Debug("eth_getStorageAt", "address", address, "key", key, "block number", blockNum)
-	clientCtx := api.clientCtx.WithHeight(blockNum.Int64())
-	res, _, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/storage/%s/%s", evmtypes.ModuleName, address.Hex(), key), nil)
+	res, _, err := api.queryState(blockNum,
+		fmt.Sprintf("custom/%s/storage/%s/%s", evmtypes.ModuleName, address.Hex(), key),
+		fmt.Sprintf("custom/%s/%s/%s/%s/%d", evmtypes.ModuleName, evmtypes.QueryArchiveStorage, address.Hex(), key,
+			blockNum))
 	if err != nil {
 		return nil, err
 	}
@@ -390,8 +406,9 @@ func (api *PublicEthereumAPI) GetUncleCountByBlockNumber(_ rpctypes.BlockNumber)
 // GetCode returns the contract code at the given address and block number.
 func (api *PublicEthereumAPI) GetCode(address common.Address, blockNumber rpctypes.BlockNumber) (hexutil.Bytes, error) {
 	api.logger.Debug("eth_getCode", "address", address, "block number", blockNumber)
-	clientCtx := api.clientCtx.WithHeight(blockNumber.Int64())
-	res, _, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", evmtypes.ModuleName, evmtypes.QueryCode, address.Hex()), nil)
+	res, _, err := api.queryState(blockNumber,
+		fmt.Sprintf("custom/%s/%s/%s", evmtypes.ModuleName, evmtypes.QueryCode, address.Hex()),
+		fmt.Sprintf("custom/%s/%s/%s/%d", evmtypes.ModuleName, evmtypes.QueryArchiveCode, address.Hex(), blockNumber))
 	if err != nil {
 		return nil, err
 	}
@@ -518,9 +535,9 @@ func (api *PublicEthereumAPI) SendRawTransaction(data hexutil.Bytes) (common.Has
 }
 
 // Call performs a raw contract call.
-func (api *PublicEthereumAPI) Call(args rpctypes.CallArgs, blockNr rpctypes.BlockNumber, _ *map[common.Address]rpctypes.Account) (hexutil.Bytes, error) {
+func (api *PublicEthereumAPI) Call(args rpctypes.CallArgs, blockNr rpctypes.BlockNumber, overrides *evmtypes.StateOverride) (hexutil.Bytes, error) {
 	api.logger.Debug("eth_call", "args", args, "block number", blockNr)
-	simRes, err := api.doCall(args, blockNr, big.NewInt(ethermint.DefaultRPCGasLimit))
+	simRes, err := api.doCall(args, blockNr, big.NewInt(ethermint.DefaultRPCGasLimit), overrides)
 	if err != nil {
 		return []byte{}, TransformDataError(err, "eth_call")
 	}
@@ -535,8 +552,10 @@ func (api *PublicEthereumAPI) Call(args rpctypes.CallArgs, blockNr rpctypes.Bloc
 
 // DoCall performs a simulated call operation through the evmtypes. It returns the
 // estimated gas used on the operation or an error if fails.
+// If overrides is not nil, the call is simulated by evm module on the state with overrides
+// applied, and the pending transactions are not included.
 func (api *PublicEthereumAPI) doCall(
-	args rpctypes.CallArgs, blockNum rpctypes.BlockNumber, globalGasCap *big.Int,
+	args rpctypes.CallArgs, blockNum rpctypes.BlockNumber, globalGasCap *big.Int, overrides *evmtypes.StateOverride,
 ) (*sdk.SimulationResponse, error) {
 
 	clientCtx := api.clientCtx
@@ -588,6 +607,17 @@ func (api *PublicEthereumAPI) doCall(
 		data = []byte(*args.Data)
 	}
 
+	if overrides != nil {
+		return api.simulateCall(clientCtx, evmtypes.CallArgs{
+			From:     addr,
+			To:       args.To,
+			Gas:      hexutil.Uint64(gas),
+			GasPrice: (*hexutil.Big)(gasPrice),
+			Value:    (*hexutil.Big)(value),
+			Data:     data,
+		}, *overrides)
+	}
+
 	// Set destination address for call
 	var toAddr sdk.AccAddress
 	if args.To != nil {
@@ -638,21 +668,69 @@ func (api *PublicEthereumAPI) doCall(
 	return &simResponse, nil
 }
 
-// EstimateGas returns an estimate of gas usage for the given smart contract call.
-// It adds 1,000 gas to the returned value instead of using the gas adjustment
-// param from the SDK.
-func (api *PublicEthereumAPI) EstimateGas(args rpctypes.CallArgs) (hexutil.Uint64, error) {
-	api.logger.Debug("eth_estimateGas", "args", args)
-	simResponse, err := api.doCall(args, 0, big.NewInt(ethermint.DefaultRPCGasLimit))
+// simulateCall simulates a message call with state overrides through the evm module
+func (api *PublicEthereumAPI) simulateCall(clientCtx clientcontext.CLIContext, call evmtypes.CallArgs,
+	overrides evmtypes.StateOverride) (*sdk.SimulationResponse, error) {
+	bz, err := json.Marshal(evmtypes.QuerySimulateCallParams{Call: call, Overrides: overrides})
+	if err != nil {
+		return nil, err
+	}
+
+	res, _, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", evmtypes.ModuleName, evmtypes.QuerySimulateCall), bz)
+	if err != nil {
+		return nil, err
+	}
+
+	var simResponse sdk.SimulationResponse
+	if err := clientCtx.Codec.UnmarshalBinaryBare(res, &simResponse); err != nil {
+		return nil, err
+	}
+
+	return &simResponse, nil
+}
+
+// EstimateGas returns the lowest gas limit with which the given call could be executed successfully
+// at the given block, which is the latest block if it's not specified.
+// The gas limit is found by binary search over the simulated executions.
+func (api *PublicEthereumAPI) EstimateGas(args rpctypes.CallArgs, blockNum *rpctypes.BlockNumber) (hexutil.Uint64, error) {
+	api.logger.Debug("eth_estimateGas", "args", args, "block number", blockNum)
+	blockNr := rpctypes.LatestBlockNumber
+	if blockNum != nil {
+		blockNr = *blockNum
+	}
+
+	// the upper bound is the gas limit of the call if it's set, otherwise the gas cap of rpc
+	hi := uint64(ethermint.DefaultRPCGasLimit)
+	if args.Gas != nil && uint64(*args.Gas) >= params.TxGas {
+		hi = uint64(*args.Gas)
+	}
+
+	executable := func(gas uint64) (*sdk.SimulationResponse, error) {
+		args.Gas = (*hexutil.Uint64)(&gas)
+		return api.doCall(args, blockNr, big.NewInt(ethermint.DefaultRPCGasLimit), nil)
+	}
+
+	// the call fails with the highest gas limit allowed, it's not a problem of gas
+	simResponse, err := executable(hi)
 	if err != nil {
-		return 0, TransformDataError(err, "eth_estimateGas")
+		return 0, TransformDataError(err, RPCEthEstimateGas)
 	}
 
-	// TODO: change 1000 buffer for more accurate buffer (eg: SDK's gasAdjusted)
-	estimatedGas := simResponse.GasInfo.GasUsed
-	gas := estimatedGas + 1000
+	// the gas limit required is at least the gas used by the execution with the highest gas limit
+	lo := params.TxGas - 1
+	if simResponse.GasInfo.GasUsed > lo {
+		lo = simResponse.GasInfo.GasUsed - 1
+	}
+	for lo+1 < hi {
+		mid := (hi + lo) / 2
+		if _, err := executable(mid); err != nil {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
 
-	return hexutil.Uint64(gas), nil
+	return hexutil.Uint64(hi), nil
 }
 
 // GetBlockByHash returns the block identified by hash.
@@ -727,22 +805,14 @@ func (api *PublicEthereumAPI) GetTransactionByHash(hash common.Hash) (*rpctypes.
 
 	tx, err := api.clientCtx.Client.Tx(hash.Bytes(), false)
 	if err != nil {
-		// check if the tx is on the mempool
-		pendingTxs, pendingErr := api.PendingTransactions()
+		// check if the tx is on the mempool, which is returned with nil block hash and number
+		pendingTx, pendingErr := api.backend.PendingTransactionByHash(hash)
 		if pendingErr != nil {
 			return nil, err
 		}
 
-		if len(pendingTxs) != 0 {
-			for _, tx := range pendingTxs {
-				if tx != nil && hash == tx.Hash {
-					return tx, nil
-				}
-			}
-		}
-
 		// Return nil for transaction when not found
-		return nil, nil
+		return pendingTx, nil
 	}
 
 	// Can either cache or just leave this out if not necessary
@@ -940,6 +1010,15 @@ func (api *PublicEthereumAPI) GetUncleByBlockNumberAndIndex(number hexutil.Uint,
 func (api *PublicEthereumAPI) GetProof(address common.Address, storageKeys []string, block rpctypes.BlockNumber) (*rpctypes.AccountResult, error) {
 	api.logger.Debug("eth_getProof", "address", address, "keys", storageKeys, "number", block)
 
+	// the proofs are only available in the store, which isn't recorded by the archive index
+	pruned, err := api.isStatePruned(block)
+	if err != nil {
+		return nil, err
+	}
+	if pruned {
+		return nil, fmt.Errorf("the proof of block %d is unavailable since the state is pruned", block)
+	}
+
 	clientCtx := api.clientCtx.WithHeight(int64(block))
 	path := fmt.Sprintf("custom/%s/%s/%s", evmtypes.ModuleName, evmtypes.QueryAccount, address.Hex())
 
@@ -953,29 +1032,25 @@ func (api *PublicEthereumAPI) GetProof(address common.Address, storageKeys []str
 	clientCtx.Codec.MustUnmarshalJSON(resBz, &account)
 
 	storageProofs := make([]rpctypes.StorageResult, len(storageKeys))
-	opts := client.ABCIQueryOptions{Height: int64(block), Prove: true}
 	for i, k := range storageKeys {
-		// Get value for key
-		vPath := fmt.Sprintf("custom/%s/%s/%s/%s", evmtypes.ModuleName, evmtypes.QueryStorage, address, k)
-		vRes, err := api.clientCtx.Client.ABCIQueryWithOptions(vPath, nil, opts)
+		// query the value of the key with its proof in the evm store
+		storageKey := evmtypes.GetStorageByAddressKey(address, common.HexToHash(k).Bytes())
+		res, err := clientCtx.QueryABCI(abci.RequestQuery{
+			Path:  fmt.Sprintf("store/%s/key", evmtypes.StoreKey),
+			Data:  append(evmtypes.AddressStoragePrefix(address), storageKey.Bytes()...),
+			Prove: true,
+		})
 		if err != nil {
 			return nil, err
 		}
-
-		var value evmtypes.QueryResStorage
-		clientCtx.Codec.MustUnmarshalJSON(vRes.Response.GetValue(), &value)
-
-		// check for proof
-		proof := vRes.Response.GetProof()
-		proofStr := new(merkle.Proof).String()
-		if proof != nil {
-			proofStr = proof.String()
+		if res.GetProof() == nil {
+			return nil, fmt.Errorf("the proof of the storage key %s is unavailable at block %d", k, block)
 		}
 
 		storageProofs[i] = rpctypes.StorageResult{
 			Key:   k,
-			Value: (*hexutil.Big)(common.BytesToHash(value.Value).Big()),
-			Proof: []string{proofStr},
+			Value: (*hexutil.Big)(common.BytesToHash(res.GetValue()).Big()),
+			Proof: []string{res.GetProof().String()},
 		}
 	}
 
@@ -990,13 +1065,10 @@ func (api *PublicEthereumAPI) GetProof(address common.Address, storageKeys []str
 	if err != nil {
 		return nil, err
 	}
-
-	// check for proof
-	accountProof := res.GetProof()
-	accProofStr := new(merkle.Proof).String()
-	if accountProof != nil {
-		accProofStr = accountProof.String()
+	if res.GetProof() == nil {
+		return nil, fmt.Errorf("the proof of the account %s is unavailable at block %d", address.Hex(), block)
 	}
+	accProofStr := res.GetProof().String()
 
 	return &rpctypes.AccountResult{
 		Address:      address,
@@ -1020,9 +1092,10 @@ func (api *PublicEthereumAPI) generateFromArgs(args rpctypes.SendTxArgs) (*evmty
 	gasPrice := (*big.Int)(args.GasPrice)
 
 	if args.GasPrice == nil {
-		// Set default gas price
-		// TODO: Change to min gas price from context once available through server/daemon
-		gasPrice = ParseGasPrice().ToInt()
+		// Set default gas price suggested by the gas price oracle
+		if gasPrice, err = api.backend.SuggestGasPrice(); err != nil {
+			return nil, err
+		}
 	}
 
 	// get the nonce from the account retriever and the pending transactions
@@ -1063,7 +1136,7 @@ func (api *PublicEthereumAPI) generateFromArgs(args rpctypes.SendTxArgs) (*evmty
 			Value:    args.Value,
 			Data:     &input,
 		}
-		gl, err := api.EstimateGas(callArgs)
+		gl, err := api.EstimateGas(callArgs, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -1162,3 +1235,46 @@ func (api *PublicEthereumAPI) accountNonce(
 
 	return nonce, nil
 }
+
+// queryState queries the evm state at the block number by the path. If the store of the historical block is pruned,
+// the state is served by the archive index of the node with the archive path, and archived is true
+func (api *PublicEthereumAPI) queryState(blockNum rpctypes.BlockNumber, path, archivePath string) (
+	res []byte, archived bool, err error) {
+	if blockNum == rpctypes.PendingBlockNumber || blockNum == rpctypes.LatestBlockNumber {
+		res, _, err = api.clientCtx.QueryWithData(path, nil)
+		return res, false, err
+	}
+
+	pruned, err := api.isStatePruned(blockNum)
+	if err != nil {
+		return nil, false, err
+	}
+	if !pruned {
+		res, _, err = api.clientCtx.WithHeight(blockNum.Int64()).QueryWithData(path, nil)
+		return res, false, err
+	}
+
+	// the archive index is queried at the latest height
+	res, _, err = api.clientCtx.QueryWithData(archivePath, nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("the state of block %d is pruned and not served by the archive index: %s",
+			blockNum, err.Error())
+	}
+	return res, true, nil
+}
+
+// isStatePruned returns true if the evm store of the block number is pruned. The store of a pruned height is loaded as
+// an empty store by the custom queries, so it's checked by the store query, which reports the missing version
+func (api *PublicEthereumAPI) isStatePruned(blockNum rpctypes.BlockNumber) (bool, error) {
+	if blockNum == rpctypes.PendingBlockNumber || blockNum == rpctypes.LatestBlockNumber {
+		return false, nil
+	}
+	res, err := api.clientCtx.WithHeight(blockNum.Int64()).QueryABCI(abci.RequestQuery{
+		Path: fmt.Sprintf("store/%s/key", evmtypes.StoreKey),
+		Data: evmtypes.KeyPrefixCode,
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.Log == iavl.ErrVersionDoesNotExist.Error(), nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/eth/filters"
 
 	rpctypes "github.com/okex/okexchain/app/rpc/types"
+	evmtypes "github.com/okex/okexchain/x/evm/types"
 )
 
 // Filter can be used to retrieve and filter logs.
@@ -19,6 +20,10 @@ type Filter struct {
 	backend  Backend
 	criteria filters.FilterCriteria
 	matcher  *bloombits.Matcher
+
+	// offset and limit of the logs, which are only supported by the log index
+	offset int
+	limit  int
 }
 
 // NewBlockFilter creates a new filter which directly inspects the contents of
@@ -73,6 +78,13 @@ func newFilter(backend Backend, criteria filters.FilterCriteria, matcher *bloomb
 	}
 }
 
+// WithPage sets the offset and the limit of the logs returned, which are served by the log index
+func (f *Filter) WithPage(offset, limit int) *Filter {
+	f.offset = offset
+	f.limit = limit
+	return f
+}
+
 // Logs searches the blockchain for matching log entries, returning all from the
 // first block that contains matches, updating the start of the filter accordingly.
 func (f *Filter) Logs(ctx context.Context) ([]*ethtypes.Log, error) {
@@ -116,6 +128,17 @@ func (f *Filter) Logs(ctx context.Context) ([]*ethtypes.Log, error) {
 
 	begin := f.criteria.FromBlock.Uint64()
 	end := f.criteria.ToBlock.Uint64()
+
+	// the range in the log index is served by it directly
+	if index := evmtypes.GetLogIndex(); index != nil {
+		if start := index.StartHeight(); start != 0 && f.criteria.FromBlock.Int64() >= start {
+			return f.logIndexLogs(index)
+		}
+	}
+	if f.offset != 0 || f.limit != 0 {
+		return nil, fmt.Errorf("the pagination is only supported in the range of the log index")
+	}
+
 	size, sections := f.backend.BloomStatus()
 	if indexed := sections*size + uint64(tmtypes.GetStartBlockHeight()); indexed > begin {
 		// update from block height
@@ -136,6 +159,28 @@ func (f *Filter) Logs(ctx context.Context) ([]*ethtypes.Log, error) {
 	return logs, err
 }
 
+// logIndexLogs returns the logs matching the filter criteria from the log index. Without the pagination, it fails if
+// there are more logs than the max results of the log index
+func (f *Filter) logIndexLogs(index *evmtypes.LogIndex) ([]*ethtypes.Log, error) {
+	filter := evmtypes.LogFilter{
+		FromBlock: f.criteria.FromBlock.Int64(),
+		ToBlock:   f.criteria.ToBlock.Int64(),
+		Addresses: f.criteria.Addresses,
+		Topics:    f.criteria.Topics,
+		Offset:    f.offset,
+		Limit:     f.limit,
+	}
+	// the blocks after the latest one indexed have no logs yet
+	if latest := index.LatestHeight(); filter.ToBlock > latest {
+		filter.ToBlock = latest
+	}
+	if filter.FromBlock > filter.ToBlock {
+		return []*ethtypes.Log{}, nil
+	}
+
+	return index.GetLogs(filter)
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(header *ethtypes.Header, hash common.Hash) ([]*ethtypes.Log, error) {
 	if !bloomFilter(header.Bloom, f.criteria.Addresses, f.criteria.Topics) {
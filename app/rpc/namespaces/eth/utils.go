@@ -3,7 +3,6 @@ package eth
 import (
 	"encoding/json"
 	"fmt"
-	"math/big"
 	"strings"
 
 	sdkerror "github.com/cosmos/cosmos-sdk/types/errors"
@@ -14,11 +13,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/core/vm"
 
-	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/ethereum/go-ethereum/common/hexutil"
-	ethermint "github.com/okex/okexchain/app/types"
-	"github.com/spf13/viper"
 )
 
 const (
@@ -34,17 +29,6 @@ const (
 	RPCNullData  = "null"
 )
 
-//gasPrice: to get "minimum-gas-prices" config or to get ethermint.DefaultGasPrice
-func ParseGasPrice() *hexutil.Big {
-	gasPrices, err := sdk.ParseDecCoins(viper.GetString(server.FlagMinGasPrices))
-	if err == nil && gasPrices != nil && len(gasPrices) > 0 {
-		return (*hexutil.Big)(gasPrices[0].Amount.BigInt())
-	}
-
-	//return the default gas price : DefaultGasPrice
-	return (*hexutil.Big)(sdk.NewDecFromBigIntWithPrec(big.NewInt(ethermint.DefaultGasPrice), sdk.Precision/2).BigInt())
-}
-
 type cosmosError struct {
 	Code      int    `json:"code"`
 	Log       string `json:"log"`
@@ -0,0 +1,176 @@
+package txpool
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	clientcontext "github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/okex/okexchain/app/rpc/backend"
+	rpctypes "github.com/okex/okexchain/app/rpc/types"
+)
+
+const (
+	pendingTxs = "pending"
+	queuedTxs  = "queued"
+)
+
+// PublicTxPoolAPI is the txpool_ prefixed set of APIs in the Web3 JSON-RPC spec.
+// The ethereum transactions in the tendermint mempool are pending if their nonces are continuous from the
+// nonce of sender, otherwise they are queued.
+// NOTE: only the first transactions of mempool are inspected because of the limit of tendermint rpc
+type PublicTxPoolAPI struct {
+	clientCtx clientcontext.CLIContext
+	logger    log.Logger
+	backend   backend.Backend
+}
+
+// NewAPI creates an instance of the TxPool API.
+func NewAPI(clientCtx clientcontext.CLIContext, backend backend.Backend) *PublicTxPoolAPI {
+	return &PublicTxPoolAPI{
+		clientCtx: clientCtx,
+		logger:    log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "json-rpc", "namespace", "txpool"),
+		backend:   backend,
+	}
+}
+
+// Content returns the transactions contained within the transaction pool, grouped by sender and nonce.
+func (api *PublicTxPoolAPI) Content() (map[string]map[string]map[string]*rpctypes.Transaction, error) {
+	api.logger.Debug("txpool_content")
+	content, err := api.getContent()
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]map[string]map[string]*rpctypes.Transaction{
+		pendingTxs: make(map[string]map[string]*rpctypes.Transaction),
+		queuedTxs:  make(map[string]map[string]*rpctypes.Transaction),
+	}
+	for status, accounts := range content {
+		for addr, txs := range accounts {
+			dump := make(map[string]*rpctypes.Transaction, len(txs))
+			for _, tx := range txs {
+				dump[fmt.Sprintf("%d", tx.Nonce)] = tx
+			}
+			result[status][addr.Hex()] = dump
+		}
+	}
+	return result, nil
+}
+
+// Inspect returns a textual summary of the transactions in the transaction pool, grouped by sender and nonce.
+func (api *PublicTxPoolAPI) Inspect() (map[string]map[string]map[string]string, error) {
+	api.logger.Debug("txpool_inspect")
+	content, err := api.getContent()
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]map[string]map[string]string{
+		pendingTxs: make(map[string]map[string]string),
+		queuedTxs:  make(map[string]map[string]string),
+	}
+	for status, accounts := range content {
+		for addr, txs := range accounts {
+			dump := make(map[string]string, len(txs))
+			for _, tx := range txs {
+				dump[fmt.Sprintf("%d", tx.Nonce)] = formatTx(tx)
+			}
+			result[status][addr.Hex()] = dump
+		}
+	}
+	return result, nil
+}
+
+// Status returns the number of pending and queued transactions in the transaction pool.
+func (api *PublicTxPoolAPI) Status() (map[string]hexutil.Uint, error) {
+	api.logger.Debug("txpool_status")
+	content, err := api.getContent()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]hexutil.Uint)
+	for _, status := range []string{pendingTxs, queuedTxs} {
+		count := 0
+		for _, txs := range content[status] {
+			count += len(txs)
+		}
+		result[status] = hexutil.Uint(count)
+	}
+	return result, nil
+}
+
+// getContent groups the ethereum transactions in the mempool by status and sender, the transactions of
+// a sender are sorted by nonce
+func (api *PublicTxPoolAPI) getContent() (map[string]map[common.Address][]*rpctypes.Transaction, error) {
+	txs, err := api.backend.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+	return groupContent(txs, api.accountNonce)
+}
+
+// groupContent splits the transactions of every sender into the pending ones, whose nonces are continuous
+// from the account nonce, and the queued ones after the first nonce gap
+func groupContent(txs []*rpctypes.Transaction, accountNonce func(common.Address) (uint64, error),
+) (map[string]map[common.Address][]*rpctypes.Transaction, error) {
+	senderTxs := make(map[common.Address][]*rpctypes.Transaction)
+	for _, tx := range txs {
+		senderTxs[tx.From] = append(senderTxs[tx.From], tx)
+	}
+
+	content := map[string]map[common.Address][]*rpctypes.Transaction{
+		pendingTxs: make(map[common.Address][]*rpctypes.Transaction),
+		queuedTxs:  make(map[common.Address][]*rpctypes.Transaction),
+	}
+	for addr, txs := range senderTxs {
+		sort.SliceStable(txs, func(i, j int) bool { return txs[i].Nonce < txs[j].Nonce })
+
+		nonce, err := accountNonce(addr)
+		if err != nil {
+			return nil, err
+		}
+		i := 0
+		for ; i < len(txs) && uint64(txs[i].Nonce) <= nonce; i++ {
+			if uint64(txs[i].Nonce) == nonce {
+				nonce++
+			}
+		}
+		if i > 0 {
+			content[pendingTxs][addr] = txs[:i]
+		}
+		if i < len(txs) {
+			content[queuedTxs][addr] = txs[i:]
+		}
+	}
+	return content, nil
+}
+
+// accountNonce returns the committed nonce of the account, 0 if the account doesn't exist
+func (api *PublicTxPoolAPI) accountNonce(address common.Address) (uint64, error) {
+	from := sdk.AccAddress(address.Bytes())
+	accRet := authtypes.NewAccountRetriever(api.clientCtx)
+	if err := accRet.EnsureExists(from); err != nil {
+		return 0, nil
+	}
+
+	_, nonce, err := accRet.GetAccountNumberSequence(from)
+	return nonce, err
+}
+
+// formatTx returns the summary of transaction in the same format as go-ethereum
+func formatTx(tx *rpctypes.Transaction) string {
+	if tx.To == nil {
+		return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei",
+			tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
+	}
+	return fmt.Sprintf("%s: %v wei + %v gas × %v wei",
+		tx.To.Hex(), tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt())
+}
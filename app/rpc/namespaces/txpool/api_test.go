@@ -0,0 +1,94 @@
+package txpool
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	rpctypes "github.com/okex/okexchain/app/rpc/types"
+)
+
+func newTestTx(from common.Address, nonce uint64) *rpctypes.Transaction {
+	return &rpctypes.Transaction{From: from, Nonce: hexutil.Uint64(nonce)}
+}
+
+func txNonces(txs []*rpctypes.Transaction) []uint64 {
+	if txs == nil {
+		return nil
+	}
+	nonces := make([]uint64, len(txs))
+	for i, tx := range txs {
+		nonces[i] = uint64(tx.Nonce)
+	}
+	return nonces
+}
+
+func TestGroupContent(t *testing.T) {
+	var (
+		gapSender       = common.HexToAddress("0x01")
+		duplicateSender = common.HexToAddress("0x02")
+		staleSender     = common.HexToAddress("0x03")
+		newSender       = common.HexToAddress("0x04")
+		newQueuedSender = common.HexToAddress("0x05")
+	)
+	// senders without an account have the nonce 0
+	accountNonces := map[common.Address]uint64{
+		gapSender:       5,
+		duplicateSender: 3,
+		staleSender:     10,
+	}
+	accountNonce := func(addr common.Address) (uint64, error) {
+		return accountNonces[addr], nil
+	}
+
+	txs := []*rpctypes.Transaction{
+		newTestTx(gapSender, 9),
+		newTestTx(gapSender, 5),
+		newTestTx(gapSender, 8),
+		newTestTx(gapSender, 6),
+		newTestTx(duplicateSender, 4),
+		newTestTx(duplicateSender, 3),
+		newTestTx(duplicateSender, 3),
+		newTestTx(staleSender, 12),
+		newTestTx(staleSender, 7),
+		newTestTx(newSender, 1),
+		newTestTx(newSender, 0),
+		newTestTx(newQueuedSender, 2),
+	}
+
+	content, err := groupContent(txs, accountNonce)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		sender  common.Address
+		pending []uint64
+		queued  []uint64
+	}{
+		{"nonce gap", gapSender, []uint64{5, 6}, []uint64{8, 9}},
+		{"duplicate nonces", duplicateSender, []uint64{3, 3, 4}, nil},
+		{"stale nonce", staleSender, []uint64{7}, []uint64{12}},
+		{"no account", newSender, []uint64{0, 1}, nil},
+		{"no account with nonce gap", newQueuedSender, nil, []uint64{2}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pending, ok := content[pendingTxs][tc.sender]
+			require.Equal(t, tc.pending != nil, ok)
+			require.Equal(t, tc.pending, txNonces(pending))
+
+			queued, ok := content[queuedTxs][tc.sender]
+			require.Equal(t, tc.queued != nil, ok)
+			require.Equal(t, tc.queued, txNonces(queued))
+		})
+	}
+
+	// the error of querying the account nonce is returned
+	_, err = groupContent(txs, func(common.Address) (uint64, error) {
+		return 0, errors.New("failed to query account")
+	})
+	require.Error(t, err)
+}
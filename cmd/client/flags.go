@@ -3,14 +3,34 @@ package client
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/okex/okexchain/app/rpc/backend"
+	backendtypes "github.com/okex/okexchain/x/backend/types"
 	evmtypes "github.com/okex/okexchain/x/evm/types"
 )
 
 const (
 	FlagPersonalAPI = "personal-api"
+	FlagDebugAPI    = evmtypes.FlagDebugAPI
 )
 
 func RegisterAppFlag(cmd *cobra.Command) {
 	cmd.Flags().Bool(FlagPersonalAPI, true, "Enable the the personal_ prefixed set of APIs in the Web3 JSON-RPC spec")
+	cmd.Flags().Bool(FlagDebugAPI, false, "Enable the debug_ prefixed set of APIs to trace the EVM transactions")
+	cmd.Flags().Int64(backend.FlagGasPriceOracleBlocks, backend.DefaultGasPriceOracleBlocks,
+		"Number of recent blocks sampled by the gas price oracle of eth_gasPrice")
+	cmd.Flags().Float64(backend.FlagGasPriceOraclePercentile, backend.DefaultGasPriceOraclePercentile,
+		"Percentile of the sampled gas prices suggested by the gas price oracle of eth_gasPrice")
 	cmd.Flags().Bool(evmtypes.FlagEnableBloomFilter, false, "enable bloom filter for logs")
+	cmd.Flags().Bool(evmtypes.FlagEnableArchiveIndex, false,
+		"Enable the archive index recording the per-block state diffs of evm to serve the queries of pruned heights")
+	cmd.Flags().Bool(evmtypes.FlagEnableLogIndex, false,
+		"Enable the log index of evm by address and topics to serve the range queries of eth_getLogs")
+	cmd.Flags().Int64(evmtypes.FlagLogIndexMaxRange, evmtypes.DefaultLogIndexMaxRange,
+		"Max number of blocks queried from the evm log index in a request")
+	cmd.Flags().Int(evmtypes.FlagLogIndexMaxResults, evmtypes.DefaultLogIndexMaxResults,
+		"Max number of logs returned from the evm log index in a request")
+	cmd.Flags().String(backendtypes.FlagKlineIntervals, backendtypes.DefaultKlineIntervals,
+		"kline intervals generated by backend, kline of 1m & 15m are always generated")
+	cmd.Flags().String(backendtypes.FlagArchiveDir, "",
+		"directory of the archives of expired backend data, default for data/backend_archive in node home")
 }
@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	backendcmd "github.com/okex/okexchain/x/backend/client/cli"
 	tokencmd "github.com/okex/okexchain/x/token/client/cli"
 
 	"github.com/okex/okexchain/app"
@@ -68,6 +69,7 @@ func main() {
 		sdkclient.ConfigCmd(app.DefaultCLIHome),
 		queryCmd(cdc),
 		txCmd(cdc),
+		backendcmd.GetBackendCmd(cdc),
 		client.ValidateChainID(
 			rpc.ServeCmd(cdc),
 		),
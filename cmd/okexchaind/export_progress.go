@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/okex/okexchain/x/evm"
+)
+
+const flagFollowInterval = "follow-interval"
+
+func exportProgressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evm-export-progress [path]",
+		Short: "Show the progress of the evm state export, import or verification in the path",
+		Long: `Show the progress of the evm state export, import or verification in the path, which is the
+evm-export-path or evm-import-path used by them. The current directory is used if the path is not given.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, err := os.Getwd()
+			if err != nil {
+				return err
+			}
+			if len(args) != 0 {
+				path = args[0]
+			}
+
+			interval := viper.GetDuration(flagFollowInterval)
+			for {
+				progress, err := evm.LoadExportProgress(path)
+				if err != nil {
+					return fmt.Errorf("failed to load the progress in %s: %s", path, err)
+				}
+				printExportProgress(progress)
+				if interval <= 0 || progress.Finished {
+					return nil
+				}
+				time.Sleep(interval)
+			}
+		},
+	}
+	cmd.Flags().Duration(flagFollowInterval, 0, "Keep reporting the progress at the interval until it's finished")
+	return cmd
+}
+
+func printExportProgress(progress evm.ExportProgress) {
+	percent := 100.0
+	if progress.Total != 0 {
+		percent = float64(progress.Done) * 100 / float64(progress.Total)
+	}
+	status := "running"
+	if progress.Finished {
+		status = "finished"
+	}
+
+	fmt.Printf("%s(%s) at height %d: %d/%d accounts (%.2f%%), %d resumed from the checkpoint, %s\n",
+		progress.Operation, progress.Mode, progress.Height, progress.Done, progress.Total, percent, progress.Skipped,
+		status)
+	if len(progress.Mismatched) != 0 {
+		fmt.Printf("%d accounts mismatched: %s\n", len(progress.Mismatched), strings.Join(progress.Mismatched, ", "))
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	evmtypes "github.com/okex/okexchain/x/evm/types"
+)
+
+const (
+	flagStartHeight = "start-height"
+	flagEndHeight   = "end-height"
+)
+
+func rebuildLogIndexCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebuild-evm-log-index",
+		Short: "Rebuild the evm log index of the existing blocks from local db",
+		Long: `Rebuild the evm log index of the existing blocks from the tx results in local db, which must be run
+when the node is stopped. The blocks rebuilt must be continuous with the blocks indexed, so the log index of an
+existing chain is built by starting the node with --enable-evm-log-index, and then rebuilding the blocks before.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return rebuildLogIndex(ctx, viper.GetInt64(flagStartHeight), viper.GetInt64(flagEndHeight))
+		},
+	}
+	cmd.Flags().Int64(flagStartHeight, 0, "The first height rebuilt, default for the first block in local db")
+	cmd.Flags().Int64(flagEndHeight, 0, "The last height rebuilt, default for the block before the indexed ones")
+	return cmd
+}
+
+// rebuildLogIndex indexes the logs of the blocks in [start, end] from the tx results saved in the state db
+func rebuildLogIndex(ctx *server.Context, start, end int64) error {
+	dataDir := filepath.Join(ctx.Config.RootDir, "data")
+	blockDB, err := openDB(blockStoreDB, dataDir)
+	if err != nil {
+		return err
+	}
+	defer blockDB.Close()
+	stateStoreDB, err := openDB(stateDB, dataDir)
+	if err != nil {
+		return err
+	}
+	defer stateStoreDB.Close()
+	indexDB, err := openDB(evmtypes.LogIndexDir, dataDir)
+	if err != nil {
+		return err
+	}
+	defer indexDB.Close()
+	index := evmtypes.NewLogIndex(indexDB, 0, 0)
+
+	blockStore := store.NewBlockStore(blockDB)
+	if start <= 0 {
+		start = blockStore.Base()
+		if start <= tmtypes.GetStartBlockHeight() {
+			start = tmtypes.GetStartBlockHeight() + 1
+		}
+	}
+	if end <= 0 {
+		end = blockStore.Height()
+		if indexed := index.StartHeight(); indexed != 0 {
+			end = indexed - 1
+		}
+	}
+	if start > end {
+		return fmt.Errorf("nothing to rebuild in [%d, %d]", start, end)
+	}
+
+	log.Printf("rebuilding the evm log index of the blocks [%d, %d]\n", start, end)
+	for height := start; height <= end; height++ {
+		responses, err := sm.LoadABCIResponses(stateStoreDB, height)
+		if err != nil {
+			return fmt.Errorf("failed to load the tx results of block %d: %s", height, err)
+		}
+
+		var logs []*ethtypes.Log
+		for _, res := range responses.DeliverTxs {
+			if !res.IsOK() {
+				continue
+			}
+			// the txs which are not evm txs fail to be decoded
+			data, err := evmtypes.DecodeResultData(res.Data)
+			if err != nil {
+				continue
+			}
+			logs = append(logs, data.Logs...)
+		}
+		if err := index.IndexBlock(height, logs); err != nil {
+			return err
+		}
+		if height%10000 == 0 {
+			log.Printf("rebuilt to block %d\n", height)
+		}
+	}
+
+	if err := index.ExtendRange(start, end); err != nil {
+		return err
+	}
+	log.Printf("rebuilt the evm log index of the blocks [%d, %d]\n", start, end)
+	return nil
+}
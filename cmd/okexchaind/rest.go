@@ -19,6 +19,9 @@ import (
 	dist "github.com/okex/okexchain/x/distribution"
 	distr "github.com/okex/okexchain/x/distribution"
 	distrest "github.com/okex/okexchain/x/distribution/client/rest"
+	erc20client "github.com/okex/okexchain/x/erc20/client"
+	erc20rest "github.com/okex/okexchain/x/erc20/client/rest"
+	evmclient "github.com/okex/okexchain/x/evm/client"
 	evmrest "github.com/okex/okexchain/x/evm/client/rest"
 	farmclient "github.com/okex/okexchain/x/farm/client"
 	farmrest "github.com/okex/okexchain/x/farm/client/rest"
@@ -60,12 +63,16 @@ func registerRoutesV1(rs *lcd.RestServer, pathPrefix string) {
 	supplyrest.RegisterRoutes(rs.CliCtx, v1Router)
 	farmrest.RegisterRoutes(rs.CliCtx, v1Router)
 	evmrest.RegisterRoutes(rs.CliCtx, v1Router)
+	erc20rest.RegisterRoutes(rs.CliCtx, v1Router)
 	govrest.RegisterRoutes(rs.CliCtx, v1Router,
 		[]govrest.ProposalRESTHandler{
 			paramsclient.ProposalHandler.RESTHandler(rs.CliCtx),
 			distr.ProposalHandler.RESTHandler(rs.CliCtx),
 			dexclient.DelistProposalHandler.RESTHandler(rs.CliCtx),
 			farmclient.ManageWhiteListProposalHandler.RESTHandler(rs.CliCtx),
+			evmclient.ManageContractDeploymentWhitelistProposalHandler.RESTHandler(rs.CliCtx),
+			evmclient.ManageContractBlockedListProposalHandler.RESTHandler(rs.CliCtx),
+			erc20client.TokenMappingProposalHandler.RESTHandler(rs.CliCtx),
 		},
 	)
 }
@@ -24,6 +24,7 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 		storeTransactions(keeper)
 		storeSwapInfos(keeper)
 		storeClaimInfos(keeper)
+		storeBalanceChanges(ctx, keeper)
 		keeper.EmitAllWsItems(ctx)
 		// refresh cache
 		keeper.Flush()
@@ -43,6 +44,26 @@ func storeSwapInfos(keeper Keeper) {
 	}
 }
 
+func storeBalanceChanges(ctx sdk.Context, keeper Keeper) {
+	defer types.PrintStackIfPanic()
+	deals, _, err := GetNewDealsAndMatchResultsAtEndBlock(ctx, keeper.OrderKeeper)
+	if err != nil {
+		keeper.Logger.Error(fmt.Sprintf("[backend] failed to GetNewDealsAndMatchResultsAtEndBlock, error: %s", err.Error()))
+	}
+
+	balanceChanges := types.GenerateBalanceChanges(ctx.BlockHeight(), ctx.BlockTime().Unix(),
+		keeper.Cache.GetTransactions(), deals, keeper.Cache.GetSwapInfos(), keeper.Cache.GetClaimInfos(),
+		keeper.TokenKeeper.GetFeeDetailList())
+	if len(balanceChanges) > 0 {
+		cnt, err := keeper.Orm.AddBalanceChanges(balanceChanges)
+		if err != nil {
+			keeper.Logger.Error(fmt.Sprintf("[backend] Expect to insert %d balanceChanges, inserted Count %d, err: %+v", len(balanceChanges), cnt, err))
+		} else {
+			keeper.Logger.Debug(fmt.Sprintf("[backend] Expect to insert %d balanceChanges, inserted Count %d", len(balanceChanges), cnt))
+		}
+	}
+}
+
 func storeTransactions(keeper Keeper) {
 	defer types.PrintStackIfPanic()
 
@@ -33,17 +33,19 @@ type (
 	ORM           = orm.ORM
 	OrmEngineInfo = orm.OrmEngineInfo
 
-	Config    = config.Config
-	SwapInfo  = types.SwapInfo
-	ClaimInfo = types.ClaimInfo
+	Config        = config.Config
+	SwapInfo      = types.SwapInfo
+	ClaimInfo     = types.ClaimInfo
+	BalanceChange = types.BalanceChange
 )
 
 var (
-	NewQuerier    = keeper.NewQuerier
-	NewKeeper     = keeper.NewKeeper
-	CleanUpKlines = keeper.CleanUpKlines
+	NewQuerier         = keeper.NewQuerier
+	NewKeeper          = keeper.NewKeeper
+	CleanUpExpiredData = keeper.CleanUpExpiredData
 
-	GenerateTx = types.GenerateTx
+	GenerateTx             = types.GenerateTx
+	GenerateBalanceChanges = types.GenerateBalanceChanges
 
 	NewORM = orm.New
 
@@ -0,0 +1,183 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/okex/okexchain/x/backend/config"
+	"github.com/okex/okexchain/x/backend/orm"
+	"github.com/okex/okexchain/x/backend/types"
+	"github.com/spf13/cobra"
+)
+
+// nolint
+const (
+	flagEngineType = "engine-type"
+	flagConnectStr = "connect-str"
+	flagProduct    = "product"
+	flagFrom       = "from"
+	flagTo         = "to"
+	flagSource     = "source"
+	flagIntervals  = "intervals"
+	flagManifest   = "manifest"
+)
+
+// GetBackendCmd returns the commands for maintaining the backend database
+func GetBackendCmd(cdc *codec.Codec) *cobra.Command {
+	backendCmd := &cobra.Command{
+		Use:   "backend",
+		Short: "Maintaining commands for the backend database",
+	}
+
+	backendCmd.AddCommand(
+		GetCmdRebuildKlines(cdc),
+		GetCmdImportArchive(cdc),
+	)
+
+	return backendCmd
+}
+
+// GetCmdRebuildKlines recomputes klines & ticker of a product from the deals or match results in database
+func GetCmdRebuildKlines(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebuild-klines",
+		Short: "recompute klines and ticker of a product from deals or match results",
+		Long: `Recompute klines and ticker of a product from deals or match results in the backend database.
+The time range is expanded to the boundaries of the longest kline interval, and the klines in the range are replaced,
+so it's safe to run the command repeatedly. It should be run while the node is stopped or the time range is sealed.
+
+Example:
+$ okexchaincli backend rebuild-klines --product btc-000_okt --from 1609430400 --to 1612108800 \
+	--engine-type mysql --connect-str "user:password@tcp(127.0.0.1:3306)/backend?charset=utf8mb4&parseTime=True"
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			engineType, errEngineType := flags.GetString(flagEngineType)
+			connectStr, errConnectStr := flags.GetString(flagConnectStr)
+			product, errProduct := flags.GetString(flagProduct)
+			from, errFrom := flags.GetInt64(flagFrom)
+			to, errTo := flags.GetInt64(flagTo)
+			source, errSource := flags.GetString(flagSource)
+			intervals, errIntervals := flags.GetString(flagIntervals)
+
+			mError := types.NewErrorsMerged(errEngineType, errConnectStr, errProduct, errFrom, errTo, errSource, errIntervals)
+			if mError != nil {
+				return mError
+			}
+
+			engineInfo := orm.OrmEngineInfo{EngineType: engineType, ConnectStr: connectStr}
+			if engineInfo.ConnectStr == "" {
+				engineInfo.ConnectStr = config.DefaultConfig().OrmEngine.ConnectStr
+			}
+			if err := config.ValidateOrmEngine(&engineInfo); err != nil {
+				return err
+			}
+
+			klineMap, err := types.ParseKlineIntervals(intervals)
+			if err != nil {
+				return err
+			}
+			klineTypes := []string{}
+			for _, klineType := range klineMap {
+				klineTypes = append(klineTypes, klineType)
+			}
+
+			o, err := orm.New(false, &engineInfo, nil)
+			if err != nil {
+				return err
+			}
+			defer o.Close()
+
+			resultMap, err := o.RebuildKlines(product, from, to, source, klineTypes)
+			if err != nil {
+				return err
+			}
+
+			tickers, err := o.RefreshTickers(to-types.SecondsInADay, to, []string{product})
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(map[string]interface{}{
+				"klines": resultMap,
+				"ticker": tickers[product],
+			}, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(bz))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagEngineType, config.EngineTypeSqlite, "engine type of backend database, sqlite3|mysql|postgres")
+	cmd.Flags().String(flagConnectStr, "", "connect string of backend database, default for the sqlite3 database in node home")
+	cmd.Flags().StringP(flagProduct, "p", "", "name of token pair")
+	cmd.Flags().Int64(flagFrom, 0, "start time of klines, unix timestamp in second")
+	cmd.Flags().Int64(flagTo, 0, "end time of klines, unix timestamp in second")
+	cmd.Flags().String(flagSource, types.KlineSourceMatchResults, "data source of klines, match_results|deals")
+	cmd.Flags().String(flagIntervals, types.DefaultKlineIntervals, "kline intervals to rebuild, separated by comma")
+	return cmd
+}
+
+// GetCmdImportArchive restores the rows of an archive created by the retention policy into database
+func GetCmdImportArchive(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-archive",
+		Short: "restore the rows of an archive of expired data into the backend database",
+		Long: `Restore the rows of an archive of expired data into the backend database.
+The checksum of the archive is verified against its manifest, and the rows of the table in the time range of the archive
+are replaced, so it's safe to import an archive repeatedly.
+
+Example:
+$ okexchaincli backend import-archive --manifest ~/.okexchaind/data/backend_archive/deals/deals_1609430400_1612108800.manifest.json \
+	--engine-type mysql --connect-str "user:password@tcp(127.0.0.1:3306)/backend?charset=utf8mb4&parseTime=True"
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			engineType, errEngineType := flags.GetString(flagEngineType)
+			connectStr, errConnectStr := flags.GetString(flagConnectStr)
+			manifestPath, errManifest := flags.GetString(flagManifest)
+
+			mError := types.NewErrorsMerged(errEngineType, errConnectStr, errManifest)
+			if mError != nil {
+				return mError
+			}
+			if manifestPath == "" {
+				return fmt.Errorf("manifest of archive is required")
+			}
+
+			engineInfo := orm.OrmEngineInfo{EngineType: engineType, ConnectStr: connectStr}
+			if engineInfo.ConnectStr == "" {
+				engineInfo.ConnectStr = config.DefaultConfig().OrmEngine.ConnectStr
+			}
+			if err := config.ValidateOrmEngine(&engineInfo); err != nil {
+				return err
+			}
+
+			o, err := orm.New(false, &engineInfo, nil)
+			if err != nil {
+				return err
+			}
+			defer o.Close()
+
+			manifest, err := o.ImportArchive(manifestPath)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(manifest, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(bz))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagEngineType, config.EngineTypeSqlite, "engine type of backend database, sqlite3|mysql|postgres")
+	cmd.Flags().String(flagConnectStr, "", "connect string of backend database, default for the sqlite3 database in node home")
+	cmd.Flags().String(flagManifest, "", "path of the manifest file of the archive")
+	return cmd
+}
@@ -0,0 +1,64 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+	"github.com/okex/okexchain/x/backend/types"
+	"github.com/okex/okexchain/x/common"
+)
+
+func registerGraphQLRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/graphql", graphQLHandler(cliCtx)).Methods("GET", "POST")
+}
+
+// graphQLHandler forwards the graphql request to backend, which resolves it against the backend db and keepers.
+// The request is accepted as json body by POST, or as url parameters query, operationName & variables by GET
+func graphQLHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var request types.GraphQLRequest
+		if r.Method == http.MethodGet {
+			request.Query = r.URL.Query().Get("query")
+			request.OperationName = r.URL.Query().Get("operationName")
+			if variables := r.URL.Query().Get("variables"); variables != "" {
+				if err := json.Unmarshal([]byte(variables), &request.Variables); err != nil {
+					common.HandleErrorMsg(w, cliCtx, common.CodeUnMarshalJSONFailed, err.Error())
+					return
+				}
+			}
+		} else {
+			body, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				common.HandleErrorMsg(w, cliCtx, common.CodeInvalidParam, err.Error())
+				return
+			}
+			if err := json.Unmarshal(body, &request); err != nil {
+				common.HandleErrorMsg(w, cliCtx, common.CodeUnMarshalJSONFailed, err.Error())
+				return
+			}
+		}
+		if request.Query == "" {
+			common.HandleErrorMsg(w, cliCtx, common.CodeMissingRequiredParam, "graphql query is required")
+			return
+		}
+
+		bz, err := json.Marshal(request)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeMarshalJSONFailed, err.Error())
+			return
+		}
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/backend/%s", types.QueryGraphQL), bz)
+		if err != nil {
+			sdkErr := common.ParseSDKError(err.Error())
+			common.HandleErrorMsg(w, cliCtx, sdkErr.Code, sdkErr.Message)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(res)
+	}
+}
@@ -36,6 +36,9 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 
 	// register farm rest
 	registerFarmQueryRoutes(cliCtx, r)
+
+	// register graphql endpoint
+	registerGraphQLRoutes(cliCtx, r)
 }
 
 func candleHandler(cliCtx context.CLIContext) http.HandlerFunc {
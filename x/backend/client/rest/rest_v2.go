@@ -33,6 +33,9 @@ func RegisterRoutesV2(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/fees", feesHandlerV2(cliCtx)).Methods("GET")
 	r.HandleFunc("/deals", dealsHandlerV2(cliCtx)).Methods("GET")
 	r.HandleFunc("/transactions", txListHandlerV2(cliCtx)).Methods("GET")
+	r.HandleFunc("/accounts/{address}/balances", balancesHandlerV2(cliCtx)).Methods("GET")
+	r.HandleFunc("/accounts/{address}/balances/history", balanceHistoryHandlerV2(cliCtx)).Methods("GET")
+	r.HandleFunc("/accounts/{address}/pnl", pnlHandlerV2(cliCtx)).Methods("GET")
 }
 
 func txListHandlerV2(cliCtx context.CLIContext) http.HandlerFunc {
@@ -434,3 +437,103 @@ func orderHandlerV2(cliCtx context.CLIContext) http.HandlerFunc {
 		common.HandleSuccessResponseV2(w, res)
 	}
 }
+
+func balancesHandlerV2(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		address := mux.Vars(r)["address"]
+		height := r.URL.Query().Get("height")
+
+		// validate request
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidAddress)
+			return
+		}
+		// default the latest height. The balances of a past height are rolled back only with the tracked balance
+		// changes, and are returned with complete=false
+		var heightInt int64
+		if height != "" {
+			var err error
+			if heightInt, err = strconv.ParseInt(height, 10, 64); err != nil || heightInt < 0 {
+				common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidParam)
+				return
+			}
+		}
+
+		params := types.QueryBalancesParamsV2{
+			Address: address,
+			Height:  heightInt,
+		}
+		req := cliCtx.Codec.MustMarshalJSON(params)
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/backend/%s", types.QueryBalancesV2), req)
+		common.HandleResponseV2(w, res, err)
+	}
+}
+
+func balanceHistoryHandlerV2(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		address := mux.Vars(r)["address"]
+		currency := r.URL.Query().Get("currency")
+		after := r.URL.Query().Get("after")
+		before := r.URL.Query().Get("before")
+		limit := r.URL.Query().Get("limit")
+
+		// validate request, after & before are block heights
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidAddress)
+			return
+		}
+		if after != "" {
+			if _, err := strconv.Atoi(after); err != nil {
+				common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidParam)
+				return
+			}
+		}
+		if before != "" {
+			if _, err := strconv.Atoi(before); err != nil {
+				common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidParam)
+				return
+			}
+		}
+		// default limit 100
+		if limit == "" {
+			limit = defaultLimit
+		}
+		limitInt, err := strconv.Atoi(limit)
+		if err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidParam)
+			return
+		}
+
+		params := types.QueryBalanceHistoryParamsV2{
+			Address:  address,
+			Currency: currency,
+			After:    after,
+			Before:   before,
+			Limit:    limitInt,
+		}
+		req := cliCtx.Codec.MustMarshalJSON(params)
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/backend/%s", types.QueryBalanceHistoryV2), req)
+		common.HandleResponseV2(w, res, err)
+	}
+}
+
+func pnlHandlerV2(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		address := mux.Vars(r)["address"]
+		product := r.URL.Query().Get("instrument_id")
+
+		// validate request
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidAddress)
+			return
+		}
+
+		params := types.QueryPnLParamsV2{
+			Address: address,
+			Product: product,
+		}
+		req := cliCtx.Codec.MustMarshalJSON(params)
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/backend/%s", types.QueryPnLV2), req)
+		common.HandleResponseV2(w, res, err)
+	}
+}
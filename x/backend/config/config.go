@@ -2,12 +2,14 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 
 	okexchaincfg "github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/okex/okexchain/x/backend/types"
 )
 
 // nolint
@@ -18,11 +20,56 @@ var (
 	DefaultTestConfig      = filepath.Join(DefaultNodeHome, "test_config")
 	DefaultTestDataHome    = filepath.Join(DefaultNodeHome, "test_data")
 	DefaultConfig          = okexchaincfg.DefaultBackendConfig
+	DefaultArchiveDir      = filepath.Join(DefaultNodeHome, "data", "backend_archive")
+)
+
+// nolint
+const (
+	EngineTypeSqlite   = okexchaincfg.BackendOrmEngineTypeSqlite
+	EngineTypeMysql    = okexchaincfg.BackendOrmEngineTypeMysql
+	EngineTypePostgres = "postgres"
 )
 
 // nolint
 type Config = okexchaincfg.BackendConfig
 
+// ValidateOrmEngine checks the engine type and connect string of the backend orm.
+// if engine_type is postgres, connect_str should be a libpq connection string or url,
+// e.g.) "host=127.0.0.1 port=5432 user=okexchain dbname=backend sslmode=disable"
+func ValidateOrmEngine(engineInfo *okexchaincfg.BackendOrmEngineInfo) error {
+	switch engineInfo.EngineType {
+	case EngineTypeSqlite, EngineTypeMysql, EngineTypePostgres:
+	default:
+		return fmt.Errorf("unsupported orm engine type: %s, should be one of %s, %s or %s",
+			engineInfo.EngineType, EngineTypeSqlite, EngineTypeMysql, EngineTypePostgres)
+	}
+
+	if engineInfo.ConnectStr == "" {
+		return errors.New("connect string of orm engine is empty")
+	}
+	return nil
+}
+
+// ValidateRetention checks clean_ups_kept_days, which is the days that rows are kept in each table before archived
+// and deleted. 0 means the rows of the table are kept forever
+func ValidateRetention(keptDays map[string]int) error {
+	tables := map[string]struct{}{}
+	for _, table := range types.GetRetentionTables() {
+		tables[table] = struct{}{}
+	}
+
+	for table, days := range keptDays {
+		if _, ok := tables[table]; !ok {
+			return fmt.Errorf("unsupported table in clean_ups_kept_days: %s, should be one of %v",
+				table, types.GetRetentionTables())
+		}
+		if days < 0 {
+			return fmt.Errorf("kept days of %s should not be negative: %d", table, days)
+		}
+	}
+	return nil
+}
+
 func loadMaintainConf(confDir string, fileName string) (*Config, error) {
 	fPath := confDir + string(os.PathSeparator) + fileName
 	if _, err := os.Stat(fPath); err != nil {
@@ -63,6 +110,13 @@ func SafeLoadMaintainConfig(configDir string) (conf *Config, err error) {
 			return nil, err
 		}
 	}
+
+	if err = ValidateOrmEngine(&maintainConf.OrmEngine); err != nil {
+		return nil, err
+	}
+	if err = ValidateRetention(maintainConf.CleanUpsKeptDays); err != nil {
+		return nil, err
+	}
 	return maintainConf, nil
 }
 
@@ -40,3 +40,26 @@ func TestConf(t *testing.T) {
 	config, err := SafeLoadMaintainConfig(DefaultTestConfig)
 	assert.True(t, config != nil && err == nil)
 }
+
+func TestValidateOrmEngine(t *testing.T) {
+	engineInfo := DefaultConfig().OrmEngine
+	require.Nil(t, ValidateOrmEngine(&engineInfo))
+
+	engineInfo.EngineType = EngineTypePostgres
+	engineInfo.ConnectStr = "host=127.0.0.1 port=5432 user=okexchain dbname=backend sslmode=disable"
+	require.Nil(t, ValidateOrmEngine(&engineInfo))
+
+	engineInfo.ConnectStr = ""
+	require.NotNil(t, ValidateOrmEngine(&engineInfo))
+
+	engineInfo.EngineType = "oracle"
+	engineInfo.ConnectStr = "whatever"
+	require.NotNil(t, ValidateOrmEngine(&engineInfo))
+}
+
+func TestValidateRetention(t *testing.T) {
+	require.Nil(t, ValidateRetention(DefaultConfig().CleanUpsKeptDays))
+	require.Nil(t, ValidateRetention(map[string]int{"deals": 30, "orders": 0, "kline_m60": 365}))
+	require.NotNil(t, ValidateRetention(map[string]int{"deals": -1}))
+	require.NotNil(t, ValidateRetention(map[string]int{"accounts": 30}))
+}
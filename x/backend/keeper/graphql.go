@@ -0,0 +1,845 @@
+package keeper
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/okex/okexchain/x/ammswap"
+	"github.com/okex/okexchain/x/backend/orm"
+	"github.com/okex/okexchain/x/backend/types"
+	"github.com/okex/okexchain/x/common"
+	farmtypes "github.com/okex/okexchain/x/farm/types"
+	"github.com/okex/okexchain/x/token"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	graphQLMaxFirst = 1000
+	graphQLMaxDepth = 10
+
+	cursorKindKeyset    = "keyset"
+	cursorKindName      = "name"
+	cursorKindTimestamp = "timestamp"
+)
+
+const graphQLSchema = `
+schema {
+	query: Query
+}
+
+# Long is a 64-bit integer, e.g. a timestamp, a block height or a count
+scalar Long
+
+type Query {
+	orders(address: String!, product: String, side: String, open: Boolean = true, startTime: Long = 0, endTime: Long = 0,
+		first: Int = 100, after: String): OrderConnection!
+	deals(address: String, product: String, side: String, startTime: Long = 0, endTime: Long = 0,
+		first: Int = 100, after: String): DealConnection!
+	matches(product: String, startTime: Long = 0, endTime: Long = 0, first: Int = 100, after: String): MatchResultConnection!
+	transactions(address: String!, type: Int = 0, startTime: Long = 0, endTime: Long = 0,
+		first: Int = 100, after: String): TransactionConnection!
+	klines(product: String!, granularity: Int = 60, first: Int = 100, after: String): KlineConnection!
+	tickers(products: [String!]): [Ticker!]!
+	swapPairs(first: Int = 100, after: String): SwapPairConnection!
+	swapLiquidity(address: String!, pair: String): [SwapLiquidity!]!
+	farmPools(first: Int = 100, after: String): FarmPoolConnection!
+	tokens(first: Int = 100, after: String): TokenConnection!
+}
+
+type PageInfo {
+	endCursor: String
+	hasNextPage: Boolean!
+}
+
+type Order {
+	txHash: String!
+	orderId: String!
+	sender: String!
+	product: String!
+	side: String!
+	price: String!
+	quantity: String!
+	status: Int!
+	filledAvgPrice: String!
+	remainQuantity: String!
+	timestamp: Long!
+}
+
+type OrderConnection {
+	nodes: [Order!]!
+	pageInfo: PageInfo!
+	totalCount: Long
+}
+
+type Deal {
+	blockHeight: Long!
+	orderId: String!
+	sender: String!
+	product: String!
+	side: String!
+	price: Float!
+	quantity: Float!
+	fee: String!
+	feeReceiver: String!
+	timestamp: Long!
+}
+
+type DealConnection {
+	nodes: [Deal!]!
+	pageInfo: PageInfo!
+	totalCount: Long
+}
+
+type MatchResult {
+	blockHeight: Long!
+	product: String!
+	price: Float!
+	quantity: Float!
+	timestamp: Long!
+}
+
+type MatchResultConnection {
+	nodes: [MatchResult!]!
+	pageInfo: PageInfo!
+	totalCount: Long
+}
+
+type Transaction {
+	txHash: String!
+	type: Int!
+	address: String!
+	symbol: String!
+	side: Int!
+	quantity: String!
+	fee: String!
+	timestamp: Long!
+}
+
+type TransactionConnection {
+	nodes: [Transaction!]!
+	pageInfo: PageInfo!
+	totalCount: Long
+}
+
+type Kline {
+	timestamp: Long!
+	open: Float!
+	close: Float!
+	high: Float!
+	low: Float!
+	volume: Float!
+}
+
+type KlineConnection {
+	nodes: [Kline!]!
+	pageInfo: PageInfo!
+	totalCount: Long
+}
+
+type Ticker {
+	product: String!
+	symbol: String!
+	timestamp: Long!
+	open: Float!
+	close: Float!
+	high: Float!
+	low: Float!
+	price: Float!
+	volume: Float!
+	change: Float!
+	changePercentage: String!
+}
+
+type SwapPair {
+	name: String!
+	basePooledCoin: String!
+	quotePooledCoin: String!
+	poolTokenName: String!
+}
+
+type SwapPairConnection {
+	nodes: [SwapPair!]!
+	pageInfo: PageInfo!
+	totalCount: Long
+}
+
+type SwapLiquidity {
+	basePooledCoin: String!
+	quotePooledCoin: String!
+	poolTokenCoin: String!
+	poolTokenRatio: String!
+}
+
+type FarmPool {
+	name: String!
+	owner: String!
+	minLockAmount: String!
+	depositAmount: String!
+	totalValueLocked: String!
+	totalAccumulatedRewards: String!
+}
+
+type FarmPoolConnection {
+	nodes: [FarmPool!]!
+	pageInfo: PageInfo!
+	totalCount: Long
+}
+
+type Token {
+	symbol: String!
+	originalSymbol: String!
+	wholeName: String!
+	description: String!
+	originalTotalSupply: String!
+	type: Int!
+	owner: String!
+	mintable: Boolean!
+}
+
+type TokenConnection {
+	nodes: [Token!]!
+	pageInfo: PageInfo!
+	totalCount: Long
+}
+`
+
+var (
+	graphQLSchemaOnce   sync.Once
+	parsedGraphQLSchema *graphql.Schema
+)
+
+// getGraphQLSchema parses the schema once, the resolvers are stateless and take sdk.Context & Keeper from context.
+// Resolvers are executed one by one because sdk.Context is not safe for concurrent use
+func getGraphQLSchema() *graphql.Schema {
+	graphQLSchemaOnce.Do(func() {
+		parsedGraphQLSchema = graphql.MustParseSchema(graphQLSchema, &graphQLResolver{},
+			graphql.MaxDepth(graphQLMaxDepth), graphql.MaxParallelism(1))
+	})
+	return parsedGraphQLSchema
+}
+
+type graphQLContextKey struct{}
+
+type graphQLContext struct {
+	ctx    sdk.Context
+	keeper Keeper
+}
+
+func fromGraphQLContext(ctx context.Context) graphQLContext {
+	return ctx.Value(graphQLContextKey{}).(graphQLContext)
+}
+
+func queryGraphQL(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var request types.GraphQLRequest
+	if err := json.Unmarshal(req.Data, &request); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+	if strings.TrimSpace(request.Query) == "" {
+		return nil, sdk.ErrUnknownRequest("graphql query is empty")
+	}
+
+	goCtx := context.WithValue(context.Background(), graphQLContextKey{}, graphQLContext{ctx: ctx, keeper: keeper})
+	response := getGraphQLSchema().Exec(goCtx, request.Query, request.OperationName, request.Variables)
+	res, err := json.Marshal(response)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return res, nil
+}
+
+// encodeCursor returns an opaque cursor of kind, e.g.) the keyset of the last row of a page or the timestamp of a kline
+func encodeCursor(kind string, value string) *string {
+	cursor := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", kind, value)))
+	return &cursor
+}
+
+func decodeCursor(kind string, cursor *string) (string, error) {
+	if cursor == nil || *cursor == "" {
+		return "", nil
+	}
+	bz, err := base64.StdEncoding.DecodeString(*cursor)
+	if err != nil {
+		return "", fmt.Errorf("invalid cursor: %s", *cursor)
+	}
+	prefix := kind + ":"
+	if !strings.HasPrefix(string(bz), prefix) {
+		return "", fmt.Errorf("invalid cursor: %s", *cursor)
+	}
+	return string(bz)[len(prefix):], nil
+}
+
+func decodeTimestampCursor(cursor *string) (int64, error) {
+	value, err := decodeCursor(cursorKindTimestamp, cursor)
+	if err != nil || value == "" {
+		return 0, err
+	}
+	timestamp, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || timestamp < 0 {
+		return 0, fmt.Errorf("invalid cursor: %s", *cursor)
+	}
+	return timestamp, nil
+}
+
+// decodeKeysetCursor returns the keyset of the last row of the previous page, nil for the first page
+func decodeKeysetCursor(cursor *string) (*orm.KeysetCursor, error) {
+	value, err := decodeCursor(cursorKindKeyset, cursor)
+	if err != nil || value == "" {
+		return nil, err
+	}
+	var keyset orm.KeysetCursor
+	if err := json.Unmarshal([]byte(value), &keyset); err != nil || keyset.Skip < 0 {
+		return nil, fmt.Errorf("invalid cursor: %s", *cursor)
+	}
+	return &keyset, nil
+}
+
+func encodeKeysetCursor(keyset orm.KeysetCursor) *string {
+	bz, _ := json.Marshal(keyset)
+	return encodeCursor(cursorKindKeyset, string(bz))
+}
+
+// graphQLLong is the Long scalar of the schema, which holds the int64 values overflowing the 32-bit Int of graphql
+type graphQLLong int64
+
+// ImplementsGraphQLType maps graphQLLong to the Long scalar
+func (graphQLLong) ImplementsGraphQLType(name string) bool { return name == "Long" }
+
+// UnmarshalGraphQL decodes a Long argument, which is an integer literal or variable, or a decimal string
+func (l *graphQLLong) UnmarshalGraphQL(input interface{}) error {
+	switch input := input.(type) {
+	case int32:
+		*l = graphQLLong(input)
+	case int64:
+		*l = graphQLLong(input)
+	case float64:
+		if input != float64(int64(input)) {
+			return fmt.Errorf("%v is not a Long", input)
+		}
+		*l = graphQLLong(input)
+	case string:
+		value, err := strconv.ParseInt(input, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%s is not a Long", input)
+		}
+		*l = graphQLLong(value)
+	default:
+		return fmt.Errorf("wrong type for Long: %T", input)
+	}
+	return nil
+}
+
+// MarshalJSON encodes a Long as a json number
+func (l graphQLLong) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(int64(l), 10)), nil
+}
+
+// pageArgs is the cursor pagination arguments of a connection
+type pageArgs struct {
+	First int32
+	After *string
+}
+
+func (args pageArgs) limit() (int, error) {
+	if args.First <= 0 || args.First > graphQLMaxFirst {
+		return 0, fmt.Errorf("first should be in (0, %d]", graphQLMaxFirst)
+	}
+	return int(args.First), nil
+}
+
+// keysetPage returns the keyset & limit decoded from a keyset cursor
+func (args pageArgs) keysetPage() (after *orm.KeysetCursor, limit int, err error) {
+	if limit, err = args.limit(); err != nil {
+		return nil, 0, err
+	}
+	if after, err = decodeKeysetCursor(args.After); err != nil {
+		return nil, 0, err
+	}
+	return after, limit, nil
+}
+
+// sliceNamePage returns the [start, end) of a page in a list sorted by name, after the name in the cursor
+func (args pageArgs) sliceNamePage(total int, name func(i int) string) (start int, end int, err error) {
+	limit, err := args.limit()
+	if err != nil {
+		return 0, 0, err
+	}
+	after, err := decodeCursor(cursorKindName, args.After)
+	if err != nil {
+		return 0, 0, err
+	}
+	if after != "" {
+		start = sort.Search(total, func(i int) bool { return name(i) > after })
+	}
+	end = start + limit
+	if end > total {
+		end = total
+	}
+	return start, end, nil
+}
+
+type pageInfoResolver struct {
+	endCursor   *string
+	hasNextPage bool
+}
+
+func (r pageInfoResolver) EndCursor() *string { return r.endCursor }
+func (r pageInfoResolver) HasNextPage() bool  { return r.hasNextPage }
+
+type connectionResolver struct {
+	pageInfo   pageInfoResolver
+	totalCount *graphQLLong
+}
+
+func newConnection(endCursor *string, hasNextPage bool, total int) connectionResolver {
+	totalCount := graphQLLong(total)
+	return connectionResolver{
+		pageInfo:   pageInfoResolver{endCursor: endCursor, hasNextPage: hasNextPage},
+		totalCount: &totalCount,
+	}
+}
+
+// newNameConnection returns the connection of the page [start, end) in a list sorted by name
+func newNameConnection(start, end, total int, name func(i int) string) connectionResolver {
+	if start == end {
+		return newConnection(nil, false, total)
+	}
+	return newConnection(encodeCursor(cursorKindName, name(end-1)), end < total, total)
+}
+
+func (r connectionResolver) PageInfo() pageInfoResolver { return r.pageInfo }
+func (r connectionResolver) TotalCount() *graphQLLong   { return r.totalCount }
+
+type timeRangeArgs struct {
+	StartTime graphQLLong
+	EndTime   graphQLLong
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// graphQLResolver is the root resolver of the backend graphql schema
+type graphQLResolver struct{}
+
+type orderResolver struct{ o types.Order }
+
+func (r orderResolver) TxHash() string         { return r.o.TxHash }
+func (r orderResolver) OrderID() string        { return r.o.OrderID }
+func (r orderResolver) Sender() string         { return r.o.Sender }
+func (r orderResolver) Product() string        { return r.o.Product }
+func (r orderResolver) Side() string           { return r.o.Side }
+func (r orderResolver) Price() string          { return r.o.Price }
+func (r orderResolver) Quantity() string       { return r.o.Quantity }
+func (r orderResolver) Status() int32          { return int32(r.o.Status) }
+func (r orderResolver) FilledAvgPrice() string { return r.o.FilledAvgPrice }
+func (r orderResolver) RemainQuantity() string { return r.o.RemainQuantity }
+func (r orderResolver) Timestamp() graphQLLong { return graphQLLong(r.o.Timestamp) }
+
+type orderConnectionResolver struct {
+	connectionResolver
+	nodes []orderResolver
+}
+
+func (r orderConnectionResolver) Nodes() []orderResolver { return r.nodes }
+
+// Orders resolves the open or closed orders of an address
+func (graphQLResolver) Orders(ctx context.Context, args struct {
+	Address string
+	Product *string
+	Side    *string
+	Open    bool
+	timeRangeArgs
+	pageArgs
+}) (*orderConnectionResolver, error) {
+	after, limit, err := args.keysetPage()
+	if err != nil {
+		return nil, err
+	}
+	k := fromGraphQLContext(ctx).keeper
+	orders, total, hasNext := k.Orm.GetOrdersByKeyset(args.Address, stringValue(args.Product),
+		strings.ToUpper(stringValue(args.Side)), args.Open, int64(args.StartTime), int64(args.EndTime), after, limit)
+
+	var endCursor *string
+	if len(orders) > 0 {
+		last := orders[len(orders)-1]
+		endCursor = encodeKeysetCursor(orm.KeysetCursor{Key: last.Timestamp, ID: last.OrderID})
+	}
+	res := &orderConnectionResolver{connectionResolver: newConnection(endCursor, hasNext, total)}
+	for _, o := range orders {
+		res.nodes = append(res.nodes, orderResolver{o})
+	}
+	return res, nil
+}
+
+type dealResolver struct{ d types.Deal }
+
+func (r dealResolver) BlockHeight() graphQLLong { return graphQLLong(r.d.BlockHeight) }
+func (r dealResolver) OrderID() string          { return r.d.OrderID }
+func (r dealResolver) Sender() string           { return r.d.Sender }
+func (r dealResolver) Product() string          { return r.d.Product }
+func (r dealResolver) Side() string             { return r.d.Side }
+func (r dealResolver) Price() float64           { return r.d.Price }
+func (r dealResolver) Quantity() float64        { return r.d.Quantity }
+func (r dealResolver) Fee() string              { return r.d.Fee }
+func (r dealResolver) FeeReceiver() string      { return r.d.FeeReceiver }
+func (r dealResolver) Timestamp() graphQLLong   { return graphQLLong(r.d.Timestamp) }
+
+type dealConnectionResolver struct {
+	connectionResolver
+	nodes []dealResolver
+}
+
+func (r dealConnectionResolver) Nodes() []dealResolver { return r.nodes }
+
+// Deals resolves the deals filtered by address, product and side
+func (graphQLResolver) Deals(ctx context.Context, args struct {
+	Address *string
+	Product *string
+	Side    *string
+	timeRangeArgs
+	pageArgs
+}) (*dealConnectionResolver, error) {
+	after, limit, err := args.keysetPage()
+	if err != nil {
+		return nil, err
+	}
+	k := fromGraphQLContext(ctx).keeper
+	deals, total, hasNext := k.Orm.GetDealsByKeyset(stringValue(args.Address), stringValue(args.Product),
+		strings.ToUpper(stringValue(args.Side)), int64(args.StartTime), int64(args.EndTime), after, limit)
+
+	var endCursor *string
+	if len(deals) > 0 {
+		last := deals[len(deals)-1]
+		endCursor = encodeKeysetCursor(orm.KeysetCursor{Key: last.BlockHeight, ID: last.OrderID})
+	}
+	res := &dealConnectionResolver{connectionResolver: newConnection(endCursor, hasNext, total)}
+	for _, d := range deals {
+		res.nodes = append(res.nodes, dealResolver{d})
+	}
+	return res, nil
+}
+
+type matchResultResolver struct{ m types.MatchResult }
+
+func (r matchResultResolver) BlockHeight() graphQLLong { return graphQLLong(r.m.BlockHeight) }
+func (r matchResultResolver) Product() string          { return r.m.Product }
+func (r matchResultResolver) Price() float64           { return r.m.Price }
+func (r matchResultResolver) Quantity() float64        { return r.m.Quantity }
+func (r matchResultResolver) Timestamp() graphQLLong   { return graphQLLong(r.m.Timestamp) }
+
+type matchResultConnectionResolver struct {
+	connectionResolver
+	nodes []matchResultResolver
+}
+
+func (r matchResultConnectionResolver) Nodes() []matchResultResolver { return r.nodes }
+
+// Matches resolves the match results of products
+func (graphQLResolver) Matches(ctx context.Context, args struct {
+	Product *string
+	timeRangeArgs
+	pageArgs
+}) (*matchResultConnectionResolver, error) {
+	after, limit, err := args.keysetPage()
+	if err != nil {
+		return nil, err
+	}
+	k := fromGraphQLContext(ctx).keeper
+	matches, total, hasNext := k.Orm.GetMatchResultsByKeyset(stringValue(args.Product), int64(args.StartTime),
+		int64(args.EndTime), after, limit)
+
+	var endCursor *string
+	if len(matches) > 0 {
+		last := matches[len(matches)-1]
+		endCursor = encodeKeysetCursor(orm.KeysetCursor{Key: last.BlockHeight, ID: last.Product})
+	}
+	res := &matchResultConnectionResolver{connectionResolver: newConnection(endCursor, hasNext, total)}
+	for _, m := range matches {
+		res.nodes = append(res.nodes, matchResultResolver{m})
+	}
+	return res, nil
+}
+
+type transactionResolver struct{ t types.Transaction }
+
+func (r transactionResolver) TxHash() string         { return r.t.TxHash }
+func (r transactionResolver) Type() int32            { return int32(r.t.Type) }
+func (r transactionResolver) Address() string        { return r.t.Address }
+func (r transactionResolver) Symbol() string         { return r.t.Symbol }
+func (r transactionResolver) Side() int32            { return int32(r.t.Side) }
+func (r transactionResolver) Quantity() string       { return r.t.Quantity }
+func (r transactionResolver) Fee() string            { return r.t.Fee }
+func (r transactionResolver) Timestamp() graphQLLong { return graphQLLong(r.t.Timestamp) }
+
+type transactionConnectionResolver struct {
+	connectionResolver
+	nodes []transactionResolver
+}
+
+func (r transactionConnectionResolver) Nodes() []transactionResolver { return r.nodes }
+
+// Transactions resolves the transactions of an address
+func (graphQLResolver) Transactions(ctx context.Context, args struct {
+	Address string
+	Type    int32
+	timeRangeArgs
+	pageArgs
+}) (*transactionConnectionResolver, error) {
+	after, limit, err := args.keysetPage()
+	if err != nil {
+		return nil, err
+	}
+	k := fromGraphQLContext(ctx).keeper
+	txs, total, hasNext := k.Orm.GetTransactionsByKeyset(args.Address, int64(args.Type), int64(args.StartTime),
+		int64(args.EndTime), after, limit)
+
+	var endCursor *string
+	if len(txs) > 0 {
+		endCursor = encodeKeysetCursor(nextTransactionsCursor(after, txs))
+	}
+	res := &transactionConnectionResolver{connectionResolver: newConnection(endCursor, hasNext, total)}
+	for _, t := range txs {
+		res.nodes = append(res.nodes, transactionResolver{t})
+	}
+	return res, nil
+}
+
+// nextTransactionsCursor returns the keyset of the last transaction of a page, which skips the rows of the same tx
+// returned in this page and the previous pages
+func nextTransactionsCursor(after *orm.KeysetCursor, txs []types.Transaction) orm.KeysetCursor {
+	last := txs[len(txs)-1]
+	next := orm.KeysetCursor{Key: last.Timestamp, ID: last.TxHash}
+	for i := len(txs) - 1; i >= 0 && txs[i].Timestamp == next.Key && txs[i].TxHash == next.ID; i-- {
+		next.Skip++
+	}
+	if next.Skip == len(txs) && after != nil && after.Key == next.Key && after.ID == next.ID {
+		next.Skip += after.Skip
+	}
+	return next
+}
+
+type klineResolver struct{ k types.IKline }
+
+func (r klineResolver) Timestamp() graphQLLong { return graphQLLong(r.k.GetTimestamp()) }
+func (r klineResolver) Open() float64          { return r.k.GetOpen() }
+func (r klineResolver) Close() float64         { return r.k.GetClose() }
+func (r klineResolver) High() float64          { return r.k.GetHigh() }
+func (r klineResolver) Low() float64           { return r.k.GetLow() }
+func (r klineResolver) Volume() float64        { return r.k.GetVolume() }
+
+type klineConnectionResolver struct {
+	connectionResolver
+	nodes []klineResolver
+}
+
+func (r klineConnectionResolver) Nodes() []klineResolver { return r.nodes }
+
+// Klines resolves the klines of a product latest first, the cursor is the timestamp of the last kline in the page
+func (graphQLResolver) Klines(ctx context.Context, args struct {
+	Product     string
+	Granularity int32
+	pageArgs
+}) (*klineConnectionResolver, error) {
+	limit, err := args.limit()
+	if err != nil {
+		return nil, err
+	}
+	before, err := decodeTimestampCursor(args.After)
+	if err != nil {
+		return nil, err
+	}
+
+	k := fromGraphQLContext(ctx).keeper
+	klineType := k.getKlineMap()[int(args.Granularity)]
+	if klineType == "" {
+		return nil, fmt.Errorf("granularity %d is not supported", args.Granularity)
+	}
+	klines, err := types.NewKlinesFactory(klineType)
+	if err != nil {
+		return nil, err
+	}
+	if err = k.Orm.GetLatestKlinesByProduct(args.Product, limit, before, klines); err != nil {
+		return nil, err
+	}
+
+	res := &klineConnectionResolver{}
+	for _, kline := range types.ToIKlinesArray(klines, 0, false) {
+		res.nodes = append(res.nodes, klineResolver{kline})
+	}
+	if len(res.nodes) > 0 {
+		cursor := encodeCursor(cursorKindTimestamp, strconv.FormatInt(res.nodes[len(res.nodes)-1].k.GetTimestamp(), 10))
+		res.pageInfo = pageInfoResolver{endCursor: cursor, hasNextPage: len(res.nodes) == limit}
+	}
+	return res, nil
+}
+
+type tickerResolver struct{ t types.Ticker }
+
+func (r tickerResolver) Product() string          { return r.t.Product }
+func (r tickerResolver) Symbol() string           { return r.t.Symbol }
+func (r tickerResolver) Timestamp() graphQLLong   { return graphQLLong(r.t.Timestamp) }
+func (r tickerResolver) Open() float64            { return r.t.Open }
+func (r tickerResolver) Close() float64           { return r.t.Close }
+func (r tickerResolver) High() float64            { return r.t.High }
+func (r tickerResolver) Low() float64             { return r.t.Low }
+func (r tickerResolver) Price() float64           { return r.t.Price }
+func (r tickerResolver) Volume() float64          { return r.t.Volume }
+func (r tickerResolver) Change() float64          { return r.t.Change }
+func (r tickerResolver) ChangePercentage() string { return r.t.ChangePercentage }
+
+// Tickers resolves the latest tickers of products, all the products if products is empty
+func (graphQLResolver) Tickers(ctx context.Context, args struct{ Products *[]string }) []tickerResolver {
+	var products []string
+	if args.Products != nil {
+		products = *args.Products
+	}
+	k := fromGraphQLContext(ctx).keeper
+	tickers := k.GetTickers(products, len(k.Cache.LatestTicker))
+
+	res := make([]tickerResolver, 0, len(tickers))
+	for _, t := range tickers {
+		res = append(res, tickerResolver{t})
+	}
+	return res
+}
+
+type swapPairResolver struct{ p ammswap.SwapTokenPair }
+
+func (r swapPairResolver) Name() string            { return r.p.TokenPairName() }
+func (r swapPairResolver) BasePooledCoin() string  { return r.p.BasePooledCoin.String() }
+func (r swapPairResolver) QuotePooledCoin() string { return r.p.QuotePooledCoin.String() }
+func (r swapPairResolver) PoolTokenName() string   { return r.p.PoolTokenName }
+
+type swapPairConnectionResolver struct {
+	connectionResolver
+	nodes []swapPairResolver
+}
+
+func (r swapPairConnectionResolver) Nodes() []swapPairResolver { return r.nodes }
+
+// SwapPairs resolves the swap token pairs and their pooled coins
+func (graphQLResolver) SwapPairs(ctx context.Context, args struct{ pageArgs }) (*swapPairConnectionResolver, error) {
+	gCtx := fromGraphQLContext(ctx)
+	pairs := gCtx.keeper.swapKeeper.GetSwapTokenPairs(gCtx.ctx)
+	sort.Slice(pairs, func(i, j int) bool { return pairs[i].TokenPairName() < pairs[j].TokenPairName() })
+	name := func(i int) string { return pairs[i].TokenPairName() }
+	start, end, err := args.sliceNamePage(len(pairs), name)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &swapPairConnectionResolver{connectionResolver: newNameConnection(start, end, len(pairs), name)}
+	for _, p := range pairs[start:end] {
+		res.nodes = append(res.nodes, swapPairResolver{p})
+	}
+	return res, nil
+}
+
+type swapLiquidityResolver struct{ l types.SwapLiquidityInfo }
+
+func (r swapLiquidityResolver) BasePooledCoin() string  { return r.l.BasePooledCoin.String() }
+func (r swapLiquidityResolver) QuotePooledCoin() string { return r.l.QuotePooledCoin.String() }
+func (r swapLiquidityResolver) PoolTokenCoin() string   { return r.l.PoolTokenCoin.String() }
+func (r swapLiquidityResolver) PoolTokenRatio() string  { return r.l.PoolTokenRatio.String() }
+
+// SwapLiquidity resolves the liquidity provided by an address
+func (graphQLResolver) SwapLiquidity(ctx context.Context, args struct {
+	Address string
+	Pair    *string
+}) ([]swapLiquidityResolver, error) {
+	addr, err := sdk.AccAddressFromBech32(args.Address)
+	if err != nil {
+		return nil, err
+	}
+	gCtx := fromGraphQLContext(ctx)
+	infos := getSwapLiquidityInfos(gCtx.ctx, gCtx.keeper, addr, stringValue(args.Pair))
+
+	res := make([]swapLiquidityResolver, 0, len(infos))
+	for _, info := range infos {
+		res = append(res, swapLiquidityResolver{info})
+	}
+	return res, nil
+}
+
+type farmPoolResolver struct{ p farmtypes.FarmPool }
+
+func (r farmPoolResolver) Name() string             { return r.p.Name }
+func (r farmPoolResolver) Owner() string            { return r.p.Owner.String() }
+func (r farmPoolResolver) MinLockAmount() string    { return r.p.MinLockAmount.String() }
+func (r farmPoolResolver) DepositAmount() string    { return r.p.DepositAmount.String() }
+func (r farmPoolResolver) TotalValueLocked() string { return r.p.TotalValueLocked.String() }
+func (r farmPoolResolver) TotalAccumulatedRewards() string {
+	return r.p.TotalAccumulatedRewards.String()
+}
+
+type farmPoolConnectionResolver struct {
+	connectionResolver
+	nodes []farmPoolResolver
+}
+
+func (r farmPoolConnectionResolver) Nodes() []farmPoolResolver { return r.nodes }
+
+// FarmPools resolves the farm pools
+func (graphQLResolver) FarmPools(ctx context.Context, args struct{ pageArgs }) (*farmPoolConnectionResolver, error) {
+	gCtx := fromGraphQLContext(ctx)
+	pools := gCtx.keeper.farmKeeper.GetFarmPools(gCtx.ctx)
+	sort.Slice(pools, func(i, j int) bool { return pools[i].Name < pools[j].Name })
+	name := func(i int) string { return pools[i].Name }
+	start, end, err := args.sliceNamePage(len(pools), name)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &farmPoolConnectionResolver{connectionResolver: newNameConnection(start, end, len(pools), name)}
+	for _, p := range pools[start:end] {
+		res.nodes = append(res.nodes, farmPoolResolver{p})
+	}
+	return res, nil
+}
+
+type tokenResolver struct{ t token.Token }
+
+func (r tokenResolver) Symbol() string              { return r.t.Symbol }
+func (r tokenResolver) OriginalSymbol() string      { return r.t.OriginalSymbol }
+func (r tokenResolver) WholeName() string           { return r.t.WholeName }
+func (r tokenResolver) Description() string         { return r.t.Description }
+func (r tokenResolver) OriginalTotalSupply() string { return r.t.OriginalTotalSupply.String() }
+func (r tokenResolver) Type() int32                 { return int32(r.t.Type) }
+func (r tokenResolver) Owner() string               { return r.t.Owner.String() }
+func (r tokenResolver) Mintable() bool              { return r.t.Mintable }
+
+type tokenConnectionResolver struct {
+	connectionResolver
+	nodes []tokenResolver
+}
+
+func (r tokenConnectionResolver) Nodes() []tokenResolver { return r.nodes }
+
+// Tokens resolves the token infos
+func (graphQLResolver) Tokens(ctx context.Context, args struct{ pageArgs }) (*tokenConnectionResolver, error) {
+	gCtx := fromGraphQLContext(ctx)
+	tokens := gCtx.keeper.TokenKeeper.GetTokensInfo(gCtx.ctx)
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].Symbol < tokens[j].Symbol })
+	name := func(i int) string { return tokens[i].Symbol }
+	start, end, err := args.sliceNamePage(len(tokens), name)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &tokenConnectionResolver{connectionResolver: newNameConnection(start, end, len(tokens), name)}
+	for _, t := range tokens[start:end] {
+		res.nodes = append(res.nodes, tokenResolver{t})
+	}
+	return res, nil
+}
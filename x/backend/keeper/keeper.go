@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"time"
 
 	"github.com/okex/okexchain/x/ammswap"
@@ -17,6 +18,7 @@ import (
 	"github.com/okex/okexchain/x/backend/orm"
 	"github.com/okex/okexchain/x/backend/types"
 	"github.com/okex/okexchain/x/token"
+	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -37,6 +39,16 @@ type Keeper struct {
 	wsChan       chan types.IWebsocket // Websocket channel, it's only available when websocket config enabled
 	ticker3sChan chan types.IWebsocket // Websocket channel, it's used by tickers merge triggered 3s once
 	Cache        *cache.Cache          // Memory cache
+	klineMap     map[int]string        // Kline types generated by backend, configured by FlagKlineIntervals
+	archiveDir   string                // Directory of the archives of expired data, configured by FlagArchiveDir
+}
+
+// getKlineMap returns the kline types generated by backend
+func (k Keeper) getKlineMap() map[int]string {
+	if k.klineMap == nil {
+		return types.GetAllKlineMap()
+	}
+	return k.klineMap
 }
 
 // NewKeeper creates new instances of the nameservice Keeper
@@ -57,6 +69,11 @@ func NewKeeper(orderKeeper types.OrderKeeper, tokenKeeper types.TokenKeeper, dex
 	}
 
 	if k.Config.EnableBackend {
+		klineMap, err := types.ParseKlineIntervals(viper.GetString(types.FlagKlineIntervals))
+		if err != nil {
+			panic(fmt.Sprintf("backend parse kline intervals error:%s", err.Error()))
+		}
+		k.klineMap = klineMap
 		k.Cache = cache.NewCache()
 		orm, err := orm.New(k.Config.LogSQL, &k.Config.OrmEngine, &k.Logger)
 		if err != nil {
@@ -65,6 +82,12 @@ func NewKeeper(orderKeeper types.OrderKeeper, tokenKeeper types.TokenKeeper, dex
 		k.Orm = orm
 		k.stopChan = make(chan struct{})
 
+		k.archiveDir = viper.GetString(types.FlagArchiveDir)
+		if k.archiveDir == "" {
+			k.archiveDir = config.DefaultArchiveDir
+		}
+		go CleanUpExpiredData(k.stopChan, k.Orm, k.Config, k.archiveDir)
+
 		if k.Config.EnableMktCompute {
 			// websocket channel
 			k.wsChan = make(chan types.IWebsocket, types.WebsocketChanCapacity)
@@ -257,7 +280,7 @@ func (k Keeper) getCandlesWithTimeFromORM(product string, granularity, size int,
 		return nil, types.ErrBackendPluginNotEnabled()
 	}
 
-	m := types.GetAllKlineMap()
+	m := k.getKlineMap()
 	candleType := m[granularity]
 	if candleType == "" || len(candleType) == 0 || (size < 0 || size > 1000) {
 		return nil, types.ErrParamNotCorrect(size, granularity)
@@ -293,7 +316,7 @@ func (k Keeper) getCandlesByMarketKeeper(productID uint64, granularity, size int
 		return nil, types.ErrMarketkeeperNotInitialized()
 	}
 
-	m := types.GetAllKlineMap()
+	m := k.getKlineMap()
 	candleType := m[granularity]
 	if candleType == "" || len(candleType) == 0 || (size < 0 || size > 1000) {
 		return nil, types.ErrParamNotCorrect(size, granularity)
@@ -416,6 +439,49 @@ func (k Keeper) getTransactionListV2(ctx sdk.Context, addr string, txType int, a
 	return k.Orm.GetTransactionListV2(addr, txType, after, before, limit)
 }
 
+func (k Keeper) getBalanceChangesV2(ctx sdk.Context, addr, currency string, after string, before string, limit int) []types.BalanceChange {
+	return k.Orm.GetBalanceChangesV2(addr, currency, after, before, limit)
+}
+
+// getBalancesAtHeight rolls back the current balances of addr with the balance changes recorded by backend.
+// The changes which are not tracked by backend (e.g. staking, gov deposit) are not covered, so the balances of a
+// past height are marked incomplete
+func (k Keeper) getBalancesAtHeight(ctx sdk.Context, addr sdk.AccAddress, height int64) types.AccountBalancesV2 {
+	if height <= 0 || height > ctx.BlockHeight() {
+		height = ctx.BlockHeight()
+	}
+	changes := k.Orm.GetBalanceChangesAfterHeight(addr.String(), height)
+	return types.GetBalancesAtHeight(height, ctx.BlockHeight(), k.TokenKeeper.GetCoins(ctx, addr), changes)
+}
+
+// getProductPnLs returns the pnl of addr in product, or in all the products it has traded if product is empty
+func (k Keeper) getProductPnLs(ctx sdk.Context, addr, product string) ([]types.ProductPnLV2, error) {
+	deals := k.Orm.GetAccountDealsAsc(addr, product)
+	var products []string
+	productDeals := map[string][]types.Deal{}
+	for _, deal := range deals {
+		if _, ok := productDeals[deal.Product]; !ok {
+			products = append(products, deal.Product)
+		}
+		productDeals[deal.Product] = append(productDeals[deal.Product], deal)
+	}
+	sort.Strings(products)
+
+	pnls := make([]types.ProductPnLV2, 0, len(products))
+	for _, p := range products {
+		var lastPrice float64
+		if ticker := k.Cache.LatestTicker[p]; ticker != nil {
+			lastPrice = ticker.Price
+		}
+		pnl, err := types.CalculateProductPnL(p, productDeals[p], lastPrice)
+		if err != nil {
+			return nil, err
+		}
+		pnls = append(pnls, pnl)
+	}
+	return pnls, nil
+}
+
 func (k Keeper) getAllTickers() []types.Ticker {
 	var tickers []types.Ticker
 	for _, ticker := range k.Cache.LatestTicker {
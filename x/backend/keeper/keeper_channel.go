@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/okex/okexchain/x/backend/config"
@@ -54,8 +55,7 @@ func generateKline1M(keeper Keeper) {
 	interval := time.Second * 60
 	ticker := time.NewTicker(interval)
 
-	go CleanUpKlines(keeper.stopChan, keeper.Orm, keeper.Config)
-	klineNotifyChans := generateSyncKlineMXChans()
+	klineNotifyChans := generateSyncKlineMXChans(keeper.getKlineMap())
 	work := func() {
 		currentBlockTimestamp := keeper.Orm.GetMaxBlockTimestamp()
 		if currentBlockTimestamp == 0 {
@@ -104,9 +104,8 @@ func generateKline1M(keeper Keeper) {
 	}
 }
 
-func generateSyncKlineMXChans() *map[int]chan int64 {
+func generateSyncKlineMXChans(klineMap map[int]string) *map[int]chan int64 {
 	notifyChans := map[int]chan int64{}
-	klineMap := types.GetAllKlineMap()
 
 	for freq := range klineMap {
 		if freq > 60 {
@@ -192,34 +191,36 @@ func generateKlinesMX(notifyChan chan int64, refreshInterval int, keeper Keeper)
 	}
 }
 
-// nolint
-func CleanUpKlines(stop chan struct{}, o *orm.ORM, conf *config.Config) {
-	o.Debug(fmt.Sprintf("[backend] CleanUpKlines go routine started. MaintainConf: %+v", *conf))
+// CleanUpExpiredData archives the expired rows of tables configured in CleanUpsKeptDays into archiveDir and
+// deletes them once a day at CleanUpsTime. Tables with 0 kept days are never cleaned up
+func CleanUpExpiredData(stop chan struct{}, o *orm.ORM, conf *config.Config, archiveDir string) {
+	o.Debug(fmt.Sprintf("[backend] CleanUpExpiredData go routine started. MaintainConf: %+v", *conf))
 	interval := time.Duration(60 * int(time.Second))
 	ticker := time.NewTicker(time.Duration(int(60-time.Now().Second()) * int(time.Second)))
 
+	tables := make([]string, 0, len(conf.CleanUpsKeptDays))
+	for table := range conf.CleanUpsKeptDays {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
 	work := func() {
 		now := time.Now()
 		strNow := now.Format("15:04:05")
-		if strNow == conf.CleanUpsTime {
-
-			m := types.GetAllKlineMap()
-			for _, ktype := range m {
-				expiredDays := conf.CleanUpsKeptDays[ktype]
-				if expiredDays != 0 {
-					o.Debug(fmt.Sprintf("[backend] entering CleanUpKlines, "+
-						"fired time: %s(currentTS: %d), kline type: %s", conf.CleanUpsTime, now.Unix(), ktype))
-					//anchorTS := now.Add(-time.Duration(int(time.Second) * 1440 * expiredDays)).Unix()
-					anchorTS := now.Add(-time.Duration(int(time.Second) * types.SecondsInADay * expiredDays)).Unix()
-					kline, err := types.NewKlineFactory(ktype, nil)
-					if err != nil {
-						o.Debug("failed to NewKlineFactory becoz of : " + err.Error())
-						break
-					}
-					if err = o.DeleteKlineBefore(anchorTS, kline); err != nil {
-						o.Error("failed to DeleteKlineBefore because " + err.Error())
-					}
-				}
+		if strNow != conf.CleanUpsTime {
+			return
+		}
+
+		for _, table := range tables {
+			keptDays := conf.CleanUpsKeptDays[table]
+			if keptDays <= 0 {
+				continue
+			}
+			o.Debug(fmt.Sprintf("[backend] entering CleanUpExpiredData, "+
+				"fired time: %s(currentTS: %d), table: %s", conf.CleanUpsTime, now.Unix(), table))
+			anchorTS := now.Add(-time.Duration(int(time.Second) * types.SecondsInADay * keptDays)).Unix()
+			if _, err := o.ArchiveBefore(table, anchorTS, archiveDir); err != nil {
+				o.Error(fmt.Sprintf("failed to archive %s before %d because %s", table, anchorTS, err.Error()))
 			}
 		}
 	}
@@ -228,11 +229,12 @@ func CleanUpKlines(stop chan struct{}, o *orm.ORM, conf *config.Config) {
 		select {
 		case <-ticker.C:
 			work()
+			ticker.Stop()
 			ticker = time.NewTicker(interval)
 
 		case <-stop:
-			break
-
+			ticker.Stop()
+			return
 		}
 	}
 }
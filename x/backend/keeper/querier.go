@@ -122,6 +122,14 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			res, err = queryDealsV2(ctx, path[1:], req, keeper)
 		case types.QueryTxListV2:
 			res, err = queryTxListV2(ctx, path[1:], req, keeper)
+		case types.QueryBalanceHistoryV2:
+			res, err = queryBalanceHistoryV2(ctx, path[1:], req, keeper)
+		case types.QueryBalancesV2:
+			res, err = queryBalancesV2(ctx, path[1:], req, keeper)
+		case types.QueryPnLV2:
+			res, err = queryPnLV2(ctx, path[1:], req, keeper)
+		case types.QueryGraphQL:
+			res, err = queryGraphQL(ctx, req, keeper)
 		default:
 			res, err = nil, types.ErrBackendModuleUnknownQueryType()
 		}
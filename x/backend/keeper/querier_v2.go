@@ -357,3 +357,65 @@ func queryTxListV2(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 
 	return res, nil
 }
+
+func queryBalanceHistoryV2(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryBalanceHistoryParamsV2
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+	if _, err := sdk.AccAddressFromBech32(params.Address); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("invalid address", err.Error()))
+	}
+
+	balanceChanges := keeper.getBalanceChangesV2(ctx, params.Address, params.Currency, params.After, params.Before, params.Limit)
+	if len(balanceChanges) == 0 {
+		return nil, nil
+	}
+
+	res, err := common.JSONMarshalV2(balanceChanges)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+
+	return res, nil
+}
+
+func queryBalancesV2(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryBalancesParamsV2
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+	addr, err := sdk.AccAddressFromBech32(params.Address)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("invalid address", err.Error()))
+	}
+
+	balances := keeper.getBalancesAtHeight(ctx, addr, params.Height)
+	res, err := common.JSONMarshalV2(balances)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+
+	return res, nil
+}
+
+func queryPnLV2(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryPnLParamsV2
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+	if _, err := sdk.AccAddressFromBech32(params.Address); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("invalid address", err.Error()))
+	}
+
+	pnls, err := keeper.getProductPnLs(ctx, params.Address, params.Product)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	res, err := common.JSONMarshalV2(pnls)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+
+	return res, nil
+}
@@ -398,6 +398,19 @@ func querySwapLiquidityHistories(ctx sdk.Context, req abci.RequestQuery, keeper
 		return nil, common.ErrCreateAddrFromBech32Failed(queryParams.Address, err.Error())
 	}
 
+	liquidityInfoList := getSwapLiquidityInfos(ctx, keeper, addr, queryParams.TokenPairName)
+
+	response := common.GetBaseResponse(liquidityInfoList)
+	bz, err := json.Marshal(response)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return bz, nil
+
+}
+
+// getSwapLiquidityInfos returns the liquidity of the pool tokens held by addr, all the token pairs if tokenPairName is empty
+func getSwapLiquidityInfos(ctx sdk.Context, keeper Keeper, addr sdk.AccAddress, tokenPairName string) []types.SwapLiquidityInfo {
 	var liquidityInfoList []types.SwapLiquidityInfo
 	// coins in account
 	accountCoins := keeper.TokenKeeper.GetCoins(ctx, addr)
@@ -407,13 +420,13 @@ func querySwapLiquidityHistories(ctx sdk.Context, req abci.RequestQuery, keeper
 			continue
 		}
 		// check token pair name
-		tokenPairName := coin.Denom[len(swaptypes.PoolTokenPrefix):]
-		if queryParams.TokenPairName != "" && queryParams.TokenPairName != tokenPairName {
+		pairName := coin.Denom[len(swaptypes.PoolTokenPrefix):]
+		if tokenPairName != "" && tokenPairName != pairName {
 			continue
 		}
 
 		// get swap token pair
-		swapTokenPair, err := keeper.swapKeeper.GetSwapTokenPair(ctx, tokenPairName)
+		swapTokenPair, err := keeper.swapKeeper.GetSwapTokenPair(ctx, pairName)
 		if err != nil {
 			continue
 		}
@@ -431,14 +444,7 @@ func querySwapLiquidityHistories(ctx sdk.Context, req abci.RequestQuery, keeper
 		}
 		liquidityInfoList = append(liquidityInfoList, liquidityInfo)
 	}
-
-	response := common.GetBaseResponse(liquidityInfoList)
-	bz, err := json.Marshal(response)
-	if err != nil {
-		return nil, common.ErrMarshalJSONFailed(err.Error())
-	}
-	return bz, nil
-
+	return liquidityInfoList
 }
 
 func getSwapWhitelistMap(keeper Keeper) map[string]struct{} {
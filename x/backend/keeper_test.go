@@ -2,6 +2,7 @@ package backend
 
 import (
 	"fmt"
+	"os"
 	"testing"
 	"time"
 
@@ -124,7 +125,7 @@ func TestKeeper_Tx(t *testing.T) {
 	require.EqualValues(t, 1, len(getTxs))
 }
 
-func TestKeeper_CleanUpKlines(t *testing.T) {
+func TestKeeper_CleanUpExpiredData(t *testing.T) {
 	o, _ := orm.MockSqlite3ORM()
 	ch := make(chan struct{}, 1)
 	conf := config.DefaultConfig()
@@ -133,9 +134,8 @@ func TestKeeper_CleanUpKlines(t *testing.T) {
 	strClenaUpTime := cleanUpTime.Format("15:04") + ":00"
 	conf.CleanUpsTime = strClenaUpTime
 	conf.EnableBackend = true
-	go CleanUpKlines(ch, o, conf)
-
-	//time.Sleep(121 * time.Second)
+	go CleanUpExpiredData(ch, o, conf, os.TempDir())
+	ch <- struct{}{}
 }
 
 func sumKlinesVolume(product string, o *orm.ORM, ikline types.IKline) (float64, error) {
@@ -0,0 +1,273 @@
+package orm
+
+import (
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/okex/okexchain/x/backend/types"
+	"github.com/okex/okexchain/x/token"
+)
+
+// retentionTable describes a table whose expired rows are archived by the retention policy
+type retentionTable struct {
+	// newModel returns a pointer to a new row of the table
+	newModel func() interface{}
+	// condition is the extra condition of rows which could be archived, e.g.) only closed orders are archived
+	condition string
+}
+
+func getRetentionTable(table string) (retentionTable, error) {
+	switch table {
+	case types.TableOrders:
+		return retentionTable{newModel: func() interface{} { return &types.Order{} }, condition: "status > 0"}, nil
+	case types.TableDeals:
+		return retentionTable{newModel: func() interface{} { return &types.Deal{} }}, nil
+	case types.TableMatchResults:
+		return retentionTable{newModel: func() interface{} { return &types.MatchResult{} }}, nil
+	case types.TableFeeDetails:
+		return retentionTable{newModel: func() interface{} { return &token.FeeDetail{} }}, nil
+	case types.TableTransactions:
+		return retentionTable{newModel: func() interface{} { return &types.Transaction{} }}, nil
+	case types.TableSwapInfos:
+		return retentionTable{newModel: func() interface{} { return &types.SwapInfo{} }}, nil
+	case types.TableClaimInfos:
+		return retentionTable{newModel: func() interface{} { return &types.ClaimInfo{} }}, nil
+	case types.TableBalanceChanges:
+		return retentionTable{newModel: func() interface{} { return &types.BalanceChange{} }}, nil
+	}
+
+	if types.GetFreqByKlineType(table) != 0 {
+		return retentionTable{newModel: func() interface{} { return types.MustNewKlineFactory(table, nil) }}, nil
+	}
+	return retentionTable{}, fmt.Errorf("unsupported table to archive: %s", table)
+}
+
+// rangeQuery returns the query of rows in table with timestamp in [startTS, endTS)
+func (rt retentionTable) rangeQuery(db *gorm.DB, startTS, endTS int64) *gorm.DB {
+	query := db.Model(rt.newModel()).Where("timestamp >= ? and timestamp < ?", startTS, endTS)
+	if rt.condition != "" {
+		query = query.Where(rt.condition)
+	}
+	return query
+}
+
+// ArchiveBefore exports the rows of table with timestamp < endTS into a compressed archive file with a manifest
+// under archiveDir, then deletes them. It returns nil manifest if there is no row to archive
+func (orm *ORM) ArchiveBefore(table string, endTS int64, archiveDir string) (*types.ArchiveManifest, error) {
+	rt, err := getRetentionTable(table)
+	if err != nil {
+		return nil, err
+	}
+
+	var startTS sql.NullInt64
+	if err = rt.rangeQuery(orm.db, 0, endTS).Select("MIN(timestamp)").Row().Scan(&startTS); err != nil {
+		return nil, err
+	}
+	if !startTS.Valid {
+		return nil, nil
+	}
+
+	manifest := &types.ArchiveManifest{
+		Table:          table,
+		StartTimestamp: startTS.Int64,
+		EndTimestamp:   endTS,
+		CreatedAt:      time.Now().Unix(),
+	}
+	baseName := types.GetArchiveBaseName(table, manifest.StartTimestamp, manifest.EndTimestamp)
+	manifest.File = filepath.Base(baseName) + types.ArchiveFileSuffix
+	archivePath := filepath.Join(archiveDir, baseName+types.ArchiveFileSuffix)
+	manifestPath := filepath.Join(archiveDir, baseName+types.ArchiveManifestSuffix)
+
+	if manifest.Rows, manifest.SHA256, err = orm.exportRows(rt, manifest.StartTimestamp, endTS, archivePath); err != nil {
+		return nil, err
+	}
+	if err = writeManifest(manifest, manifestPath); err != nil {
+		return nil, err
+	}
+
+	if err = orm.deleteArchivedRows(rt, manifest); err != nil {
+		// the rows are kept in db, so the archive is useless
+		os.Remove(archivePath)
+		os.Remove(manifestPath)
+		return nil, err
+	}
+
+	orm.Debug(fmt.Sprintf("ArchiveBefore archived %d rows of %s in [%s, %s) to %s", manifest.Rows, table,
+		types.TimeString(manifest.StartTimestamp), types.TimeString(endTS), archivePath))
+	return manifest, nil
+}
+
+// exportRows writes the rows in [startTS, endTS) to path as gzip compressed json lines,
+// returns the count of rows and the sha256 of the file
+func (orm *ORM) exportRows(rt retentionTable, startTS, endTS int64, path string) (cnt int, checksum string, err error) {
+	if err = os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return 0, "", err
+	}
+	tmpPath := path + ".tmp"
+	file, err := os.Create(tmpPath)
+	if err != nil {
+		return 0, "", err
+	}
+	defer func() {
+		file.Close()
+		if err != nil {
+			os.Remove(tmpPath)
+		}
+	}()
+
+	hasher := sha256.New()
+	gzWriter := gzip.NewWriter(io.MultiWriter(file, hasher))
+	encoder := json.NewEncoder(gzWriter)
+
+	rows, err := rt.rangeQuery(orm.db, startTS, endTS).Order("timestamp asc").Rows()
+	if err != nil {
+		return 0, "", err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		row := rt.newModel()
+		if err = orm.db.ScanRows(rows, row); err != nil {
+			return 0, "", err
+		}
+		if err = encoder.Encode(row); err != nil {
+			return 0, "", err
+		}
+		cnt++
+	}
+	if err = rows.Err(); err != nil {
+		return 0, "", err
+	}
+
+	if err = gzWriter.Close(); err != nil {
+		return 0, "", err
+	}
+	if err = file.Sync(); err != nil {
+		return 0, "", err
+	}
+	if err = os.Rename(tmpPath, path); err != nil {
+		return 0, "", err
+	}
+	return cnt, hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+func writeManifest(manifest *types.ArchiveManifest, path string) error {
+	bz, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpPath := path + ".tmp"
+	if err = ioutil.WriteFile(tmpPath, bz, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// deleteArchivedRows deletes the rows in the range of manifest, it fails if the rows deleted are not the ones archived
+func (orm *ORM) deleteArchivedRows(rt retentionTable, manifest *types.ArchiveManifest) (err error) {
+	orm.singleEntryLock.Lock()
+	defer orm.singleEntryLock.Unlock()
+
+	tx := orm.db.Begin()
+	defer func() { orm.deferRollbackTx(tx, err) }()
+
+	r := rt.rangeQuery(tx, manifest.StartTimestamp, manifest.EndTimestamp).Delete(rt.newModel())
+	if r.Error != nil {
+		return r.Error
+	}
+	if r.RowsAffected != int64(manifest.Rows) {
+		return fmt.Errorf("%d rows of %s archived, but %d rows to delete", manifest.Rows, manifest.Table, r.RowsAffected)
+	}
+
+	tx.Commit()
+	return nil
+}
+
+// ImportArchive restores the rows of the archive described by the manifest file. The rows of the table in the range
+// of the archive are replaced, so it's safe to import an archive repeatedly
+func (orm *ORM) ImportArchive(manifestPath string) (manifest *types.ArchiveManifest, err error) {
+	bz, err := ioutil.ReadFile(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	manifest = &types.ArchiveManifest{}
+	if err = json.Unmarshal(bz, manifest); err != nil {
+		return nil, err
+	}
+	rt, err := getRetentionTable(manifest.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	archivePath := filepath.Join(filepath.Dir(manifestPath), manifest.File)
+	if err = verifyArchiveChecksum(archivePath, manifest.SHA256); err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	gzReader, err := gzip.NewReader(bufio.NewReader(file))
+	if err != nil {
+		return nil, err
+	}
+	defer gzReader.Close()
+
+	orm.singleEntryLock.Lock()
+	defer orm.singleEntryLock.Unlock()
+
+	tx := orm.db.Begin()
+	defer func() { orm.deferRollbackTx(tx, err) }()
+
+	if r := rt.rangeQuery(tx, manifest.StartTimestamp, manifest.EndTimestamp).Delete(rt.newModel()); r.Error != nil {
+		return nil, r.Error
+	}
+
+	cnt := 0
+	decoder := json.NewDecoder(gzReader)
+	for decoder.More() {
+		row := rt.newModel()
+		if err = decoder.Decode(row); err != nil {
+			return nil, err
+		}
+		if r := tx.Create(row); r.Error != nil {
+			return nil, r.Error
+		}
+		cnt++
+	}
+	if cnt != manifest.Rows {
+		return nil, fmt.Errorf("%d rows in archive %s, but %d rows in manifest", cnt, archivePath, manifest.Rows)
+	}
+
+	tx.Commit()
+	return manifest, nil
+}
+
+func verifyArchiveChecksum(path, checksum string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	hasher := sha256.New()
+	if _, err = io.Copy(hasher, file); err != nil {
+		return err
+	}
+	if actual := hex.EncodeToString(hasher.Sum(nil)); actual != checksum {
+		return fmt.Errorf("checksum of archive %s mismatch, expected %s, actual %s", path, checksum, actual)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package orm
+
+import (
+	"github.com/okex/okexchain/x/backend/types"
+)
+
+// AddBalanceChanges insert balance changes into db
+func (orm *ORM) AddBalanceChanges(balanceChanges []*types.BalanceChange) (addedCnt int, err error) {
+	orm.singleEntryLock.Lock()
+	defer orm.singleEntryLock.Unlock()
+
+	tx := orm.db.Begin()
+	defer func() { orm.deferRollbackTx(tx, err) }()
+	cnt := 0
+
+	for _, balanceChange := range balanceChanges {
+		if balanceChange != nil {
+			ret := tx.Set("gorm:insert_option", orm.insertOption()).Create(balanceChange)
+			if ret.Error != nil {
+				return cnt, ret.Error
+			}
+			cnt++
+		}
+	}
+
+	tx.Commit()
+	return cnt, nil
+}
+
+// GetBalanceChangesV2 returns the balance changes of address in (after, before) of block height, latest first
+func (orm *ORM) GetBalanceChangesV2(address, symbol string, after string, before string, limit int) []types.BalanceChange {
+	var balanceChanges []types.BalanceChange
+	query := orm.db.Model(types.BalanceChange{}).Where("address = ?", address)
+	if symbol != "" {
+		query = query.Where("symbol = ?", symbol)
+	}
+	if after != "" {
+		query = query.Where("height > ?", after)
+	}
+	if before != "" {
+		query = query.Where("height < ?", before)
+	}
+
+	query.Order("height desc").Limit(limit).Find(&balanceChanges)
+	return balanceChanges
+}
+
+// GetBalanceChangesAfterHeight returns all the balance changes of address after height
+func (orm *ORM) GetBalanceChangesAfterHeight(address string, height int64) []types.BalanceChange {
+	var balanceChanges []types.BalanceChange
+	orm.db.Model(types.BalanceChange{}).Where("address = ? and height > ?", address, height).
+		Order("height asc").Find(&balanceChanges)
+	return balanceChanges
+}
+
+// GetAccountDealsAsc returns all the deals of address in product sorted by time asc, all products if product is empty
+func (orm *ORM) GetAccountDealsAsc(address, product string) []types.Deal {
+	var deals []types.Deal
+	query := orm.db.Model(types.Deal{}).Where("sender = ?", address)
+	if product != "" {
+		query = query.Where("product = ?", product)
+	}
+
+	query.Order("block_height asc, order_id asc").Find(&deals)
+	return deals
+}
@@ -0,0 +1,51 @@
+package orm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// quote returns the identifier quoted in the way of the current engine
+func (orm *ORM) quote(identifier string) string {
+	if orm.engineType == EngineTypePostgres {
+		return fmt.Sprintf(`"%s"`, identifier)
+	}
+	return fmt.Sprintf("`%s`", identifier)
+}
+
+func (orm *ORM) quoteAll(identifiers []string) string {
+	quoted := make([]string, len(identifiers))
+	for i, identifier := range identifiers {
+		quoted[i] = orm.quote(identifier)
+	}
+	return strings.Join(quoted, ",")
+}
+
+// insertOption returns the option which makes a gorm Create ignore rows conflicting on primary key
+func (orm *ORM) insertOption() string {
+	if orm.engineType == EngineTypePostgres {
+		return "ON CONFLICT DO NOTHING"
+	}
+	return ""
+}
+
+// batchInsertSQL returns the sql to insert values into table.
+// For postgres, rows conflicting on conflictColumns are upserted with updateColumns, or ignored if updateColumns
+// is empty. Other engines keep the plain insert and report the duplicated rows as an error
+func (orm *ORM) batchInsertSQL(table string, columns []string, values []string,
+	conflictColumns []string, updateColumns []string) string {
+	sql := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", orm.quote(table), orm.quoteAll(columns), strings.Join(values, ", "))
+	if orm.engineType != EngineTypePostgres || len(conflictColumns) == 0 {
+		return sql
+	}
+
+	if len(updateColumns) == 0 {
+		return fmt.Sprintf("%s ON CONFLICT (%s) DO NOTHING", sql, orm.quoteAll(conflictColumns))
+	}
+
+	updates := make([]string, len(updateColumns))
+	for i, column := range updateColumns {
+		updates[i] = fmt.Sprintf("%s = EXCLUDED.%s", orm.quote(column), orm.quote(column))
+	}
+	return fmt.Sprintf("%s ON CONFLICT (%s) DO UPDATE SET %s", sql, orm.quoteAll(conflictColumns), strings.Join(updates, ", "))
+}
@@ -0,0 +1,154 @@
+package orm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/okex/okexchain/x/backend/types"
+)
+
+// KeysetCursor is the position of the last row of a page, latest first. Key is the timestamp or the block height of
+// the row and ID is its id among the rows of the same key. Skip counts the rows of the same (Key, ID) returned
+// already, which is only used by the tables without a unique (Key, ID)
+type KeysetCursor struct {
+	Key  int64  `json:"key"`
+	ID   string `json:"id"`
+	Skip int    `json:"skip,omitempty"`
+}
+
+// findKeysetPage finds a page of query ordered by (keyCol, idCol) desc after the cursor, with the rows of the same
+// (keyCol, idCol) ordered by tieOrder. One more row than limit is found to tell whether there is a next page, and the
+// total count of query regardless of the cursor is returned
+func findKeysetPage(query *gorm.DB, keyCol, idCol, tieOrder string, after *KeysetCursor, limit int,
+	out interface{}) (total int) {
+	query.Count(&total)
+
+	if after != nil {
+		if after.Skip > 0 {
+			query = query.Where(fmt.Sprintf("(%s, %s) <= (?, ?)", keyCol, idCol), after.Key, after.ID).Offset(after.Skip)
+		} else {
+			query = query.Where(fmt.Sprintf("(%s, %s) < (?, ?)", keyCol, idCol), after.Key, after.ID)
+		}
+	}
+	order := fmt.Sprintf("%s desc, %s desc", keyCol, idCol)
+	if tieOrder != "" {
+		order += ", " + tieOrder
+	}
+
+	query.Order(order).Limit(limit + 1).Find(out)
+	return total
+}
+
+// GetOrdersByKeyset returns a page of the orders of address after the cursor of (timestamp, order_id)
+func (orm *ORM) GetOrdersByKeyset(address, product, side string, open bool, startTS, endTS int64,
+	after *KeysetCursor, limit int) ([]types.Order, int, bool) {
+	if endTS == 0 {
+		endTS = time.Now().Unix()
+	}
+
+	query := orm.db.Model(types.Order{}).Where("sender = ? AND timestamp >= ? AND timestamp < ?", address, startTS, endTS)
+	if product != "" {
+		query = query.Where("product = ?", product)
+	}
+	if open {
+		query = query.Where("status = 0")
+	} else {
+		query = query.Where("status > 0")
+	}
+	if side != "" {
+		query = query.Where("side = ?", side)
+	}
+
+	var orders []types.Order
+	total := findKeysetPage(query, "timestamp", "order_id", "", after, limit, &orders)
+	hasNext := len(orders) > limit
+	if hasNext {
+		orders = orders[:limit]
+	}
+	return orders, total, hasNext
+}
+
+// GetDealsByKeyset returns a page of the deals after the cursor of (block_height, order_id)
+func (orm *ORM) GetDealsByKeyset(address, product, side string, startTime, endTime int64, after *KeysetCursor,
+	limit int) ([]types.Deal, int, bool) {
+	if startTime == 0 && endTime == 0 {
+		endTime = time.Now().Unix()
+	}
+
+	query := orm.db.Model(types.Deal{})
+	if address != "" {
+		query = query.Where("sender = ?", address)
+	}
+	if product != "" {
+		query = query.Where("product = ?", product)
+	}
+	if side != "" {
+		query = query.Where("side = ?", side)
+	}
+	if startTime > 0 {
+		query = query.Where("timestamp >= ?", startTime)
+	}
+	if endTime > 0 {
+		query = query.Where("timestamp < ?", endTime)
+	}
+
+	var deals []types.Deal
+	total := findKeysetPage(query, "block_height", "order_id", "", after, limit, &deals)
+	hasNext := len(deals) > limit
+	if hasNext {
+		deals = deals[:limit]
+	}
+	return deals, total, hasNext
+}
+
+// GetMatchResultsByKeyset returns a page of the match results after the cursor of (block_height, product)
+func (orm *ORM) GetMatchResultsByKeyset(product string, startTime, endTime int64, after *KeysetCursor,
+	limit int) ([]types.MatchResult, int, bool) {
+	if startTime == 0 && endTime == 0 {
+		endTime = time.Now().Unix()
+	}
+
+	query := orm.db.Model(types.MatchResult{})
+	if product != "" {
+		query = query.Where("product = ?", product)
+	}
+	if startTime > 0 {
+		query = query.Where("timestamp >= ?", startTime)
+	}
+	if endTime > 0 {
+		query = query.Where("timestamp < ?", endTime)
+	}
+
+	var matchResults []types.MatchResult
+	total := findKeysetPage(query, "block_height", "product", "", after, limit, &matchResults)
+	hasNext := len(matchResults) > limit
+	if hasNext {
+		matchResults = matchResults[:limit]
+	}
+	return matchResults, total, hasNext
+}
+
+// GetTransactionsByKeyset returns a page of the transactions of address after the cursor of (timestamp, tx_hash).
+// A tx might have several rows of an address, so the cursor skips the ones returned already
+func (orm *ORM) GetTransactionsByKeyset(address string, txType, startTime, endTime int64, after *KeysetCursor,
+	limit int) ([]types.Transaction, int, bool) {
+	query := orm.db.Model(types.Transaction{}).Where("address = ?", address)
+	if txType != 0 {
+		query = query.Where("type = ?", txType)
+	}
+	if startTime > 0 {
+		query = query.Where("timestamp >= ?", startTime)
+	}
+	if endTime > 0 {
+		query = query.Where("timestamp < ?", endTime)
+	}
+
+	var txs []types.Transaction
+	total := findKeysetPage(query, "timestamp", "tx_hash", "type, symbol, side, quantity", after, limit, &txs)
+	hasNext := len(txs) > limit
+	if hasNext {
+		txs = txs[:limit]
+	}
+	return txs, total, hasNext
+}
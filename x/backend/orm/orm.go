@@ -6,7 +6,6 @@ import (
 	"path/filepath"
 	"runtime/debug"
 	"sort"
-	"strings"
 	"sync"
 	"time"
 
@@ -17,7 +16,9 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/mysql"
+	_ "github.com/jinzhu/gorm/dialects/postgres"
 	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	"github.com/okex/okexchain/x/backend/config"
 	"github.com/okex/okexchain/x/backend/types"
 	"github.com/okex/okexchain/x/token"
 	"github.com/pkg/errors"
@@ -26,8 +27,9 @@ import (
 
 // nolint
 const (
-	EngineTypeSqlite = okexchaincfg.BackendOrmEngineTypeSqlite
-	EngineTypeMysql  = okexchaincfg.BackendOrmEngineTypeMysql
+	EngineTypeSqlite   = config.EngineTypeSqlite
+	EngineTypeMysql    = config.EngineTypeMysql
+	EngineTypePostgres = config.EngineTypePostgres
 )
 
 // nolint
@@ -37,6 +39,7 @@ type OrmEngineInfo = okexchaincfg.BackendOrmEngineInfo
 // http://gorm.io/docs/query.html
 type ORM struct {
 	db                     *gorm.DB
+	engineType             string
 	logger                 *log.Logger
 	bufferLock             sync.Locker
 	singleEntryLock        sync.Locker
@@ -77,7 +80,7 @@ func New(enableLog bool, engineInfo *OrmEngineInfo, logger *log.Logger) (m *ORM,
 				orm.Debug(fmt.Sprintf("%s created", dbDir))
 			}
 		}
-	case EngineTypeMysql:
+	case EngineTypeMysql, EngineTypePostgres:
 	default:
 
 	}
@@ -89,6 +92,7 @@ func New(enableLog bool, engineInfo *OrmEngineInfo, logger *log.Logger) (m *ORM,
 
 	orm.logger = logger
 	orm.db = db
+	orm.engineType = engineInfo.EngineType
 	orm.lastK1Timestamp = -1
 	orm.lastK15Timestamp = -1
 	orm.bufferLock = new(sync.Mutex)
@@ -103,6 +107,7 @@ func New(enableLog bool, engineInfo *OrmEngineInfo, logger *log.Logger) (m *ORM,
 	orm.db.AutoMigrate(&types.SwapInfo{})
 	orm.db.AutoMigrate(&types.SwapWhitelist{})
 	orm.db.AutoMigrate(&types.ClaimInfo{})
+	orm.db.AutoMigrate(&types.BalanceChange{})
 
 	allKlinesMap := types.GetAllKlineMap()
 	for _, v := range allKlinesMap {
@@ -386,7 +391,7 @@ func (orm *ORM) getOpenCloseKline(startTS, endTS int64, product string, firstK i
 }
 
 func (orm *ORM) getMinTimestamp(tbName string) int64 {
-	sql := fmt.Sprintf("select min(Timestamp) as ts from %s", tbName)
+	sql := fmt.Sprintf("select min(%s) as ts from %s", orm.quote("timestamp"), orm.quote(tbName))
 	ts := int64(-1)
 	count := 0
 
@@ -404,7 +409,7 @@ func (orm *ORM) getMinTimestamp(tbName string) int64 {
 }
 
 func (orm *ORM) getMaxTimestamp(tbName string) int64 {
-	sql := fmt.Sprintf("select max(Timestamp) as ts from %s", tbName)
+	sql := fmt.Sprintf("select max(%s) as ts from %s", orm.quote("timestamp"), orm.quote(tbName))
 	ts := int64(-1)
 	count := 0
 
@@ -422,7 +427,8 @@ func (orm *ORM) getMaxTimestamp(tbName string) int64 {
 }
 
 func (orm *ORM) getMergingKlineTimestamp(tbName string, timestamp int64) int64 {
-	sql := fmt.Sprintf("select max(Timestamp) as ts from %s where Timestamp <=%d", tbName, timestamp)
+	sql := fmt.Sprintf("select max(%s) as ts from %s where %s <= %d",
+		orm.quote("timestamp"), orm.quote(tbName), orm.quote("timestamp"), timestamp)
 	ts := int64(-1)
 	count := 0
 
@@ -481,8 +487,11 @@ func (dm *DealDataSource) getDataSourceMinTimestamp() int64 {
 }
 
 func (dm *DealDataSource) getMaxMinSumByGroupSQL(startTS, endTS int64) string {
-	sql := fmt.Sprintf("select product, sum(Quantity) as quantity, max(Price) as high, min(Price) as low, count(price) as cnt from deals "+
-		"where Timestamp >= %d and Timestamp < %d and Side = 'BUY' group by product", startTS, endTS)
+	q := dm.orm.quote
+	sql := fmt.Sprintf("select %s, sum(%s) as quantity, max(%s) as high, min(%s) as low, count(%s) as cnt from %s "+
+		"where %s >= %d and %s < %d and %s = 'BUY' group by %s",
+		q("product"), q("quantity"), q("price"), q("price"), q("price"), q("deals"),
+		q("timestamp"), startTS, q("timestamp"), endTS, q("side"), q("product"))
 	return sql
 }
 
@@ -501,8 +510,11 @@ func (dm *MergeResultDataSource) getDataSourceMinTimestamp() int64 {
 }
 
 func (dm *MergeResultDataSource) getMaxMinSumByGroupSQL(startTS, endTS int64) string {
-	sql := fmt.Sprintf("select product, sum(Quantity) as quantity, max(Price) as high, min(Price) as low, count(price) as cnt from match_results "+
-		"where Timestamp >= %d and Timestamp < %d group by product", startTS, endTS)
+	q := dm.Orm.quote
+	sql := fmt.Sprintf("select %s, sum(%s) as quantity, max(%s) as high, min(%s) as low, count(%s) as cnt from %s "+
+		"where %s >= %d and %s < %d group by %s",
+		q("product"), q("quantity"), q("price"), q("price"), q("price"), q("match_results"),
+		q("timestamp"), startTS, q("timestamp"), endTS, q("product"))
 	return sql
 }
 
@@ -680,8 +692,8 @@ func (orm *ORM) getAllUpdatedProducts(anchorStartTS, anchorEndTS int64) ([]strin
 }
 
 func (orm *ORM) getAllUpdatedProductsFromTable(anchorStartTS, anchorEndTS int64, tb string) ([]string, error) {
-	sql := fmt.Sprintf("select distinct(Product) from %s where Timestamp >= %d and Timestamp < %d",
-		tb, anchorStartTS, anchorEndTS)
+	sql := fmt.Sprintf("select distinct(%s) from %s where %s >= %d and %s < %d",
+		orm.quote("product"), orm.quote(tb), orm.quote("timestamp"), anchorStartTS, orm.quote("timestamp"), anchorEndTS)
 
 	rows, err := orm.db.Raw(sql).Rows()
 
@@ -786,8 +798,10 @@ func (orm *ORM) MergeKlineM1(startTS, endTS int64, destKline types.IKline) (
 	nextTime := anchorStartTime.Add(interval)
 	for nextTime.Unix() <= anchorEndTime {
 
-		sql := fmt.Sprintf("select %d, product, sum(volume) as volume, max(high) as high, min(low) as low, count(*) as cnt from %s "+
-			"where Timestamp >= %d and Timestamp < %d group by product", anchorStartTime.Unix(), klineM1.(types.IKline).GetTableName(), anchorStartTime.Unix(), nextTime.Unix())
+		q := orm.quote
+		sql := fmt.Sprintf("select %d, %s, sum(%s) as volume, max(%s) as high, min(%s) as low, count(*) as cnt from %s "+
+			"where %s >= %d and %s < %d group by %s", anchorStartTime.Unix(), q("product"), q("volume"), q("high"), q("low"),
+			q(klineM1.(types.IKline).GetTableName()), q("timestamp"), anchorStartTime.Unix(), q("timestamp"), nextTime.Unix(), q("product"))
 		orm.Debug(fmt.Sprintf("[backend] MergeKlineM1 KlinesMX-#%d# sql=%s",
 			destKline.GetFreqInSecond(), sql))
 		rows, err := orm.db.Raw(sql).Rows()
@@ -1239,7 +1253,8 @@ func (orm *ORM) GetTransactionList(address string, txType, startTime, endTime in
 
 // BatchInsertOrUpdate return map mean success or fail
 func (orm *ORM) BatchInsertOrUpdate(newOrders []*types.Order, updatedOrders []*types.Order, deals []*types.Deal, mrs []*types.MatchResult,
-	feeDetails []*token.FeeDetail, trxs []*types.Transaction, swapInfos []*types.SwapInfo, claimInfos []*types.ClaimInfo) (resultMap map[string]int, err error) {
+	feeDetails []*token.FeeDetail, trxs []*types.Transaction, swapInfos []*types.SwapInfo, claimInfos []*types.ClaimInfo,
+	balanceChanges []*types.BalanceChange) (resultMap map[string]int, err error) {
 
 	orm.singleEntryLock.Lock()
 	defer orm.singleEntryLock.Unlock()
@@ -1256,6 +1271,7 @@ func (orm *ORM) BatchInsertOrUpdate(newOrders []*types.Order, updatedOrders []*t
 	resultMap["matchResults"] = 0
 	resultMap["swapInfos"] = 0
 	resultMap["claimInfos"] = 0
+	resultMap["balanceChanges"] = 0
 
 	// 1. Batch Insert Orders.
 	orderVItems := []string{}
@@ -1267,9 +1283,9 @@ func (orm *ORM) BatchInsertOrUpdate(newOrders []*types.Order, updatedOrders []*t
 
 	}
 	if len(orderVItems) > 0 {
-		orderValueSQL := strings.Join(orderVItems, ", ")
-		orderSQL := fmt.Sprintf("INSERT INTO `orders` (`tx_hash`,`order_id`,`sender`,`product`,`side`,`price`,"+
-			"`quantity`,`status`,`filled_avg_price`,`remain_quantity`,`timestamp`) VALUES %s", orderValueSQL)
+		orderSQL := orm.batchInsertSQL("orders", []string{"tx_hash", "order_id", "sender", "product", "side", "price",
+			"quantity", "status", "filled_avg_price", "remain_quantity", "timestamp"}, orderVItems,
+			[]string{"order_id"}, []string{"status", "filled_avg_price", "remain_quantity"})
 		ret := trx.Exec(orderSQL)
 		if ret.Error != nil {
 			return resultMap, ret.Error
@@ -1288,7 +1304,7 @@ func (orm *ORM) BatchInsertOrUpdate(newOrders []*types.Order, updatedOrders []*t
 	}
 
 	for _, mr := range mrs {
-		ret := trx.Create(mr)
+		ret := trx.Set("gorm:insert_option", orm.insertOption()).Create(mr)
 		if ret.Error != nil {
 			return resultMap, ret.Error
 		} else {
@@ -1304,8 +1320,8 @@ func (orm *ORM) BatchInsertOrUpdate(newOrders []*types.Order, updatedOrders []*t
 		dealVItems = append(dealVItems, vItem)
 	}
 	if len(dealVItems) > 0 {
-		dealsSQL := fmt.Sprintf("INSERT INTO `deals` (`timestamp`,`block_height`,`order_id`,`sender`,`product`,`side`,`price`,`quantity`,`fee`,`fee_receiver`) "+
-			"VALUES %s", strings.Join(dealVItems, ","))
+		dealsSQL := orm.batchInsertSQL("deals", []string{"timestamp", "block_height", "order_id", "sender", "product", "side",
+			"price", "quantity", "fee", "fee_receiver"}, dealVItems, []string{"block_height", "order_id"}, nil)
 		ret := trx.Exec(dealsSQL)
 		if ret.Error != nil {
 			return resultMap, ret.Error
@@ -1322,8 +1338,8 @@ func (orm *ORM) BatchInsertOrUpdate(newOrders []*types.Order, updatedOrders []*t
 		trxVItems = append(trxVItems, vItem)
 	}
 	if len(trxVItems) > 0 {
-		trxSQL := fmt.Sprintf("INSERT INTO `transactions` (`tx_hash`,`type`,`address`,`symbol`,`side`,`quantity`,`fee`,`timestamp`) "+
-			"VALUES %s", strings.Join(trxVItems, ", "))
+		trxSQL := orm.batchInsertSQL("transactions", []string{"tx_hash", "type", "address", "symbol", "side", "quantity",
+			"fee", "timestamp"}, trxVItems, nil, nil)
 		ret := trx.Exec(trxSQL)
 		if ret.Error != nil {
 			return resultMap, ret.Error
@@ -1340,7 +1356,7 @@ func (orm *ORM) BatchInsertOrUpdate(newOrders []*types.Order, updatedOrders []*t
 		fdVItems = append(fdVItems, vItem)
 	}
 	if len(fdVItems) > 0 {
-		fdSQL := fmt.Sprintf("INSERT INTO `fee_details` (`address`,`fee`,`fee_type`,`timestamp`) VALUES %s", strings.Join(fdVItems, ","))
+		fdSQL := orm.batchInsertSQL("fee_details", []string{"address", "fee", "fee_type", "timestamp"}, fdVItems, nil, nil)
 		ret := trx.Exec(fdSQL)
 		if ret.Error != nil {
 			return resultMap, ret.Error
@@ -1373,6 +1389,17 @@ func (orm *ORM) BatchInsertOrUpdate(newOrders []*types.Order, updatedOrders []*t
 		}
 	}
 
+	// 7. insert balance changes
+	for _, balanceChange := range balanceChanges {
+		if balanceChange != nil {
+			ret := trx.Set("gorm:insert_option", orm.insertOption()).Create(balanceChange)
+			if ret.Error != nil {
+				return resultMap, ret.Error
+			}
+			resultMap["balanceChanges"]++
+		}
+	}
+
 	trx.Commit()
 
 	return resultMap, nil
@@ -3,7 +3,9 @@ package orm
 import (
 	//"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"runtime/debug"
 	"strconv"
 	"testing"
@@ -541,11 +543,87 @@ func TestORMMatches(t *testing.T) {
 	//
 	mrds := MergeResultDataSource{orm}
 	require.EqualValues(t, 100, mrds.getDataSourceMinTimestamp())
-	sql := `select product, sum(Quantity) as quantity, max(Price) as high, min(Price) as low, count(price) as cnt from match_results where Timestamp >= 0 and Timestamp < 1574406957 group by product`
+	sql := "select `product`, sum(`quantity`) as quantity, max(`price`) as high, min(`price`) as low, count(`price`) as cnt from `match_results` where `timestamp` >= 0 and `timestamp` < 1574406957 group by `product`"
 	require.EqualValues(t, sql, mrds.getMaxMinSumByGroupSQL(0, 1574406957))
 
 }
 
+func TestORM_RebuildKlines(t *testing.T) {
+	orm, dbPath := MockSqlite3ORM()
+	defer DeleteDB(dbPath)
+
+	// 2021-01-01 00:00:00 UTC
+	base := int64(1609459200)
+	addMatches := []*types.MatchResult{
+		{Timestamp: base + 60, BlockHeight: 1, Product: types.TestTokenPair, Price: 10.0, Quantity: 1.0},
+		{Timestamp: base + 90, BlockHeight: 2, Product: types.TestTokenPair, Price: 12.0, Quantity: 2.0},
+		{Timestamp: base + 100, BlockHeight: 3, Product: types.TestTokenPair, Price: 9.0, Quantity: 3.0},
+		{Timestamp: base + 200, BlockHeight: 4, Product: types.TestTokenPair, Price: 11.0, Quantity: 4.0},
+		{Timestamp: base + 200, BlockHeight: 4, Product: "btc_" + common.NativeToken, Price: 1.0, Quantity: 1.0},
+	}
+	_, err := orm.AddMatchResults(addMatches)
+	require.Nil(t, err)
+
+	// klines of m1 & m3 in range [base, base+360)
+	klineTypes := []string{types.KlineTypeM3}
+	for i := 0; i < 2; i++ {
+		resultMap, err := orm.RebuildKlines(types.TestTokenPair, base+10, base+250, types.KlineSourceMatchResults, klineTypes)
+		require.Nil(t, err)
+		require.EqualValues(t, map[string]int{types.KlineTypeM1: 2, types.KlineTypeM3: 2}, resultMap)
+	}
+
+	var klinesM1 []types.KlineM1
+	require.Nil(t, orm.getKlinesByTimeRange(types.TestTokenPair, base, base+360, &klinesM1))
+	require.EqualValues(t, 2, len(klinesM1))
+	require.EqualValues(t, base+60, klinesM1[1].Timestamp)
+	require.EqualValues(t, 10.0, klinesM1[1].Open)
+	require.EqualValues(t, 9.0, klinesM1[1].Close)
+	require.EqualValues(t, 12.0, klinesM1[1].High)
+	require.EqualValues(t, 9.0, klinesM1[1].Low)
+	require.EqualValues(t, 6.0, klinesM1[1].Volume)
+
+	var klinesM3 []types.KlineM3
+	require.Nil(t, orm.getKlinesByTimeRange(types.TestTokenPair, base, base+360, &klinesM3))
+	require.EqualValues(t, 2, len(klinesM3))
+	require.EqualValues(t, base+180, klinesM3[0].Timestamp)
+	require.EqualValues(t, base, klinesM3[1].Timestamp)
+	require.EqualValues(t, 10.0, klinesM3[1].Open)
+	require.EqualValues(t, 9.0, klinesM3[1].Close)
+	require.EqualValues(t, 6.0, klinesM3[1].Volume)
+
+	var otherKlines []types.KlineM1
+	require.Nil(t, orm.getKlinesByTimeRange("btc_"+common.NativeToken, base, base+360, &otherKlines))
+	require.EqualValues(t, 0, len(otherKlines))
+
+	_, err = orm.RebuildKlines(types.TestTokenPair, base+10, base+250, "unknown", klineTypes)
+	require.NotNil(t, err)
+	_, err = orm.RebuildKlines(types.TestTokenPair, base+250, base+10, types.KlineSourceDeals, klineTypes)
+	require.NotNil(t, err)
+}
+
+func TestORM_PostgresDialect(t *testing.T) {
+	orm := &ORM{engineType: EngineTypePostgres}
+
+	mrds := MergeResultDataSource{orm}
+	sql := `select "product", sum("quantity") as quantity, max("price") as high, min("price") as low, count("price") as cnt from "match_results" where "timestamp" >= 0 and "timestamp" < 1574406957 group by "product"`
+	require.EqualValues(t, sql, mrds.getMaxMinSumByGroupSQL(0, 1574406957))
+
+	sql = orm.batchInsertSQL("orders", []string{"order_id", "status"}, []string{"('ID1','1')", "('ID2','0')"},
+		[]string{"order_id"}, []string{"status"})
+	require.EqualValues(t, `INSERT INTO "orders" ("order_id","status") VALUES ('ID1','1'), ('ID2','0') `+
+		`ON CONFLICT ("order_id") DO UPDATE SET "status" = EXCLUDED."status"`, sql)
+
+	sql = orm.batchInsertSQL("deals", []string{"block_height", "order_id"}, []string{"('1','ID1')"},
+		[]string{"block_height", "order_id"}, nil)
+	require.EqualValues(t, `INSERT INTO "deals" ("block_height","order_id") VALUES ('1','ID1') `+
+		`ON CONFLICT ("block_height","order_id") DO NOTHING`, sql)
+
+	sqliteOrm := &ORM{engineType: EngineTypeSqlite}
+	sql = sqliteOrm.batchInsertSQL("deals", []string{"block_height", "order_id"}, []string{"('1','ID1')"},
+		[]string{"block_height", "order_id"}, nil)
+	require.EqualValues(t, "INSERT INTO `deals` (`block_height`,`order_id`) VALUES ('1','ID1')", sql)
+}
+
 func TestSqlite3_ORMDeals(t *testing.T) {
 	orm, dbPath := MockSqlite3ORM()
 	defer DeleteDB(dbPath)
@@ -792,7 +870,10 @@ func testORMBatchInsert(t *testing.T, orm *ORM) {
 		{Address: "addr1", PoolName: "test-pool-name", Claimed: "10xxb", Timestamp: 100},
 	}
 
-	resultMap, e := orm.BatchInsertOrUpdate(newOrders, updatedOrders, addDeals, mrs, feeDetails, txs, swapInfos, claimInfos)
+	balanceChanges := types.GenerateBalanceChanges(1, 100, txs, addDeals, swapInfos, claimInfos, feeDetails)
+
+	resultMap, e := orm.BatchInsertOrUpdate(newOrders, updatedOrders, addDeals, mrs, feeDetails, txs, swapInfos, claimInfos,
+		balanceChanges)
 	require.True(t, resultMap != nil && e == nil)
 
 	require.True(t, resultMap != nil && resultMap["newOrders"] == 2000)
@@ -801,8 +882,10 @@ func testORMBatchInsert(t *testing.T, orm *ORM) {
 	require.True(t, resultMap != nil && resultMap["deals"] == 4)
 	require.True(t, resultMap != nil && resultMap["feeDetails"] == 4)
 	require.True(t, resultMap != nil && resultMap["swapInfos"] == 1)
+	require.True(t, resultMap != nil && resultMap["balanceChanges"] == len(balanceChanges))
 
-	resultMap2, e2 := orm.BatchInsertOrUpdate(newOrders, updatedOrders, addDeals, mrs, feeDetails, txs, swapInfos, claimInfos)
+	resultMap2, e2 := orm.BatchInsertOrUpdate(newOrders, updatedOrders, addDeals, mrs, feeDetails, txs, swapInfos, claimInfos,
+		balanceChanges)
 	fmt.Printf("%+v\n", e2)
 	require.True(t, resultMap2 != nil, resultMap2)
 	require.True(t, e2 != nil, e2)
@@ -814,6 +897,91 @@ func TestORM_BatchInsert(t *testing.T) {
 	testORMBatchInsert(t, orm)
 }
 
+func TestORM_BalanceChanges(t *testing.T) {
+	orm, dbPath := MockSqlite3ORM()
+	defer DeleteDB(dbPath)
+
+	balanceChanges := []*types.BalanceChange{
+		{Height: 1, Address: "addr1", Symbol: common.NativeToken, Source: types.BalanceChangeSourceSend, Amount: "10.0", Timestamp: 100},
+		{Height: 2, Address: "addr1", Symbol: common.NativeToken, Source: types.BalanceChangeSourceFee, Amount: "-0.1", Timestamp: 200},
+		{Height: 3, Address: "addr1", Symbol: common.TestToken, Source: types.BalanceChangeSourceDeal, Amount: "1.0", Timestamp: 300},
+		{Height: 3, Address: "addr2", Symbol: common.TestToken, Source: types.BalanceChangeSourceDeal, Amount: "-1.0", Timestamp: 300},
+	}
+	cnt, err := orm.AddBalanceChanges(balanceChanges)
+	require.Nil(t, err)
+	require.Equal(t, 4, cnt)
+
+	// duplicated balance changes of a block
+	_, err = orm.AddBalanceChanges(balanceChanges[:1])
+	require.NotNil(t, err)
+
+	changes := orm.GetBalanceChangesV2("addr1", "", "", "", 10)
+	require.Equal(t, 3, len(changes))
+	require.Equal(t, int64(3), changes[0].Height)
+
+	changes = orm.GetBalanceChangesV2("addr1", common.NativeToken, "1", "", 10)
+	require.Equal(t, 1, len(changes))
+	require.Equal(t, "-0.1", changes[0].Amount)
+
+	changes = orm.GetBalanceChangesAfterHeight("addr1", 1)
+	require.Equal(t, 2, len(changes))
+	require.Equal(t, int64(2), changes[0].Height)
+}
+
+func TestORM_ArchiveAndImport(t *testing.T) {
+	orm, dbPath := MockSqlite3ORM()
+	defer DeleteDB(dbPath)
+	archiveDir, err := ioutil.TempDir("", "backend_archive")
+	require.Nil(t, err)
+	defer os.RemoveAll(archiveDir)
+
+	deals := []*types.Deal{
+		{Timestamp: 100, BlockHeight: 1, OrderID: "ID1", Sender: "addr1", Product: types.TestTokenPair, Side: types.BuyOrder, Price: 1.0, Quantity: 1.0},
+		{Timestamp: 200, BlockHeight: 2, OrderID: "ID2", Sender: "addr1", Product: types.TestTokenPair, Side: types.SellOrder, Price: 1.1, Quantity: 2.0},
+		{Timestamp: 300, BlockHeight: 3, OrderID: "ID3", Sender: "addr2", Product: types.TestTokenPair, Side: types.BuyOrder, Price: 1.2, Quantity: 3.0},
+	}
+	_, err = orm.AddDeals(deals)
+	require.Nil(t, err)
+
+	// nothing to archive
+	manifest, err := orm.ArchiveBefore(types.TableDeals, 100, archiveDir)
+	require.Nil(t, err)
+	require.Nil(t, manifest)
+
+	manifest, err = orm.ArchiveBefore(types.TableDeals, 300, archiveDir)
+	require.Nil(t, err)
+	require.Equal(t, 2, manifest.Rows)
+	require.Equal(t, int64(100), manifest.StartTimestamp)
+	require.Equal(t, int64(300), manifest.EndTimestamp)
+	_, total := orm.GetDeals("", "", "", 0, 0, 0, 10)
+	require.Equal(t, 1, total)
+
+	manifestPath := filepath.Join(archiveDir,
+		types.GetArchiveBaseName(types.TableDeals, 100, 300)+types.ArchiveManifestSuffix)
+	for i := 0; i < 2; i++ {
+		imported, err := orm.ImportArchive(manifestPath)
+		require.Nil(t, err)
+		require.Equal(t, manifest.SHA256, imported.SHA256)
+		restored, total := orm.GetDeals("", "", "", 0, 0, 0, 10)
+		require.Equal(t, 3, total)
+		require.Contains(t, restored, *deals[1])
+	}
+
+	// only closed orders are archived
+	orders := []*types.Order{
+		{TxHash: "hash1", OrderID: "ID1", Sender: "addr1", Product: types.TestTokenPair, Side: types.BuyOrder, Price: "1.0", Quantity: "1.0", Status: 0, Timestamp: 100},
+		{TxHash: "hash2", OrderID: "ID2", Sender: "addr1", Product: types.TestTokenPair, Side: types.BuyOrder, Price: "1.0", Quantity: "1.0", Status: 1, Timestamp: 100},
+	}
+	_, err = orm.AddOrders(orders)
+	require.Nil(t, err)
+	manifest, err = orm.ArchiveBefore(types.TableOrders, 200, archiveDir)
+	require.Nil(t, err)
+	require.Equal(t, 1, manifest.Rows)
+
+	_, err = orm.ArchiveBefore("accounts", 200, archiveDir)
+	require.NotNil(t, err)
+}
+
 func TestORM_CloseDB(t *testing.T) {
 	closeORM, err := NewSqlite3ORM(false, "/tmp/", "test_close.db", nil)
 	require.Nil(t, err)
@@ -899,3 +1067,59 @@ func TestORM_DeferRollbackTx(t *testing.T) {
 	panic("orm deferRollbackTx recover will catch the panic")
 
 }
+
+func TestORM_Keyset(t *testing.T) {
+	orm, dbPath := MockSqlite3ORM()
+	defer DeleteDB(dbPath)
+
+	// deals of the same timestamp are paged by (block_height, order_id)
+	deals := []*types.Deal{
+		{BlockHeight: 1, OrderID: "ID0000000001-1", Sender: "addr1", Product: "xxb_okt", Side: types.BuyOrder, Timestamp: 100},
+		{BlockHeight: 2, OrderID: "ID0000000002-1", Sender: "addr1", Product: "xxb_okt", Side: types.BuyOrder, Timestamp: 100},
+		{BlockHeight: 2, OrderID: "ID0000000002-2", Sender: "addr1", Product: "xxb_okt", Side: types.SellOrder, Timestamp: 100},
+	}
+	_, err := orm.AddDeals(deals)
+	require.Nil(t, err)
+
+	page, total, hasNext := orm.GetDealsByKeyset("addr1", "", "", 0, 200, nil, 2)
+	require.Equal(t, 3, total)
+	require.True(t, hasNext)
+	require.Equal(t, []string{"ID0000000002-2", "ID0000000002-1"}, []string{page[0].OrderID, page[1].OrderID})
+
+	// a new deal doesn't shift the next page as an offset does
+	_, err = orm.AddDeals([]*types.Deal{
+		{BlockHeight: 3, OrderID: "ID0000000003-1", Sender: "addr1", Product: "xxb_okt", Side: types.BuyOrder, Timestamp: 100},
+	})
+	require.Nil(t, err)
+	last := page[len(page)-1]
+	page, total, hasNext = orm.GetDealsByKeyset("addr1", "", "", 0, 200,
+		&KeysetCursor{Key: last.BlockHeight, ID: last.OrderID}, 2)
+	require.Equal(t, 4, total)
+	require.False(t, hasNext)
+	require.Equal(t, 1, len(page))
+	require.Equal(t, "ID0000000001-1", page[0].OrderID)
+
+	// the rows of a tx are skipped by the count returned already
+	txs := []*types.Transaction{
+		{TxHash: "hash1", Type: types.TxTypeOrderNew, Address: "addr1", Symbol: "xxb_okt", Side: types.TxSideBuy, Quantity: "1", Timestamp: 100},
+		{TxHash: "hash2", Type: types.TxTypeOrderNew, Address: "addr1", Symbol: "xxb_okt", Side: types.TxSideBuy, Quantity: "1", Timestamp: 200},
+		{TxHash: "hash2", Type: types.TxTypeOrderNew, Address: "addr1", Symbol: "xxb_okt", Side: types.TxSideBuy, Quantity: "2", Timestamp: 200},
+		{TxHash: "hash2", Type: types.TxTypeOrderNew, Address: "addr1", Symbol: "yyb_okt", Side: types.TxSideSell, Quantity: "1", Timestamp: 200},
+	}
+	_, err = orm.AddTransactions(txs)
+	require.Nil(t, err)
+
+	txPage, total, hasNext := orm.GetTransactionsByKeyset("addr1", 0, 0, 0, nil, 2)
+	require.Equal(t, 4, total)
+	require.True(t, hasNext)
+	require.Equal(t, []string{"1", "2"}, []string{txPage[0].Quantity, txPage[1].Quantity})
+
+	// 2 rows of hash2 have been returned
+	txPage, total, hasNext = orm.GetTransactionsByKeyset("addr1", 0, 0, 0,
+		&KeysetCursor{Key: 200, ID: "hash2", Skip: 2}, 2)
+	require.Equal(t, 4, total)
+	require.False(t, hasNext)
+	require.Equal(t, 2, len(txPage))
+	require.Equal(t, "yyb_okt", txPage[0].Symbol)
+	require.Equal(t, "hash1", txPage[1].TxHash)
+}
@@ -0,0 +1,182 @@
+package orm
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/jinzhu/gorm"
+	"github.com/okex/okexchain/x/backend/types"
+)
+
+// klineTrade is the price & quantity of a trade which klines are built from
+type klineTrade struct {
+	Timestamp int64
+	Price     float64
+	Quantity  float64
+}
+
+// RebuildKlines recomputes klines of product in [startTS, endTS) from match_results or deals.
+// The range is expanded to the boundaries of the longest kline type, and the klines in the expanded range
+// are replaced, so it's safe to run it repeatedly. kline_m1 is always rebuilt because others are merged from it
+func (orm *ORM) RebuildKlines(product string, startTS, endTS int64, source string, klineTypes []string) (
+	resultMap map[string]int, err error) {
+	if product == "" {
+		return nil, fmt.Errorf("product is required to rebuild klines")
+	}
+	if endTS <= startTS {
+		return nil, fmt.Errorf("EndTimestamp %d <= StartTimestamp %d, somewhere goes wrong", endTS, startTS)
+	}
+
+	maxFreq := int64(types.GetFreqByKlineType(types.KlineTypeM1))
+	destTypes := []string{}
+	for _, klineType := range klineTypes {
+		freq := int64(types.GetFreqByKlineType(klineType))
+		if freq == 0 {
+			return nil, fmt.Errorf("unknown kline type: %s", klineType)
+		}
+		if klineType == types.KlineTypeM1 {
+			continue
+		}
+		if freq > maxFreq {
+			maxFreq = freq
+		}
+		destTypes = append(destTypes, klineType)
+	}
+	sort.Slice(destTypes, func(i, j int) bool {
+		return types.GetFreqByKlineType(destTypes[i]) < types.GetFreqByKlineType(destTypes[j])
+	})
+
+	anchorStartTS := startTS / maxFreq * maxFreq
+	anchorEndTS := (endTS + maxFreq - 1) / maxFreq * maxFreq
+
+	trades, err := orm.getKlineTrades(product, anchorStartTS, anchorEndTS, source)
+	if err != nil {
+		return nil, err
+	}
+
+	orm.singleEntryLock.Lock()
+	defer orm.singleEntryLock.Unlock()
+
+	resultMap = map[string]int{}
+	tx := orm.db.Begin()
+	defer func() { orm.deferRollbackTx(tx, err) }()
+
+	// 1. kline_m1 from trades
+	klinesM1 := buildKlinesFromTrades(product, trades, int64(types.GetFreqByKlineType(types.KlineTypeM1)))
+	if err = orm.replaceKlines(tx, types.KlineTypeM1, product, anchorStartTS, anchorEndTS, klinesM1); err != nil {
+		return resultMap, err
+	}
+	resultMap[types.KlineTypeM1] = len(klinesM1)
+
+	// 2. kline_mX merged from kline_m1
+	for _, klineType := range destTypes {
+		klines := mergeKlines(product, klinesM1, int64(types.GetFreqByKlineType(klineType)))
+		if err = orm.replaceKlines(tx, klineType, product, anchorStartTS, anchorEndTS, klines); err != nil {
+			return resultMap, err
+		}
+		resultMap[klineType] = len(klines)
+	}
+
+	tx.Commit()
+	orm.Debug(fmt.Sprintf("RebuildKlines of %s in [%s, %s) result: %+v", product,
+		types.TimeString(anchorStartTS), types.TimeString(anchorEndTS), resultMap))
+	return resultMap, nil
+}
+
+func (orm *ORM) getKlineTrades(product string, startTS, endTS int64, source string) ([]klineTrade, error) {
+	var trades []klineTrade
+	var query = orm.db.Where("product = ? and timestamp >= ? and timestamp < ?", product, startTS, endTS)
+
+	switch source {
+	case types.KlineSourceMatchResults:
+		var matchResults []types.MatchResult
+		if r := query.Order("timestamp asc, block_height asc").Find(&matchResults); r.Error != nil {
+			return nil, r.Error
+		}
+		for _, mr := range matchResults {
+			trades = append(trades, klineTrade{Timestamp: mr.Timestamp, Price: mr.Price, Quantity: mr.Quantity})
+		}
+	case types.KlineSourceDeals:
+		// every match produces a BUY deal and a SELL deal, count the BUY side only
+		var deals []types.Deal
+		if r := query.Where("side = ?", "BUY").Order("timestamp asc, block_height asc").Find(&deals); r.Error != nil {
+			return nil, r.Error
+		}
+		for _, deal := range deals {
+			trades = append(trades, klineTrade{Timestamp: deal.Timestamp, Price: deal.Price, Quantity: deal.Quantity})
+		}
+	default:
+		return nil, fmt.Errorf("unknown kline data source: %s, should be %s or %s",
+			source, types.KlineSourceMatchResults, types.KlineSourceDeals)
+	}
+
+	return trades, nil
+}
+
+// replaceKlines deletes klines of product in [startTS, endTS) and inserts the new ones
+func (orm *ORM) replaceKlines(tx *gorm.DB, klineType, product string, startTS, endTS int64, klines []types.BaseKline) error {
+	kline, err := types.NewKlineFactory(klineType, nil)
+	if err != nil {
+		return err
+	}
+	if r := tx.Delete(kline, "timestamp >= ? and timestamp < ? and product = ?", startTS, endTS, product); r.Error != nil {
+		return r.Error
+	}
+
+	for i := range klines {
+		if r := tx.Create(types.MustNewKlineFactory(klineType, &klines[i])); r.Error != nil {
+			return r.Error
+		}
+	}
+	return nil
+}
+
+// buildKlinesFromTrades aggregates trades sorted by timestamp into klines of freq
+func buildKlinesFromTrades(product string, trades []klineTrade, freq int64) []types.BaseKline {
+	var klines []types.BaseKline
+	for _, trade := range trades {
+		anchorTS := trade.Timestamp / freq * freq
+		if len(klines) == 0 || klines[len(klines)-1].Timestamp != anchorTS {
+			klines = append(klines, types.BaseKline{
+				Product: product, Timestamp: anchorTS,
+				Open: trade.Price, Close: trade.Price, High: trade.Price, Low: trade.Price,
+			})
+		}
+
+		k := &klines[len(klines)-1]
+		k.Close = trade.Price
+		k.Volume += trade.Quantity
+		if trade.Price > k.High {
+			k.High = trade.Price
+		}
+		if trade.Price < k.Low {
+			k.Low = trade.Price
+		}
+	}
+	return klines
+}
+
+// mergeKlines merges klines sorted by timestamp into klines of freq
+func mergeKlines(product string, srcKlines []types.BaseKline, freq int64) []types.BaseKline {
+	var klines []types.BaseKline
+	for _, src := range srcKlines {
+		anchorTS := src.Timestamp / freq * freq
+		if len(klines) == 0 || klines[len(klines)-1].Timestamp != anchorTS {
+			klines = append(klines, types.BaseKline{
+				Product: product, Timestamp: anchorTS,
+				Open: src.Open, Close: src.Close, High: src.High, Low: src.Low,
+			})
+		}
+
+		k := &klines[len(klines)-1]
+		k.Close = src.Close
+		k.Volume += src.Volume
+		if src.High > k.High {
+			k.High = src.High
+		}
+		if src.Low < k.Low {
+			k.Low = src.Low
+		}
+	}
+	return klines
+}
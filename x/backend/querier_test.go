@@ -203,3 +203,74 @@ func TestQuerier_QueryTxList(t *testing.T) {
 	}
 
 }
+
+func TestQuerier_QueryGraphQL(t *testing.T) {
+	_, ctx, querier, orders := mockQuerier(t)
+	path := []string{types.QueryGraphQL}
+
+	query := func(q string, variables map[string]interface{}) map[string]interface{} {
+		requestData, errMarshal := json.Marshal(types.GraphQLRequest{Query: q, Variables: variables})
+		require.Nil(t, errMarshal)
+		bytesBuffer, err := querier(ctx, path, abci.RequestQuery{Data: requestData})
+		require.Nil(t, err)
+		result := map[string]interface{}{}
+		require.Nil(t, json.Unmarshal(bytesBuffer, &result))
+		fmt.Println(fmt.Sprintf("finalResult: %s", bytesBuffer))
+		return result
+	}
+
+	// 1. empty query
+	_, err := querier(ctx, path, abci.RequestQuery{Data: []byte(`{"query":""}`)})
+	require.NotNil(t, err)
+
+	// 2. several resources in one request, paginated by cursor
+	q := `query($address: String!, $after: String) {
+		orders(address: $address, open: false, first: 1, after: $after) {
+			nodes { orderId sender product }
+			pageInfo { endCursor hasNextPage }
+			totalCount
+		}
+		deals(address: $address) { totalCount }
+		matches(product: "` + types.TestTokenPair + `") { nodes { price quantity } }
+		klines(product: "` + types.TestTokenPair + `", granularity: 60) { nodes { timestamp close } }
+		tickers { product price }
+		tokens(first: 10) { nodes { symbol } totalCount }
+	}`
+	address := orders[0].Sender.String()
+	result := query(q, map[string]interface{}{"address": address})
+	require.Nil(t, result["errors"])
+	data := result["data"].(map[string]interface{})
+	for _, field := range []string{"orders", "deals", "matches", "klines", "tickers", "tokens"} {
+		require.NotNil(t, data[field], field)
+	}
+
+	orderConn := data["orders"].(map[string]interface{})
+	totalCount := int(orderConn["totalCount"].(float64))
+	pageInfo := orderConn["pageInfo"].(map[string]interface{})
+	seen := len(orderConn["nodes"].([]interface{}))
+	for pageInfo["hasNextPage"].(bool) {
+		result = query(q, map[string]interface{}{"address": address, "after": pageInfo["endCursor"]})
+		require.Nil(t, result["errors"])
+		orderConn = result["data"].(map[string]interface{})["orders"].(map[string]interface{})
+		pageInfo = orderConn["pageInfo"].(map[string]interface{})
+		seen += len(orderConn["nodes"].([]interface{}))
+	}
+	require.Equal(t, totalCount, seen)
+
+	// 3. invalid cursor
+	result = query(q, map[string]interface{}{"address": address, "after": "invalid"})
+	require.NotNil(t, result["errors"])
+
+	// 4. Long arguments beyond 32 bits, as a number or a decimal string
+	q = `query($address: String!, $endTime: Long) {
+		orders(address: $address, open: false, endTime: $endTime) { nodes { timestamp } totalCount }
+	}`
+	for _, endTime := range []interface{}{int64(4102444800), "4102444800"} {
+		result = query(q, map[string]interface{}{"address": address, "endTime": endTime})
+		require.Nil(t, result["errors"])
+		orderConn = result["data"].(map[string]interface{})["orders"].(map[string]interface{})
+		require.Equal(t, totalCount, int(orderConn["totalCount"].(float64)))
+	}
+	result = query(q, map[string]interface{}{"address": address, "endTime": "2100-01-01"})
+	require.NotNil(t, result["errors"])
+}
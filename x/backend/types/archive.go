@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+)
+
+// nolint
+const (
+	TableOrders         = "orders"
+	TableDeals          = "deals"
+	TableMatchResults   = "match_results"
+	TableFeeDetails     = "fee_details"
+	TableTransactions   = "transactions"
+	TableSwapInfos      = "swap_infos"
+	TableClaimInfos     = "claim_infos"
+	TableBalanceChanges = "balance_changes"
+
+	ArchiveFileSuffix     = ".jsonl.gz"
+	ArchiveManifestSuffix = ".manifest.json"
+)
+
+// ArchiveManifest describes an archive file of the rows of a table with timestamp in [StartTimestamp, EndTimestamp).
+// The archive file is a gzip compressed file of json lines, File is relative to the directory of manifest
+type ArchiveManifest struct {
+	Table          string `json:"table"`
+	StartTimestamp int64  `json:"start_timestamp"`
+	EndTimestamp   int64  `json:"end_timestamp"`
+	Rows           int    `json:"rows"`
+	File           string `json:"file"`
+	SHA256         string `json:"sha256"`
+	CreatedAt      int64  `json:"created_at"`
+}
+
+// GetArchiveBaseName returns the base name of archive & manifest files, e.g.) deals/deals_1609459200_1612137600
+func GetArchiveBaseName(table string, startTS, endTS int64) string {
+	return filepath.Join(table, fmt.Sprintf("%s_%d_%d", table, startTS, endTS))
+}
+
+// GetRetentionTables returns the tables whose expired rows are archived and deleted by the retention policy,
+// which is configured by clean_ups_kept_days in maintain.conf
+func GetRetentionTables() []string {
+	tables := []string{TableOrders, TableDeals, TableMatchResults, TableFeeDetails, TableTransactions,
+		TableSwapInfos, TableClaimInfos, TableBalanceChanges}
+	for _, klineType := range GetAllKlineMap() {
+		tables = append(tables, klineType)
+	}
+	sort.Strings(tables)
+	return tables
+}
@@ -0,0 +1,344 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	orderTypes "github.com/okex/okexchain/x/order/types"
+	"github.com/okex/okexchain/x/token"
+)
+
+// nolint
+const (
+	BalanceChangeSourceSend      = "send"
+	BalanceChangeSourceDeal      = "deal"
+	BalanceChangeSourceSwap      = "swap"
+	BalanceChangeSourceFarmClaim = "farm_claim"
+	BalanceChangeSourceFee       = "fee"
+)
+
+// BalanceChangeSources are the sources of the balance changes recorded by backend. The coin movements of the other
+// modules (gas fees, staking, token issue/mint/burn, dex/gov deposits, farm lock/unlock, swap liquidity, evm
+// transfers) are not recorded, so the balances rolled back with the changes may be wrong
+var BalanceChangeSources = []string{
+	BalanceChangeSourceSend,
+	BalanceChangeSourceDeal,
+	BalanceChangeSourceSwap,
+	BalanceChangeSourceFarmClaim,
+	BalanceChangeSourceFee,
+}
+
+// BalanceChange is the net change of an address's token balance caused by one source in a block.
+// Amount is a signed decimal string, negative for the decrease of balance
+type BalanceChange struct {
+	Height    int64  `gorm:"PRIMARY_KEY;type:bigint" json:"height" v2:"height"`
+	Address   string `gorm:"PRIMARY_KEY;type:varchar(80)" json:"address" v2:"address"`
+	Symbol    string `gorm:"PRIMARY_KEY;type:varchar(20)" json:"symbol" v2:"currency"`
+	Source    string `gorm:"PRIMARY_KEY;type:varchar(20)" json:"source" v2:"source"`
+	Amount    string `gorm:"type:varchar(40)" json:"amount" v2:"amount"`
+	Timestamp int64  `gorm:"index;" json:"timestamp" v2:"timestamp"`
+}
+
+// AccountBalanceV2 is the balance of a token of an address at a block height
+type AccountBalanceV2 struct {
+	Height   int64  `json:"height"`
+	Currency string `json:"currency"`
+	Balance  string `json:"balance"`
+}
+
+// AccountBalancesV2 is the balances of an address at a block height. Complete is false if the balances are rolled
+// back from the latest ones, as only the changes from TrackedSources are taken into account then
+type AccountBalancesV2 struct {
+	Height         int64              `json:"height"`
+	Complete       bool               `json:"complete"`
+	TrackedSources []string           `json:"tracked_sources"`
+	Balances       []AccountBalanceV2 `json:"balances"`
+}
+
+// ProductPnLV2 is the profit and loss of an address trading a product, valued in the quote token.
+// It's calculated with the average cost method over the deals of the address
+type ProductPnLV2 struct {
+	InstrumentID  string `json:"instrument_id"`
+	Position      string `json:"position"`
+	AvgCost       string `json:"avg_cost"`
+	LastPrice     string `json:"last"`
+	RealisedPnL   string `json:"realised_pnl"`
+	UnrealisedPnL string `json:"unrealised_pnl"`
+	BuyVolume     string `json:"buy_volume"`
+	SellVolume    string `json:"sell_volume"`
+}
+
+type balanceChangeKey struct {
+	address string
+	symbol  string
+	source  string
+}
+
+// balanceChangeAccumulator sums the changes of balance in a block by address, symbol and source
+type balanceChangeAccumulator struct {
+	keys    []balanceChangeKey
+	amounts map[balanceChangeKey]sdk.Dec
+}
+
+func newBalanceChangeAccumulator() *balanceChangeAccumulator {
+	return &balanceChangeAccumulator{amounts: map[balanceChangeKey]sdk.Dec{}}
+}
+
+func (acc *balanceChangeAccumulator) add(address, symbol, source string, amount sdk.Dec) {
+	if address == "" || symbol == "" || amount.IsZero() {
+		return
+	}
+	key := balanceChangeKey{address: address, symbol: symbol, source: source}
+	if sum, ok := acc.amounts[key]; ok {
+		acc.amounts[key] = sum.Add(amount)
+		return
+	}
+	acc.keys = append(acc.keys, key)
+	acc.amounts[key] = amount
+}
+
+func (acc *balanceChangeAccumulator) addCoins(address, source string, coinsStr string, negative bool) {
+	coins, err := sdk.ParseDecCoins(coinsStr)
+	if err != nil {
+		return
+	}
+	for _, coin := range coins {
+		if negative {
+			acc.add(address, coin.Denom, source, coin.Amount.Neg())
+		} else {
+			acc.add(address, coin.Denom, source, coin.Amount)
+		}
+	}
+}
+
+// GenerateBalanceChanges returns the balance changes in a block from the data collected by backend.
+// The fees of orders are taken from fee details only, because the fee of a deal is paid by the locked fee of
+// the order, which is refunded by the fee detail of "receive"
+func GenerateBalanceChanges(height, timestamp int64, txs []*Transaction, deals []*Deal, swapInfos []*SwapInfo,
+	claimInfos []*ClaimInfo, feeDetails []*token.FeeDetail) []*BalanceChange {
+	acc := newBalanceChangeAccumulator()
+
+	for _, tx := range txs {
+		if tx == nil || tx.Type != TxTypeTransfer {
+			continue
+		}
+		amount, err := sdk.NewDecFromStr(tx.Quantity)
+		if err != nil {
+			continue
+		}
+		if tx.Side == TxSideFrom {
+			amount = amount.Neg()
+		}
+		acc.add(tx.Address, tx.Symbol, BalanceChangeSourceSend, amount)
+	}
+
+	for _, deal := range deals {
+		if deal == nil {
+			continue
+		}
+		symbols := strings.Split(deal.Product, "_")
+		if len(symbols) != 2 {
+			continue
+		}
+		quantity, err := Float64ToDec(deal.Quantity)
+		if err != nil {
+			continue
+		}
+		price, err := Float64ToDec(deal.Price)
+		if err != nil {
+			continue
+		}
+		funds := quantity.Mul(price)
+		if deal.Side == orderTypes.BuyOrder {
+			acc.add(deal.Sender, symbols[0], BalanceChangeSourceDeal, quantity)
+			acc.add(deal.Sender, symbols[1], BalanceChangeSourceDeal, funds.Neg())
+		} else {
+			acc.add(deal.Sender, symbols[0], BalanceChangeSourceDeal, quantity.Neg())
+			acc.add(deal.Sender, symbols[1], BalanceChangeSourceDeal, funds)
+		}
+	}
+
+	for _, swapInfo := range swapInfos {
+		if swapInfo == nil {
+			continue
+		}
+		acc.addCoins(swapInfo.Address, BalanceChangeSourceSwap, swapInfo.SellAmount, true)
+		acc.addCoins(swapInfo.Address, BalanceChangeSourceSwap, swapInfo.BuysAmount, false)
+	}
+
+	for _, claimInfo := range claimInfos {
+		if claimInfo == nil {
+			continue
+		}
+		acc.addCoins(claimInfo.Address, BalanceChangeSourceFarmClaim, claimInfo.Claimed, false)
+	}
+
+	for _, feeDetail := range feeDetails {
+		if feeDetail == nil {
+			continue
+		}
+		// the unused part of locked fee is refunded to the sender
+		acc.addCoins(feeDetail.Address, BalanceChangeSourceFee, feeDetail.Fee,
+			feeDetail.FeeType != orderTypes.FeeTypeOrderReceive)
+	}
+
+	changes := make([]*BalanceChange, 0, len(acc.keys))
+	for _, key := range acc.keys {
+		amount := acc.amounts[key]
+		if amount.IsZero() {
+			continue
+		}
+		changes = append(changes, &BalanceChange{
+			Height:    height,
+			Address:   key.address,
+			Symbol:    key.symbol,
+			Source:    key.source,
+			Amount:    amount.String(),
+			Timestamp: timestamp,
+		})
+	}
+	return changes
+}
+
+// GetBalancesAtHeight rolls back the current balances at latestHeight with the balance changes after height.
+// The result is marked incomplete unless height is the latest one, see BalanceChangeSources. A balance rolled back
+// below zero reveals an untracked coin movement, it's reported as zero instead of a negative holding
+func GetBalancesAtHeight(height, latestHeight int64, current sdk.SysCoins,
+	changesAfter []BalanceChange) AccountBalancesV2 {
+	balanceMap := map[string]sdk.Dec{}
+	for _, coin := range current {
+		balanceMap[coin.Denom] = coin.Amount
+	}
+	for _, change := range changesAfter {
+		amount, err := sdk.NewDecFromStr(change.Amount)
+		if err != nil {
+			continue
+		}
+		if balance, ok := balanceMap[change.Symbol]; ok {
+			balanceMap[change.Symbol] = balance.Sub(amount)
+		} else {
+			balanceMap[change.Symbol] = amount.Neg()
+		}
+	}
+
+	complete := height >= latestHeight
+	balances := make([]AccountBalanceV2, 0, len(balanceMap))
+	for symbol, balance := range balanceMap {
+		if balance.IsNegative() {
+			complete = false
+			continue
+		}
+		if balance.IsZero() {
+			continue
+		}
+		balances = append(balances, AccountBalanceV2{Height: height, Currency: symbol, Balance: balance.String()})
+	}
+	sort.Slice(balances, func(i, j int) bool {
+		return balances[i].Currency < balances[j].Currency
+	})
+	return AccountBalancesV2{
+		Height:         height,
+		Complete:       complete,
+		TrackedSources: BalanceChangeSources,
+		Balances:       balances,
+	}
+}
+
+// CalculateProductPnL calculates the pnl of a product from the deals sorted by time asc with the average cost method.
+// Position of short selling is not supported by dex, so selling more than the position closes the position only.
+// The deal fee is charged in the received token: the base token fee of a buy shrinks the position bought, and the
+// quote token fee of a sell reduces the realised pnl
+func CalculateProductPnL(product string, deals []Deal, lastPrice float64) (ProductPnLV2, error) {
+	symbols := strings.Split(product, "_")
+	if len(symbols) != 2 {
+		return ProductPnLV2{}, fmt.Errorf("invalid product %s", product)
+	}
+	position, cost := sdk.ZeroDec(), sdk.ZeroDec()
+	realised := sdk.ZeroDec()
+	buyVolume, sellVolume := sdk.ZeroDec(), sdk.ZeroDec()
+
+	for _, deal := range deals {
+		quantity, err := Float64ToDec(deal.Quantity)
+		if err != nil {
+			return ProductPnLV2{}, err
+		}
+		price, err := Float64ToDec(deal.Price)
+		if err != nil {
+			return ProductPnLV2{}, err
+		}
+		baseFee, quoteFee, err := dealFees(deal.Fee, symbols[0], symbols[1])
+		if err != nil {
+			return ProductPnLV2{}, err
+		}
+		realised = realised.Sub(quoteFee)
+
+		if deal.Side == orderTypes.BuyOrder {
+			position = position.Add(quantity).Sub(sdk.MinDec(baseFee, quantity))
+			cost = cost.Add(quantity.Mul(price))
+			buyVolume = buyVolume.Add(quantity)
+			continue
+		}
+
+		sellVolume = sellVolume.Add(quantity)
+		closed := sdk.MinDec(quantity, position)
+		if closed.IsPositive() {
+			avgCost := cost.Quo(position)
+			realised = realised.Add(closed.Mul(price.Sub(avgCost)))
+			cost = cost.Sub(closed.Mul(avgCost))
+			position = position.Sub(closed)
+		}
+	}
+
+	avgCost := sdk.ZeroDec()
+	if position.IsPositive() {
+		avgCost = cost.Quo(position)
+	}
+	last, err := Float64ToDec(lastPrice)
+	if err != nil {
+		return ProductPnLV2{}, err
+	}
+	unrealised := sdk.ZeroDec()
+	if position.IsPositive() && last.IsPositive() {
+		unrealised = position.Mul(last).Sub(cost)
+	}
+
+	return ProductPnLV2{
+		InstrumentID:  product,
+		Position:      position.String(),
+		AvgCost:       avgCost.String(),
+		LastPrice:     last.String(),
+		RealisedPnL:   realised.String(),
+		UnrealisedPnL: unrealised.String(),
+		BuyVolume:     buyVolume.String(),
+		SellVolume:    sellVolume.String(),
+	}, nil
+}
+
+// dealFees returns the amounts of the base and quote token charged by the fee of a deal
+func dealFees(fee, base, quote string) (baseFee, quoteFee sdk.Dec, err error) {
+	baseFee, quoteFee = sdk.ZeroDec(), sdk.ZeroDec()
+	if fee == "" {
+		return baseFee, quoteFee, nil
+	}
+	coins, err := sdk.ParseDecCoins(fee)
+	if err != nil {
+		return baseFee, quoteFee, fmt.Errorf("invalid deal fee %s: %s", fee, err.Error())
+	}
+	return coins.AmountOf(base), coins.AmountOf(quote), nil
+}
+
+// Float64ToDec converts the float64 stored in backend db to sdk.Dec, NaN and Inf can't be converted
+func Float64ToDec(f float64) (sdk.Dec, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return sdk.Dec{}, fmt.Errorf("failed to convert %v to Dec", f)
+	}
+	dec, err := sdk.NewDecFromStr(strconv.FormatFloat(f, 'f', sdk.Precision, 64))
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("failed to convert %v to Dec: %s", f, err.Error())
+	}
+	return dec, nil
+}
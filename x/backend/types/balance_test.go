@@ -0,0 +1,143 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/token"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateBalanceChanges(t *testing.T) {
+	txs := []*Transaction{
+		{Type: TxTypeTransfer, Address: "addr1", Symbol: "okt", Side: TxSideFrom, Quantity: "10.0"},
+		{Type: TxTypeTransfer, Address: "addr2", Symbol: "okt", Side: TxSideTo, Quantity: "10.0"},
+		{Type: TxTypeOrderNew, Address: "addr1", Symbol: "xxb_okt", Side: TxSideBuy, Quantity: "1.0"},
+	}
+	deals := []*Deal{
+		{Sender: "addr1", Product: "xxb_okt", Side: BuyOrder, Price: 2.5, Quantity: 2},
+		{Sender: "addr2", Product: "xxb_okt", Side: SellOrder, Price: 2.5, Quantity: 2},
+	}
+	swapInfos := []*SwapInfo{
+		{Address: "addr1", SellAmount: "1.0xxb", BuysAmount: "2.0okt"},
+	}
+	claimInfos := []*ClaimInfo{
+		{Address: "addr2", Claimed: "0.5xxb,0.1yyb"},
+	}
+	feeDetails := []*token.FeeDetail{
+		{Address: "addr1", Fee: "0.3okt", FeeType: FeeTypeOrderNew},
+		{Address: "addr1", Fee: "0.2okt", FeeType: FeeTypeOrderReceive},
+	}
+
+	changes := GenerateBalanceChanges(10, 100, txs, deals, swapInfos, claimInfos, feeDetails)
+	amounts := map[string]string{}
+	for _, change := range changes {
+		require.Equal(t, int64(10), change.Height)
+		require.Equal(t, int64(100), change.Timestamp)
+		amounts[change.Address+"/"+change.Symbol+"/"+change.Source] = change.Amount
+	}
+
+	expected := map[string]string{
+		"addr1/okt/send":       sdk.MustNewDecFromStr("-10").String(),
+		"addr2/okt/send":       sdk.MustNewDecFromStr("10").String(),
+		"addr1/xxb/deal":       sdk.MustNewDecFromStr("2").String(),
+		"addr1/okt/deal":       sdk.MustNewDecFromStr("-5").String(),
+		"addr2/xxb/deal":       sdk.MustNewDecFromStr("-2").String(),
+		"addr2/okt/deal":       sdk.MustNewDecFromStr("5").String(),
+		"addr1/xxb/swap":       sdk.MustNewDecFromStr("-1").String(),
+		"addr1/okt/swap":       sdk.MustNewDecFromStr("2").String(),
+		"addr2/xxb/farm_claim": sdk.MustNewDecFromStr("0.5").String(),
+		"addr2/yyb/farm_claim": sdk.MustNewDecFromStr("0.1").String(),
+		"addr1/okt/fee":        sdk.MustNewDecFromStr("-0.1").String(),
+	}
+	require.Equal(t, expected, amounts)
+}
+
+func TestGetBalancesAtHeight(t *testing.T) {
+	current := sdk.SysCoins{sdk.NewDecCoinFromDec("okt", sdk.NewDec(100))}
+	changesAfter := []BalanceChange{
+		{Height: 11, Symbol: "okt", Amount: "-10"},
+		{Height: 12, Symbol: "xxb", Amount: "-3"},
+		{Height: 12, Symbol: "yyb", Amount: "2"},
+		{Height: 13, Symbol: "okt", Amount: "30"},
+	}
+
+	balances := GetBalancesAtHeight(10, 13, current, changesAfter)
+	require.Equal(t, []AccountBalanceV2{
+		{Height: 10, Currency: "okt", Balance: sdk.NewDec(80).String()},
+		{Height: 10, Currency: "xxb", Balance: sdk.NewDec(3).String()},
+	}, balances.Balances)
+	// the untracked coin movements can't be rolled back
+	require.False(t, balances.Complete)
+	require.Equal(t, BalanceChangeSources, balances.TrackedSources)
+
+	// yyb rolled back below zero is never reported as a negative holding, even for the latest height
+	balances = GetBalancesAtHeight(13, 13, current, []BalanceChange{{Height: 13, Symbol: "yyb", Amount: "2"}})
+	require.False(t, balances.Complete)
+	require.Equal(t, []AccountBalanceV2{{Height: 13, Currency: "okt", Balance: sdk.NewDec(100).String()}},
+		balances.Balances)
+
+	// the latest balances are not rolled back
+	balances = GetBalancesAtHeight(13, 13, current, nil)
+	require.True(t, balances.Complete)
+	require.Equal(t, []AccountBalanceV2{{Height: 13, Currency: "okt", Balance: sdk.NewDec(100).String()}},
+		balances.Balances)
+}
+
+func TestCalculateProductPnL(t *testing.T) {
+	deals := []Deal{
+		{Side: BuyOrder, Price: 10, Quantity: 2},
+		{Side: BuyOrder, Price: 20, Quantity: 2},
+		{Side: SellOrder, Price: 25, Quantity: 1},
+	}
+
+	pnl, err := CalculateProductPnL("xxb_okt", deals, 30)
+	require.NoError(t, err)
+	require.Equal(t, "xxb_okt", pnl.InstrumentID)
+	require.Equal(t, sdk.NewDec(3).String(), pnl.Position)
+	require.Equal(t, sdk.NewDec(15).String(), pnl.AvgCost)
+	require.Equal(t, sdk.NewDec(10).String(), pnl.RealisedPnL)
+	require.Equal(t, sdk.NewDec(45).String(), pnl.UnrealisedPnL)
+	require.Equal(t, sdk.NewDec(4).String(), pnl.BuyVolume)
+	require.Equal(t, sdk.NewDec(1).String(), pnl.SellVolume)
+
+	// no ticker yet
+	pnl, err = CalculateProductPnL("xxb_okt", deals, 0)
+	require.NoError(t, err)
+	require.Equal(t, sdk.ZeroDec().String(), pnl.UnrealisedPnL)
+
+	// the buy fee is charged in xxb and the sell fee in okt
+	deals = []Deal{
+		{Side: BuyOrder, Price: 10, Quantity: 5, Fee: "1xxb"},
+		{Side: SellOrder, Price: 20, Quantity: 2, Fee: "0.5okt"},
+	}
+	pnl, err = CalculateProductPnL("xxb_okt", deals, 20)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2).String(), pnl.Position)
+	require.Equal(t, sdk.MustNewDecFromStr("12.5").String(), pnl.AvgCost)
+	require.Equal(t, sdk.MustNewDecFromStr("14.5").String(), pnl.RealisedPnL)
+	require.Equal(t, sdk.NewDec(15).String(), pnl.UnrealisedPnL)
+	require.Equal(t, sdk.NewDec(5).String(), pnl.BuyVolume)
+
+	// invalid fee, price and product
+	_, err = CalculateProductPnL("xxb_okt", []Deal{{Side: BuyOrder, Price: 10, Quantity: 1, Fee: "1.x"}}, 0)
+	require.Error(t, err)
+	_, err = CalculateProductPnL("xxb_okt", []Deal{{Side: BuyOrder, Price: math.NaN(), Quantity: 1}}, 0)
+	require.Error(t, err)
+	_, err = CalculateProductPnL("xxb_okt", deals, math.Inf(1))
+	require.Error(t, err)
+	_, err = CalculateProductPnL("xxb", deals, 0)
+	require.Error(t, err)
+}
+
+func TestFloat64ToDec(t *testing.T) {
+	dec, err := Float64ToDec(1.5)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("1.5"), dec)
+
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		_, err = Float64ToDec(f)
+		require.Error(t, err)
+	}
+}
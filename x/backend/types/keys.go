@@ -22,16 +22,22 @@ const (
 	QueryDexFeesList   = "dexFees"
 
 	// v2
-	QueryTickerListV2   = "tickerListV2"
-	QueryTickerV2       = "tickerV2"
-	QueryInstrumentsV2  = "instrumentsV2"
-	QueryOrderListV2    = "orderListV2"
-	QueryOrderV2        = "orderV2"
-	QueryCandleListV2   = "candlesV2"
-	QueryMatchResultsV2 = "matchesV2"
-	QueryFeeDetailsV2   = "feesV2"
-	QueryDealListV2     = "dealsV2"
-	QueryTxListV2       = "txsV2"
+	QueryTickerListV2     = "tickerListV2"
+	QueryTickerV2         = "tickerV2"
+	QueryInstrumentsV2    = "instrumentsV2"
+	QueryOrderListV2      = "orderListV2"
+	QueryOrderV2          = "orderV2"
+	QueryCandleListV2     = "candlesV2"
+	QueryMatchResultsV2   = "matchesV2"
+	QueryFeeDetailsV2     = "feesV2"
+	QueryDealListV2       = "dealsV2"
+	QueryTxListV2         = "txsV2"
+	QueryBalanceHistoryV2 = "balanceHistoryV2"
+	QueryBalancesV2       = "balancesV2"
+	QueryPnLV2            = "pnlV2"
+
+	// graphql
+	QueryGraphQL = "graphql"
 
 	// kline const
 
@@ -39,4 +45,13 @@ const (
 	KlinexGoRoutineWaitInSecond = 10
 
 	SecondsInADay = 24 * 60 * 60
+
+	// FlagKlineIntervals is the flag of kline intervals generated by backend, separated by comma
+	FlagKlineIntervals = "backend-kline-intervals"
+	// FlagArchiveDir is the flag of directory where the expired rows are archived before deleted
+	FlagArchiveDir = "backend-archive-dir"
+
+	// data sources of klines
+	KlineSourceMatchResults = "match_results"
+	KlineSourceDeals        = "deals"
 )
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -81,6 +82,27 @@ var (
 	}
 )
 
+// DefaultKlineIntervals contains all the kline intervals supported by backend
+const DefaultKlineIntervals = "1m,3m,5m,15m,30m,1h,2h,4h,6h,12h,1d,3d,1w,1M,1y"
+
+var klineInterval2Type = map[string]string{
+	"1m":  KlineTypeM1,
+	"3m":  KlineTypeM3,
+	"5m":  KlineTypeM5,
+	"15m": KlineTypeM15,
+	"30m": KlineTypeM30,
+	"1h":  KlineTypeM60,
+	"2h":  KlineTypeM120,
+	"4h":  KlineTypeM240,
+	"6h":  KlineTypeM360,
+	"12h": KlineTypeM720,
+	"1d":  KlineTypeM1440,
+	"3d":  KlineTypeM4320,
+	"1w":  KlineTypeM10080,
+	"1M":  KlineTypeM44640,
+	"1y":  KlineTypeM525600,
+}
+
 func GetChannelByKlineType(klineType string) string {
 	return kline2channel[klineType]
 }
@@ -129,11 +151,11 @@ func (klines IKlinesDsc) Less(i, j int) bool {
 type BaseKline struct {
 	Product   string  `gorm:"PRIMARY_KEY;type:varchar(20)" json:"product"`
 	Timestamp int64   `gorm:"PRIMARY_KEY;type:bigint;" json:"timestamp"`
-	Open      float64 `gorm:"type:DOUBLE" json:"open"`
-	Close     float64 `gorm:"type:DOUBLE" json:"close"`
-	High      float64 `gorm:"type:DOUBLE" json:"high"`
-	Low       float64 `gorm:"type:DOUBLE" json:"low"`
-	Volume    float64 `gorm:"type:DOUBLE" json:"volume"`
+	Open      float64 `gorm:"type:DOUBLE PRECISION" json:"open"`
+	Close     float64 `gorm:"type:DOUBLE PRECISION" json:"close"`
+	High      float64 `gorm:"type:DOUBLE PRECISION" json:"high"`
+	Low       float64 `gorm:"type:DOUBLE PRECISION" json:"low"`
+	Volume    float64 `gorm:"type:DOUBLE PRECISION" json:"volume"`
 	impl      IKline
 }
 
@@ -660,6 +682,29 @@ func GetKlineTableNameByFreq(freq int) string {
 
 }
 
+// ParseKlineIntervals parses intervals separated by comma, e.g.) "1m,3m,15m,2h,1w,1M", into a map about kline table names.
+// kline_m1 and kline_m15 are always included, because the other klines are merged from kline_m1 and tickers are
+// refreshed from kline_m1 & kline_m15
+func ParseKlineIntervals(intervals string) (map[int]string, error) {
+	if strings.TrimSpace(intervals) == "" {
+		return GetAllKlineMap(), nil
+	}
+
+	m := map[int]string{
+		klineType2Freq[KlineTypeM1]:  KlineTypeM1,
+		klineType2Freq[KlineTypeM15]: KlineTypeM15,
+	}
+	for _, interval := range strings.Split(intervals, ",") {
+		interval = strings.TrimSpace(interval)
+		klineType, ok := klineInterval2Type[interval]
+		if !ok {
+			return nil, fmt.Errorf("invalid kline interval: %s, should be one of %s", interval, DefaultKlineIntervals)
+		}
+		m[klineType2Freq[klineType]] = klineType
+	}
+	return m, nil
+}
+
 // NewKlinesFactory generate kline type by type of kline
 func NewKlinesFactory(name string) (r interface{}, err error) {
 	switch name {
@@ -167,3 +167,27 @@ func TestToIKlinesArray(t *testing.T) {
 	restData := ToRestfulData(&newIKlines, 100)
 	assert.True(t, restData != nil || len(restData) != 0)
 }
+
+func TestParseKlineIntervals(t *testing.T) {
+	m, err := ParseKlineIntervals("")
+	require.Nil(t, err)
+	require.EqualValues(t, GetAllKlineMap(), m)
+
+	m, err = ParseKlineIntervals(DefaultKlineIntervals)
+	require.Nil(t, err)
+	require.EqualValues(t, GetAllKlineMap(), m)
+
+	m, err = ParseKlineIntervals("3m, 2h,1w,1M")
+	require.Nil(t, err)
+	require.EqualValues(t, map[int]string{
+		60:      KlineTypeM1,
+		180:     KlineTypeM3,
+		900:     KlineTypeM15,
+		7200:    KlineTypeM120,
+		604800:  KlineTypeM10080,
+		2678400: KlineTypeM44640,
+	}, m)
+
+	_, err = ParseKlineIntervals("1m,7m")
+	require.NotNil(t, err)
+}
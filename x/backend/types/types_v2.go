@@ -17,8 +17,8 @@ type MatchResult struct {
 	Timestamp   int64   `gorm:"index;" json:"timestamp" v2:"timestamp"`
 	BlockHeight int64   `gorm:"PRIMARY_KEY;type:bigint" json:"block_height" v2:"block_height"`
 	Product     string  `gorm:"PRIMARY_KEY;type:varchar(20)" json:"product" v2:"product"`
-	Price       float64 `gorm:"type:DOUBLE" json:"price" v2:"price"`
-	Quantity    float64 `gorm:"type:DOUBLE" json:"volume" v2:"volume"`
+	Price       float64 `gorm:"type:DOUBLE PRECISION" json:"price" v2:"price"`
+	Quantity    float64 `gorm:"type:DOUBLE PRECISION" json:"volume" v2:"volume"`
 }
 
 type Deal struct {
@@ -28,8 +28,8 @@ type Deal struct {
 	Sender      string  `gorm:"index;type:varchar(80)" json:"sender" v2:"sender"`
 	Product     string  `gorm:"index;type:varchar(20)" json:"product" v2:"product"`
 	Side        string  `gorm:"type:varchar(10)" json:"side" v2:"side"`
-	Price       float64 `gorm:"type:DOUBLE" json:"price" v2:"price"`
-	Quantity    float64 `gorm:"type:DOUBLE" json:"volume" v2:"volume"`
+	Price       float64 `gorm:"type:DOUBLE PRECISION" json:"price" v2:"price"`
+	Quantity    float64 `gorm:"type:DOUBLE PRECISION" json:"volume" v2:"volume"`
 	Fee         string  `gorm:"type:varchar(40)" json:"fee" v2:"fee"`
 	FeeReceiver string  `gorm:"index;type:varchar(80)" json:"fee_receiver" v2:"fee_receiver"`
 }
@@ -166,6 +166,31 @@ type QueryTxListParamsV2 struct {
 	Limit   int
 }
 
+type QueryBalanceHistoryParamsV2 struct {
+	Address  string
+	Currency string
+	After    string
+	Before   string
+	Limit    int
+}
+
+type QueryBalancesParamsV2 struct {
+	Address string
+	Height  int64
+}
+
+type QueryPnLParamsV2 struct {
+	Address string
+	Product string
+}
+
+// GraphQLRequest is the request body of graphql endpoint
+type GraphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
 type DexFees struct {
 	Timestamp       int64  `json:"timestamp"`
 	OrderID         string `json:"order_id"`
@@ -251,7 +251,10 @@ func GetCmdSubmitDelistProposal(cdc *codec.Codec) *cobra.Command {
 		Short: "Submit a dex delist proposal",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Submit a dex delist proposal along with an initial deposit.
-The proposal details must be supplied via a JSON file.
+The proposal details must be supplied via a JSON file. An expedited delist proposal
+requires a higher deposit, and is voted in a shorter voting period with a higher
+threshold to halt a compromised market quickly. It falls back to the normal voting
+period if the expedited tally fails.
 
 Example:
 $ %s tx gov submit-proposal delist-proposal <path/to/proposal.json> --from=<key_or_address>
@@ -263,6 +266,7 @@ Where proposal.json contains:
  "description": "delist asset from dex",
  "base_asset": "xxx",
  "quote_asset": "%s",
+ "expedited": false,
  "deposit": [
    {
      "denom": "%s",
@@ -284,6 +288,7 @@ Where proposal.json contains:
 
 			from := cliCtx.GetFromAddress()
 			content := types.NewDelistProposal(proposal.Title, proposal.Description, from, proposal.BaseAsset, proposal.QuoteAsset)
+			content.Expedited = proposal.Expedited
 			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
@@ -15,6 +15,7 @@ type DelistProposalJSON struct {
 	BaseAsset   string       `json:"base_asset" yaml:"base_asset"`
 	QuoteAsset  string       `json:"quote_asset" yaml:"quote_asset"`
 	Deposit     sdk.SysCoins `json:"deposit" yaml:"deposit"`
+	Expedited   bool         `json:"expedited" yaml:"expedited"`
 }
 
 // ParseDelistProposalJSON parse json from proposal file to DelistProposalJSON struct
@@ -76,6 +76,7 @@ type BankKeeper interface {
 // GovKeeper defines the expected gov Keeper
 type GovKeeper interface {
 	RemoveFromActiveProposalQueue(ctx sdk.Context, proposalID uint64, endTime time.Time)
+	InsertWaitingProposalQueue(ctx sdk.Context, blockHeight, proposalID uint64)
 }
 
 type StreamKeeper interface {
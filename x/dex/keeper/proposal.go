@@ -86,8 +86,8 @@ func (k Keeper) AfterSubmitProposalHandler(ctx sdk.Context, proposal govTypes.Pr
 
 // VoteHandler handles  delist proposal when voted
 func (k Keeper) VoteHandler(ctx sdk.Context, proposal govTypes.Proposal, vote govTypes.Vote) (string, sdk.Error) {
-	if _, ok := proposal.Content.(types.DelistProposal); ok {
-		delistProposal := proposal.Content.(types.DelistProposal)
+	// the expedited delist proposal waits for the product lock to be released on execution
+	if delistProposal, ok := proposal.Content.(types.DelistProposal); ok && !delistProposal.Expedited {
 		tokenPairName := delistProposal.BaseAsset + "_" + delistProposal.QuoteAsset
 		if k.IsTokenPairLocked(ctx, tokenPairName) {
 			errContent := fmt.Sprintf("the trading pair (%s) is locked, please retry later", tokenPairName)
@@ -131,3 +131,8 @@ func (k Keeper) AfterDepositPeriodPassed(ctx sdk.Context, proposal govTypes.Prop
 func (k Keeper) RemoveFromActiveProposalQueue(ctx sdk.Context, proposalID uint64, endTime time.Time) {
 	k.govKeeper.RemoveFromActiveProposalQueue(ctx, proposalID, endTime)
 }
+
+// InsertWaitingProposalQueue inserts the proposal into the waiting proposal queue of gov to be executed at the height
+func (k Keeper) InsertWaitingProposalQueue(ctx sdk.Context, blockHeight, proposalID uint64) {
+	k.govKeeper.InsertWaitingProposalQueue(ctx, blockHeight, proposalID)
+}
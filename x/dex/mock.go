@@ -233,3 +233,7 @@ type mockGovKeeper struct{}
 // RemoveFromActiveProposalQueue mocks RemoveFromActiveProposalQueue of gov.Keeper
 func (k mockGovKeeper) RemoveFromActiveProposalQueue(ctx sdk.Context, proposalID uint64, endTime time.Time) {
 }
+
+// InsertWaitingProposalQueue mocks InsertWaitingProposalQueue of gov.Keeper
+func (k mockGovKeeper) InsertWaitingProposalQueue(ctx sdk.Context, blockHeight, proposalID uint64) {
+}
@@ -32,7 +32,19 @@ func handleDelistProposal(ctx sdk.Context, keeper *Keeper, proposal *govTypes.Pr
 		return ErrTokenPairNotFound(tokenPairName)
 	}
 	if keeper.IsTokenPairLocked(ctx, tokenPairName) {
-		return types.ErrIsTokenPairLocked(tokenPairName)
+		if !p.Expedited {
+			return types.ErrIsTokenPairLocked(tokenPairName)
+		}
+
+		// the passed expedited delist proposal postponed by the product lock is executed from the waiting proposal
+		// queue of gov, where it is retried every block until the lock is released or the postponement expires
+		deadline := proposal.VotingEndTime.Add(types.MaxDelistPostponement)
+		if ctx.BlockTime().After(deadline) {
+			return types.ErrDelistPostponementExpired(tokenPairName, deadline.String())
+		}
+		keeper.InsertWaitingProposalQueue(ctx, uint64(ctx.BlockHeight())+1, proposal.ProposalID)
+		logger.Debug(fmt.Sprintf("expedited DelistProposal postponed: the trading pair (%s) is locked", tokenPairName))
+		return nil
 	}
 	// withdraw
 	if tokenPair.Deposits.IsPositive() {
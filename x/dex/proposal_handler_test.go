@@ -66,4 +66,20 @@ func TestProposal_NewProposalHandler(t *testing.T) {
 	err = proposalHandler(ctx, &proposal)
 	require.Error(t, err)
 
+	// the expedited delist proposal is postponed until the product lock is released
+	saveErr = mApp.dexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, saveErr)
+	err = proposalHandler(ctx, &proposal)
+	require.Error(t, err)
+	content.Expedited = true
+	proposal = govTypes.Proposal{Content: content}
+	err = proposalHandler(ctx, &proposal)
+	require.Nil(t, err)
+	require.NotNil(t, mDexKeeper.Keeper.GetTokenPair(ctx, ordertypes.TestTokenPair))
+
+	mDexKeeper.UnlockTokenPair(ctx, ordertypes.TestTokenPair)
+	proposal.Status = govTypes.StatusPassed
+	err = proposalHandler(ctx, &proposal)
+	require.Nil(t, err)
+	require.Nil(t, mDexKeeper.Keeper.GetTokenPair(ctx, ordertypes.TestTokenPair))
 }
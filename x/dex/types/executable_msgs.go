@@ -0,0 +1,11 @@
+package types
+
+import (
+	govtypes "github.com/okex/okexchain/x/gov/types"
+)
+
+// the governance is allowed to manage the token pairs owned by the governance module account
+func init() {
+	govtypes.RegisterExecutableMsg(MsgTransferOwnership{}, "okexchain/dex/MsgTransferTradingPairOwnership")
+	govtypes.RegisterExecutableMsg(MsgConfirmOwnership{}, "okexchain/dex/MsgConfirmOwnership")
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/okex/okexchain/x/gov/types"
@@ -10,6 +11,10 @@ import (
 
 const (
 	proposalTypeDelist = "Delist"
+
+	// MaxDelistPostponement is how long after the end of its voting period a passed expedited delist proposal keeps
+	// waiting for the product lock to be released before it is given up
+	MaxDelistPostponement = 24 * time.Hour
 )
 
 func init() {
@@ -18,8 +23,8 @@ func init() {
 
 }
 
-// Assert DelistProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = (*DelistProposal)(nil)
+// Assert DelistProposal implements govtypes.ExpeditableContent at compile-time
+var _ govtypes.ExpeditableContent = (*DelistProposal)(nil)
 
 // DelistProposal represents delist proposal object
 type DelistProposal struct {
@@ -28,6 +33,7 @@ type DelistProposal struct {
 	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
 	BaseAsset   string         `json:"base_asset" yaml:"base_asset"`
 	QuoteAsset  string         `json:"quote_asset" yaml:"quote_asset"`
+	Expedited   bool           `json:"expedited" yaml:"expedited"`
 }
 
 // NewDelistProposal create a new delist proposal object
@@ -51,6 +57,11 @@ func (drp DelistProposal) GetDescription() string {
 	return drp.Description
 }
 
+// IsExpedited returns true if the delist proposal is submitted as an expedited proposal to halt the market quickly
+func (drp DelistProposal) IsExpedited() bool {
+	return drp.Expedited
+}
+
 // ProposalRoute returns route key of delist proposal object
 func (DelistProposal) ProposalRoute() string {
 	return RouterKey
@@ -102,8 +113,9 @@ func (drp DelistProposal) String() string {
  Proposer:            %s
  ListAsset            %s
  QuoteAsset           %s
+ Expedited            %t
 `, drp.Title, drp.Description,
 		drp.ProposalType(), drp.Proposer,
-		drp.BaseAsset, drp.QuoteAsset,
+		drp.BaseAsset, drp.QuoteAsset, drp.Expedited,
 	)
 }
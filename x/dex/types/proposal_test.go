@@ -27,15 +27,15 @@ func TestDelistProposal_ValidateBasic(t *testing.T) {
 	}{
 		{"delist-proposal", proposal, true},
 
-		{"no-title", DelistProposal{"", "delist proposal", addr, "eth", "btc"}, false},
-		{"no-description", DelistProposal{"proposal", "", addr, "eth", "btc"}, false},
-		{"no-proposer", DelistProposal{"proposal", "delist proposal", nil, "eth", "btc"}, false},
-		{"no-product", DelistProposal{"proposal", "delist proposal", addr, "btc", "btc"}, false},
+		{"no-title", DelistProposal{"", "delist proposal", addr, "eth", "btc", false}, false},
+		{"no-description", DelistProposal{"proposal", "", addr, "eth", "btc", false}, false},
+		{"no-proposer", DelistProposal{"proposal", "delist proposal", nil, "eth", "btc", false}, false},
+		{"no-product", DelistProposal{"proposal", "delist proposal", addr, "btc", "btc", false}, false},
 
 		{"long-title", DelistProposal{getLongString(15),
-			"right delist proposal", addr, "eth", "btc"}, false},
+			"right delist proposal", addr, "eth", "btc", false}, false},
 		{"long-description", DelistProposal{"proposal",
-			getLongString(501), addr, "eth", "btc"}, false},
+			getLongString(501), addr, "eth", "btc", false}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
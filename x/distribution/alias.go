@@ -20,6 +20,8 @@ const (
 	QueryParams                 = types.QueryParams
 	QueryValidatorCommission    = types.QueryValidatorCommission
 	QueryWithdrawAddr           = types.QueryWithdrawAddr
+	QueryGrant                  = types.QueryGrant
+	QueryGrants                 = types.QueryGrants
 	ParamWithdrawAddrEnabled    = types.ParamWithdrawAddrEnabled
 	DefaultParamspace           = types.DefaultParamspace
 )
@@ -43,6 +45,10 @@ var (
 	ValidateGenesis                          = types.ValidateGenesis
 	NewMsgSetWithdrawAddress                 = types.NewMsgSetWithdrawAddress
 	NewMsgWithdrawValidatorCommission        = types.NewMsgWithdrawValidatorCommission
+	NewMsgConfirmGrantMilestone              = types.NewMsgConfirmGrantMilestone
+	NewCommunityPoolGrantProposal            = types.NewCommunityPoolGrantProposal
+	NewCommunityPoolGrantReleaseProposal     = types.NewCommunityPoolGrantReleaseProposal
+	NewCommunityPoolGrantCancelProposal      = types.NewCommunityPoolGrantCancelProposal
 	NewQueryValidatorCommissionParams        = types.NewQueryValidatorCommissionParams
 	NewQueryDelegatorWithdrawAddrParams      = types.NewQueryDelegatorWithdrawAddrParams
 	InitialValidatorAccumulatedCommission    = types.InitialValidatorAccumulatedCommission
@@ -61,6 +67,9 @@ var (
 	AttributeKeyValidator                = types.AttributeKeyValidator
 	AttributeValueCategory               = types.AttributeValueCategory
 	ProposalHandler                      = client.ProposalHandler
+	GrantProposalHandler                 = client.GrantProposalHandler
+	GrantReleaseProposalHandler          = client.GrantReleaseProposalHandler
+	GrantCancelProposalHandler           = client.GrantCancelProposalHandler
 )
 
 type (
@@ -71,6 +80,9 @@ type (
 	GenesisState                         = types.GenesisState
 	MsgSetWithdrawAddress                = types.MsgSetWithdrawAddress
 	MsgWithdrawValidatorCommission       = types.MsgWithdrawValidatorCommission
+	MsgConfirmGrantMilestone             = types.MsgConfirmGrantMilestone
+	Grant                                = types.Grant
+	GrantMilestone                       = types.GrantMilestone
 	QueryValidatorCommissionParams       = types.QueryValidatorCommissionParams
 	QueryDelegatorWithdrawAddrParams     = types.QueryDelegatorWithdrawAddrParams
 	ValidatorAccumulatedCommission       = types.ValidatorAccumulatedCommission
@@ -2,9 +2,11 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -17,6 +19,11 @@ import (
 	"github.com/okex/okexchain/x/distribution/types"
 )
 
+const (
+	flagStatus    = "status"
+	flagRecipient = "recipient"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	distQueryCmd := &cobra.Command{
@@ -32,6 +39,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryParams(queryRoute, cdc),
 		GetCmdQueryValidatorCommission(queryRoute, cdc),
 		GetCmdQueryCommunityPool(queryRoute, cdc),
+		GetCmdQueryGrant(queryRoute, cdc),
+		GetCmdQueryGrants(queryRoute, cdc),
 	)...)
 
 	return distQueryCmd
@@ -120,3 +129,92 @@ $ %s query distr community-pool
 		},
 	}
 }
+
+// GetCmdQueryGrant implements the query grant command.
+func GetCmdQueryGrant(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grant [grant-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the status of a community pool grant",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the milestones, the released and remaining coins and the status of a community pool
+grant. The id of a grant is the id of the community pool grant proposal creating it.
+
+Example:
+$ %s query distr grant 1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("grant-id %s is not a valid uint", args[0])
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGrant),
+				cdc.MustMarshalJSON(types.NewQueryGrantParams(grantID)))
+			if err != nil {
+				return err
+			}
+
+			var grant types.Grant
+			cdc.MustUnmarshalJSON(res, &grant)
+			return cliCtx.PrintOutput(grant)
+		},
+	}
+}
+
+// GetCmdQueryGrants implements the query grants command.
+func GetCmdQueryGrants(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grants",
+		Args:  cobra.NoArgs,
+		Short: "Query community pool grants with optional filters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query community pool grants that match optional filters.
+
+Example:
+$ %s query distr grants --status=Active
+$ %s query distr grants --recipient=okexchain1hw4r48aww06ldrfeuq2v438ujnl6alsz0685a0
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var params types.QueryGrantsParams
+			if statusStr := viper.GetString(flagStatus); len(statusStr) != 0 {
+				status, err := types.GrantStatusFromString(statusStr)
+				if err != nil {
+					return err
+				}
+				params.Status = status
+			}
+			if recipientStr := viper.GetString(flagRecipient); len(recipientStr) != 0 {
+				recipient, err := sdk.AccAddressFromBech32(recipientStr)
+				if err != nil {
+					return err
+				}
+				params.Recipient = recipient
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGrants),
+				cdc.MustMarshalJSON(params))
+			if err != nil {
+				return err
+			}
+
+			var grants types.Grants
+			cdc.MustUnmarshalJSON(res, &grants)
+			return cliCtx.PrintOutput(grants)
+		},
+	}
+
+	cmd.Flags().String(flagStatus, "", "(optional) filter grants by status: Active|Completed|Cancelled")
+	cmd.Flags().String(flagRecipient, "", "(optional) filter grants by recipient address")
+	return cmd
+}
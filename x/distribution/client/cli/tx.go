@@ -4,6 +4,7 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -34,6 +35,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	distTxCmd.AddCommand(flags.PostCommands(
 		GetCmdWithdrawRewards(cdc),
 		GetCmdSetWithdrawAddr(cdc),
+		GetCmdConfirmGrantMilestone(cdc),
 	)...)
 
 	return distTxCmd
@@ -164,3 +166,221 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+// GetCmdConfirmGrantMilestone implements the command for the reviewer of a grant to release its pending milestone
+func GetCmdConfirmGrantMilestone(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "confirm-grant-milestone [grant-id]",
+		Short: "confirm the pending milestone of a community pool grant as its reviewer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Release the pending milestone of a community pool grant to its recipient. Only the reviewer
+of the grant is allowed to confirm, and timed milestones are released automatically at their release time.
+
+Example:
+$ %s tx distr confirm-grant-milestone 1 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("grant-id %s is not a valid uint", args[0])
+			}
+
+			msg := types.NewMsgConfirmGrantMilestone(grantID, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSubmitGrantProposal implements the command to submit a community-pool-grant proposal
+func GetCmdSubmitGrantProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-grant [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a community pool grant proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a community pool grant proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Once the proposal passes, the coins of all the milestones are escrowed out of the community pool and the id
+of the proposal becomes the id of the grant. The milestones are released in order: a milestone with a release
+time is streamed automatically once the release time is reached, the others are released by the reviewer via
+'confirm-grant-milestone' or by a 'community-pool-grant-release' proposal. The reviewer is optional.
+
+Example:
+$ %s tx gov submit-proposal community-pool-grant <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community Pool Grant",
+  "description": "Fund the wallet integration",
+  "recipient": "okexchain1hw4r48aww06ldrfeuq2v438ujnl6alsz0685a0",
+  "reviewer": "okexchain1alq9na49n9yycysh889rl90g9nhe58lcqkfpfg",
+  "milestones": [
+    {
+      "amount": [
+        {
+          "denom": "%s",
+          "amount": "10000"
+        }
+      ]
+    },
+    {
+      "amount": [
+        {
+          "denom": "%s",
+          "amount": "10000"
+        }
+      ],
+      "release_time": "2021-06-01T00:00:00Z"
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "%s",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName, sdk.DefaultBondDenom, sdk.DefaultBondDenom, sdk.DefaultBondDenom,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseCommunityPoolGrantProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCommunityPoolGrantProposal(proposal.Title, proposal.Description, proposal.Recipient,
+				proposal.Reviewer, proposal.Milestones)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitGrantReleaseProposal implements the command to submit a community-pool-grant-release proposal
+func GetCmdSubmitGrantReleaseProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-grant-release [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal releasing the pending milestone of a community pool grant",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal releasing the pending milestone of a community pool grant along with
+an initial deposit. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal community-pool-grant-release <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community Pool Grant Release",
+  "description": "The wallet integration has been delivered",
+  "grant_id": "1",
+  "deposit": [
+    {
+      "denom": "%s",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName, sdk.DefaultBondDenom,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseCommunityPoolGrantActionProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCommunityPoolGrantReleaseProposal(proposal.Title, proposal.Description, proposal.GrantID)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitGrantCancelProposal implements the command to submit a community-pool-grant-cancel proposal
+func GetCmdSubmitGrantCancelProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-grant-cancel [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal cancelling a community pool grant",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal cancelling a community pool grant along with an initial deposit.
+The coins of the unreleased milestones return to the community pool. The proposal details must be supplied
+via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal community-pool-grant-cancel <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Community Pool Grant Cancel",
+  "description": "The wallet integration has been abandoned",
+  "grant_id": "1",
+  "deposit": [
+    {
+      "denom": "%s",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName, sdk.DefaultBondDenom,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseCommunityPoolGrantActionProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCommunityPoolGrantCancelProposal(proposal.Title, proposal.Description, proposal.GrantID)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
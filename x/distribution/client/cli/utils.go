@@ -5,6 +5,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/distribution/types"
 )
 
 type (
@@ -16,6 +18,25 @@ type (
 		Amount      sdk.SysCoins   `json:"amount" yaml:"amount"`
 		Deposit     sdk.SysCoins   `json:"deposit" yaml:"deposit"`
 	}
+
+	// CommunityPoolGrantProposalJSON defines a CommunityPoolGrantProposal with a deposit
+	CommunityPoolGrantProposalJSON struct {
+		Title       string                `json:"title" yaml:"title"`
+		Description string                `json:"description" yaml:"description"`
+		Recipient   sdk.AccAddress        `json:"recipient" yaml:"recipient"`
+		Reviewer    sdk.AccAddress        `json:"reviewer" yaml:"reviewer"`
+		Milestones  types.GrantMilestones `json:"milestones" yaml:"milestones"`
+		Deposit     sdk.SysCoins          `json:"deposit" yaml:"deposit"`
+	}
+
+	// CommunityPoolGrantActionProposalJSON defines a CommunityPoolGrantReleaseProposal or a
+	// CommunityPoolGrantCancelProposal with a deposit
+	CommunityPoolGrantActionProposalJSON struct {
+		Title       string       `json:"title" yaml:"title"`
+		Description string       `json:"description" yaml:"description"`
+		GrantID     uint64       `json:"grant_id" yaml:"grant_id"`
+		Deposit     sdk.SysCoins `json:"deposit" yaml:"deposit"`
+	}
 )
 
 // ParseCommunityPoolSpendProposalJSON reads and parses a CommunityPoolSpendProposalJSON from a file.
@@ -33,3 +54,36 @@ func ParseCommunityPoolSpendProposalJSON(cdc *codec.Codec, proposalFile string)
 
 	return proposal, nil
 }
+
+// ParseCommunityPoolGrantProposalJSON reads and parses a CommunityPoolGrantProposalJSON from a file.
+func ParseCommunityPoolGrantProposalJSON(cdc *codec.Codec, proposalFile string) (CommunityPoolGrantProposalJSON, error) {
+	proposal := CommunityPoolGrantProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseCommunityPoolGrantActionProposalJSON reads and parses a CommunityPoolGrantActionProposalJSON from a file.
+func ParseCommunityPoolGrantActionProposalJSON(cdc *codec.Codec, proposalFile string) (
+	CommunityPoolGrantActionProposalJSON, error) {
+	proposal := CommunityPoolGrantActionProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
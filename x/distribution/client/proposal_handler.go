@@ -9,4 +9,12 @@ import (
 // param change proposal handler
 var (
 	ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+
+	// community pool grant proposal handlers
+	GrantProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitGrantProposal,
+		rest.GrantProposalRESTHandler)
+	GrantReleaseProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitGrantReleaseProposal,
+		rest.GrantReleaseProposalRESTHandler)
+	GrantCancelProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitGrantCancelProposal,
+		rest.GrantCancelProposalRESTHandler)
 )
@@ -3,6 +3,7 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
@@ -39,6 +40,18 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		"/distribution/community_pool",
 		communityPoolHandler(cliCtx, queryRoute),
 	).Methods("GET")
+
+	// Get community pool grants filtered by status and recipient
+	r.HandleFunc(
+		"/distribution/grants",
+		grantsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	// Get a single community pool grant
+	r.HandleFunc(
+		"/distribution/grants/{grantID}",
+		grantHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
 }
 
 // HTTP request handler to query a delegation rewards
@@ -136,3 +149,78 @@ func accumulatedCommissionHandlerFn(cliCtx context.CLIContext, queryRoute string
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// HTTP request handler to query a community pool grant
+func grantHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		grantID, ok := checkGrantIDVar(w, r)
+		if !ok {
+			return
+		}
+
+		cliCtx, ok = rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz := cliCtx.Codec.MustMarshalJSON(types.NewQueryGrantParams(grantID))
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGrant), bz)
+		if err != nil {
+			sdkErr := comm.ParseSDKError(err.Error())
+			comm.HandleErrorMsg(w, cliCtx, sdkErr.Code, sdkErr.Message)
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query community pool grants filtered by status and recipient
+func grantsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		var params types.QueryGrantsParams
+		if statusStr := r.URL.Query().Get("status"); len(statusStr) != 0 {
+			status, err := types.GrantStatusFromString(statusStr)
+			if err != nil {
+				comm.HandleErrorMsg(w, cliCtx, comm.CodeInvalidParam, err.Error())
+				return
+			}
+			params.Status = status
+		}
+		if recipientStr := r.URL.Query().Get("recipient"); len(recipientStr) != 0 {
+			recipient, err := sdk.AccAddressFromBech32(recipientStr)
+			if err != nil {
+				comm.HandleErrorMsg(w, cliCtx, types.CodeAccAddressFromBech32Failed, err.Error())
+				return
+			}
+			params.Recipient = recipient
+		}
+
+		bz := cliCtx.Codec.MustMarshalJSON(params)
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGrants), bz)
+		if err != nil {
+			sdkErr := comm.ParseSDKError(err.Error())
+			comm.HandleErrorMsg(w, cliCtx, sdkErr.Code, sdkErr.Message)
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func checkGrantIDVar(w http.ResponseWriter, r *http.Request) (uint64, bool) {
+	grantID, err := strconv.ParseUint(mux.Vars(r)["grantID"], 10, 64)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid grant id：%s", mux.Vars(r)["grantID"]))
+		return 0, false
+	}
+
+	return grantID, true
+}
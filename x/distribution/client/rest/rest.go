@@ -53,3 +53,82 @@ func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+// GrantProposalRESTHandler returns a ProposalRESTHandler that exposes the community pool grant REST handler with a
+// given sub-route.
+func GrantProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "community_pool_grant",
+		Handler:  postGrantProposalHandlerFn(cliCtx),
+	}
+}
+
+// GrantReleaseProposalRESTHandler returns a ProposalRESTHandler that exposes the community pool grant release REST
+// handler with a given sub-route.
+func GrantReleaseProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "community_pool_grant_release",
+		Handler: postGrantActionProposalHandlerFn(cliCtx, func(req CommunityPoolGrantActionProposalReq) gov.Content {
+			return types.NewCommunityPoolGrantReleaseProposal(req.Title, req.Description, req.GrantID)
+		}),
+	}
+}
+
+// GrantCancelProposalRESTHandler returns a ProposalRESTHandler that exposes the community pool grant cancel REST
+// handler with a given sub-route.
+func GrantCancelProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "community_pool_grant_cancel",
+		Handler: postGrantActionProposalHandlerFn(cliCtx, func(req CommunityPoolGrantActionProposalReq) gov.Content {
+			return types.NewCommunityPoolGrantCancelProposal(req.Title, req.Description, req.GrantID)
+		}),
+	}
+}
+
+func postGrantProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CommunityPoolGrantProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewCommunityPoolGrantProposal(req.Title, req.Description, req.Recipient, req.Reviewer,
+			req.Milestones)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			comm.HandleErrorMsg(w, cliCtx, comm.CodeInvalidParam, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postGrantActionProposalHandlerFn(cliCtx context.CLIContext,
+	newContent func(req CommunityPoolGrantActionProposalReq) gov.Content) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CommunityPoolGrantActionProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := gov.NewMsgSubmitProposal(newContent(req), req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			comm.HandleErrorMsg(w, cliCtx, comm.CodeInvalidParam, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
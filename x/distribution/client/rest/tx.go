@@ -30,6 +30,11 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, _ string) {
 		withdrawValidatorRewardsHandlerFn(cliCtx),
 	).Methods("POST")
 
+	// Confirm the pending milestone of a community pool grant
+	r.HandleFunc(
+		"/distribution/grants/{grantID}/confirm",
+		confirmGrantMilestoneHandlerFn(cliCtx),
+	).Methods("POST")
 }
 
 type (
@@ -37,6 +42,11 @@ type (
 		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 	}
 
+	confirmGrantMilestoneReq struct {
+		BaseReq  rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		Reviewer sdk.AccAddress `json:"reviewer" yaml:"reviewer"`
+	}
+
 	setWithdrawalAddrReq struct {
 		BaseReq         rest.BaseReq   `json:"base_req" yaml:"base_req"`
 		WithdrawAddress sdk.AccAddress `json:"withdraw_address" yaml:"withdraw_address"`
@@ -104,6 +114,36 @@ func withdrawValidatorRewardsHandlerFn(cliCtx context.CLIContext) http.HandlerFu
 	}
 }
 
+// Confirm the pending milestone of a community pool grant
+func confirmGrantMilestoneHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req confirmGrantMilestoneReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// read and validate URL's variable
+		grantID, ok := checkGrantIDVar(w, r)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgConfirmGrantMilestone(grantID, req.Reviewer)
+		if err := msg.ValidateBasic(); err != nil {
+			comm.HandleErrorMsg(w, cliCtx, comm.CodeInvalidParam, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
 // Auxiliary
 
 func checkDelegatorAddressVar(w http.ResponseWriter, r *http.Request) (sdk.AccAddress, bool) {
@@ -3,6 +3,8 @@ package rest
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/okex/okexchain/x/distribution/types"
 )
 
 type (
@@ -17,4 +19,28 @@ type (
 		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
 		Deposit     sdk.SysCoins   `json:"deposit" yaml:"deposit"`
 	}
+
+	// CommunityPoolGrantProposalReq defines a community pool grant proposal request body.
+	CommunityPoolGrantProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string                `json:"title" yaml:"title"`
+		Description string                `json:"description" yaml:"description"`
+		Recipient   sdk.AccAddress        `json:"recipient" yaml:"recipient"`
+		Reviewer    sdk.AccAddress        `json:"reviewer" yaml:"reviewer"`
+		Milestones  types.GrantMilestones `json:"milestones" yaml:"milestones"`
+		Proposer    sdk.AccAddress        `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.SysCoins          `json:"deposit" yaml:"deposit"`
+	}
+
+	// CommunityPoolGrantActionProposalReq defines a community pool grant release or cancel proposal request body.
+	CommunityPoolGrantActionProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		GrantID     uint64         `json:"grant_id" yaml:"grant_id"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.SysCoins   `json:"deposit" yaml:"deposit"`
+	}
 )
@@ -26,6 +26,16 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	}
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 
+	for _, grant := range data.Grants {
+		keeper.SetGrant(ctx, grant)
+		if milestone, ok := grant.PendingMilestone(); ok && milestone.IsTimed() {
+			keeper.InsertGrantReleaseQueue(ctx, grant.GrantID, milestone.ReleaseTime)
+		}
+		if grant.Status == types.GrantStatusActive {
+			moduleHoldings = moduleHoldings.Add(grant.Remaining...)
+		}
+	}
+
 	// check if the module account exists
 	moduleAcc := keeper.GetDistributionAccount(ctx)
 	if moduleAcc == nil {
@@ -64,5 +74,11 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, acc)
+	grants := types.Grants{}
+	keeper.IterateGrants(ctx, func(grant types.Grant) (stop bool) {
+		grants = append(grants, grant)
+		return false
+	})
+
+	return types.NewGenesisState(params, feePool, dwi, pp, acc, grants)
 }
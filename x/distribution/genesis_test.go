@@ -37,7 +37,8 @@ func TestInitGenesis(t *testing.T) {
 		dwis[i].DelegatorAddress, dwis[i].WithdrawAddress = keeper.TestAddrs[i*2], keeper.TestAddrs[i*2+1]
 	}
 
-	genesisState := NewGenesisState(types.DefaultParams(), types.InitialFeePool(), dwis, valConsAddrs[0], accs)
+	genesisState := NewGenesisState(types.DefaultParams(), types.InitialFeePool(), dwis, valConsAddrs[0], accs,
+		types.Grants{})
 	InitGenesis(ctx, k, supplyKeeper, genesisState)
 	require.True(t, k.GetFeePoolCommunityCoins(ctx).IsZero())
 	require.Equal(t, genesisState.Params.CommunityTax, k.GetCommunityTax(ctx))
@@ -58,4 +59,5 @@ func TestInitGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.DelegatorWithdrawInfos, actualGenesis.DelegatorWithdrawInfos)
 	require.Equal(t, genesisState.PreviousProposer, actualGenesis.PreviousProposer)
 	require.ElementsMatch(t, genesisState.ValidatorAccumulatedCommissions, actualGenesis.ValidatorAccumulatedCommissions)
+	require.ElementsMatch(t, genesisState.Grants, actualGenesis.Grants)
 }
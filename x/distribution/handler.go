@@ -20,6 +20,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case types.MsgWithdrawValidatorCommission:
 			return handleMsgWithdrawValidatorCommission(ctx, msg, k)
 
+		case types.MsgConfirmGrantMilestone:
+			return handleMsgConfirmGrantMilestone(ctx, msg, k)
+
 		default:
 			return nil, types.ErrUnknownDistributionMsgType()
 		}
@@ -60,12 +63,36 @@ func handleMsgWithdrawValidatorCommission(ctx sdk.Context, msg types.MsgWithdraw
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgConfirmGrantMilestone(ctx sdk.Context, msg types.MsgConfirmGrantMilestone, k keeper.Keeper) (*sdk.Result, error) {
+	if err := k.ConfirmGrantMilestone(ctx, msg.GrantID, msg.Reviewer); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Reviewer.String()),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func NewCommunityPoolSpendProposalHandler(k Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content *govtypes.Proposal) error {
 		switch c := content.Content.(type) {
 		case types.CommunityPoolSpendProposal:
 			return keeper.HandleCommunityPoolSpendProposal(ctx, k, c)
 
+		case types.CommunityPoolGrantProposal:
+			return keeper.HandleCommunityPoolGrantProposal(ctx, k, content.ProposalID, c)
+
+		case types.CommunityPoolGrantReleaseProposal:
+			return keeper.HandleCommunityPoolGrantReleaseProposal(ctx, k, c)
+
+		case types.CommunityPoolGrantCancelProposal:
+			return keeper.HandleCommunityPoolGrantCancelProposal(ctx, k, c)
+
 		default:
 			return types.ErrUnknownDistributionCommunityPoolProposaType()
 		}
@@ -142,11 +142,10 @@ func (k Keeper) allocateByShares(ctx sdk.Context, rewards sdk.SysCoins) sdk.SysC
 	return remaining
 }
 
-// AllocateTokensToValidator allocate tokens to a particular validator, splitting according to commissions
+// AllocateTokensToValidator allocate all the tokens to the accumulated commission of a particular validator
 func (k Keeper) AllocateTokensToValidator(ctx sdk.Context, val exported.ValidatorI, tokens sdk.SysCoins) {
-	// split tokens between validator and delegators according to commissions
-	// commissions is always 1.0, so tokens.MulDec(val.GetCommission()) = tokens
-	// only update current commissions
+	// the commission rate of the validator is not used for the allocation until delegator rewards exist,
+	// there is no one else to share the tokens with
 	commission := k.GetValidatorAccumulatedCommission(ctx, val.GetOperator())
 	commission = commission.Add(tokens...)
 	k.SetValidatorAccumulatedCommission(ctx, val.GetOperator(), commission)
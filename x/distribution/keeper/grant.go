@@ -0,0 +1,227 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/okex/okexchain/x/distribution/types"
+)
+
+// GetGrant gets a community pool grant by its id
+func (k Keeper) GetGrant(ctx sdk.Context, grantID uint64) (grant types.Grant, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetGrantKey(grantID))
+	if bz == nil {
+		return grant, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &grant)
+	return grant, true
+}
+
+// SetGrant sets a community pool grant
+func (k Keeper) SetGrant(ctx sdk.Context, grant types.Grant) {
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(grant)
+	ctx.KVStore(k.storeKey).Set(types.GetGrantKey(grant.GrantID), bz)
+}
+
+// IterateGrants iterates over all the community pool grants in the order of their ids
+func (k Keeper) IterateGrants(ctx sdk.Context, handler func(grant types.Grant) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GrantKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var grant types.Grant
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &grant)
+		if handler(grant) {
+			break
+		}
+	}
+}
+
+// GetGrantsEscrow returns the coins of the unreleased milestones of all the active grants
+func (k Keeper) GetGrantsEscrow(ctx sdk.Context) (escrow sdk.SysCoins) {
+	k.IterateGrants(ctx, func(grant types.Grant) (stop bool) {
+		if grant.Status == types.GrantStatusActive {
+			escrow = escrow.Add(grant.Remaining...)
+		}
+		return false
+	})
+	return
+}
+
+// InsertGrantReleaseQueue schedules a timed grant milestone to be released at releaseTime
+func (k Keeper) InsertGrantReleaseQueue(ctx sdk.Context, grantID uint64, releaseTime time.Time) {
+	ctx.KVStore(k.storeKey).Set(types.GetGrantReleaseQueueKey(grantID, releaseTime), types.GetGrantIDBytes(grantID))
+}
+
+// RemoveFromGrantReleaseQueue removes a timed grant milestone from the release queue
+func (k Keeper) RemoveFromGrantReleaseQueue(ctx sdk.Context, grantID uint64, releaseTime time.Time) {
+	ctx.KVStore(k.storeKey).Delete(types.GetGrantReleaseQueueKey(grantID, releaseTime))
+}
+
+// IterateGrantReleaseQueue iterates over the grants whose timed milestones are due by endTime
+func (k Keeper) IterateGrantReleaseQueue(ctx sdk.Context, endTime time.Time, handler func(grantID uint64) (stop bool)) {
+	iter := ctx.KVStore(k.storeKey).Iterator(types.GrantReleaseQueuePrefix,
+		sdk.PrefixEndBytes(types.GetGrantReleaseQueueTimeKey(endTime)))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(types.GetGrantIDFromBytes(iter.Value())) {
+			break
+		}
+	}
+}
+
+// CreateGrant creates a community pool grant from a passed grant proposal, escrowing the coins of all its
+// milestones out of the community pool
+func (k Keeper) CreateGrant(ctx sdk.Context, grantID uint64, p types.CommunityPoolGrantProposal) error {
+	if k.blacklistedAddrs[p.Recipient.String()] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is blacklisted from receiving external funds", p.Recipient)
+	}
+
+	grant := types.NewGrant(grantID, p.Title, p.Recipient, p.Reviewer, p.Milestones)
+	feePool := k.GetFeePool(ctx)
+	newPool, negative := feePool.CommunityPool.SafeSub(grant.Remaining)
+	if negative {
+		return types.ErrBadDistribution()
+	}
+	feePool.CommunityPool = newPool
+	k.SetFeePool(ctx, feePool)
+
+	k.SetGrant(ctx, grant)
+	k.scheduleGrantMilestone(ctx, grant)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateGrant,
+			sdk.NewAttribute(types.AttributeKeyGrantID, strconv.FormatUint(grantID, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, p.Recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, grant.Remaining.String()),
+		),
+	)
+	return nil
+}
+
+// ConfirmGrantMilestone releases the pending milestone of a grant on the confirmation of its reviewer. Timed
+// milestones are streamed automatically and can't be confirmed
+func (k Keeper) ConfirmGrantMilestone(ctx sdk.Context, grantID uint64, reviewer sdk.AccAddress) error {
+	grant, found := k.GetGrant(ctx, grantID)
+	if !found {
+		return types.ErrUnknownGrant(grantID)
+	}
+	if grant.Reviewer.Empty() || !grant.Reviewer.Equals(reviewer) {
+		return types.ErrNotGrantReviewer(reviewer, grantID)
+	}
+	milestone, ok := grant.PendingMilestone()
+	if !ok {
+		return types.ErrGrantNotActive(grantID, grant.Status)
+	}
+	if milestone.IsTimed() {
+		return types.ErrGrantMilestoneTimed(grantID, grant.NextMilestone, milestone.ReleaseTime)
+	}
+
+	return k.ReleaseGrantMilestone(ctx, grantID)
+}
+
+// ReleaseGrantMilestone sends the coins of the pending milestone of an active grant to its recipient
+func (k Keeper) ReleaseGrantMilestone(ctx sdk.Context, grantID uint64) error {
+	grant, found := k.GetGrant(ctx, grantID)
+	if !found {
+		return types.ErrUnknownGrant(grantID)
+	}
+	milestone, ok := grant.PendingMilestone()
+	if !ok {
+		return types.ErrGrantNotActive(grantID, grant.Status)
+	}
+
+	// the coins of the milestone have been escrowed in the distribution module account when the grant was created
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, grant.Recipient,
+		milestone.Amount); err != nil {
+		return err
+	}
+
+	if milestone.IsTimed() {
+		k.RemoveFromGrantReleaseQueue(ctx, grantID, milestone.ReleaseTime)
+	}
+	released := grant.NextMilestone
+	grant.NextMilestone++
+	grant.Released = grant.Released.Add(milestone.Amount...)
+	grant.Remaining = grant.Remaining.Sub(milestone.Amount)
+	if grant.NextMilestone == uint64(len(grant.Milestones)) {
+		grant.Status = types.GrantStatusCompleted
+	}
+	k.SetGrant(ctx, grant)
+	k.scheduleGrantMilestone(ctx, grant)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReleaseGrant,
+			sdk.NewAttribute(types.AttributeKeyGrantID, strconv.FormatUint(grantID, 10)),
+			sdk.NewAttribute(types.AttributeKeyMilestone, strconv.FormatUint(released, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, grant.Recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, milestone.Amount.String()),
+		),
+	)
+	return nil
+}
+
+// CancelGrant cancels an active grant and returns the coins of its unreleased milestones to the community pool
+func (k Keeper) CancelGrant(ctx sdk.Context, grantID uint64) error {
+	grant, found := k.GetGrant(ctx, grantID)
+	if !found {
+		return types.ErrUnknownGrant(grantID)
+	}
+	milestone, ok := grant.PendingMilestone()
+	if !ok {
+		return types.ErrGrantNotActive(grantID, grant.Status)
+	}
+	if milestone.IsTimed() {
+		k.RemoveFromGrantReleaseQueue(ctx, grantID, milestone.ReleaseTime)
+	}
+
+	refund := grant.Remaining
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(refund...)
+	k.SetFeePool(ctx, feePool)
+
+	grant.Remaining = sdk.SysCoins{}
+	grant.Status = types.GrantStatusCancelled
+	k.SetGrant(ctx, grant)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelGrant,
+			sdk.NewAttribute(types.AttributeKeyGrantID, strconv.FormatUint(grantID, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, refund.String()),
+		),
+	)
+	return nil
+}
+
+// ReleaseDueGrantMilestones streams the timed grant milestones whose release time has been reached
+func (k Keeper) ReleaseDueGrantMilestones(ctx sdk.Context) {
+	var grantIDs []uint64
+	k.IterateGrantReleaseQueue(ctx, ctx.BlockHeader().Time, func(grantID uint64) (stop bool) {
+		grantIDs = append(grantIDs, grantID)
+		return false
+	})
+
+	logger := k.Logger(ctx)
+	for _, grantID := range grantIDs {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.ReleaseGrantMilestone(cacheCtx, grantID); err != nil {
+			logger.Error(fmt.Sprintf("failed to release the milestone of grant %d: %s", grantID, err))
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+// scheduleGrantMilestone puts the pending milestone of a grant into the release queue if it is a timed one
+func (k Keeper) scheduleGrantMilestone(ctx sdk.Context, grant types.Grant) {
+	if milestone, ok := grant.PendingMilestone(); ok && milestone.IsTimed() {
+		k.InsertGrantReleaseQueue(ctx, grant.GrantID, milestone.ReleaseTime)
+	}
+}
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/okex/okexchain/x/distribution/types"
+)
+
+func TestCommunityPoolGrant(t *testing.T) {
+	ctx, ak, k, _, supplyKeeper := CreateTestInputDefault(t, false, 1000)
+	ctx = ctx.WithBlockTime(time.Now())
+	recipient, reviewer := delAddr1, delAddr2
+
+	// fund the community pool
+	pool := NewTestSysCoins(100, 0)
+	require.Nil(t, supplyKeeper.SendCoinsFromAccountToModule(ctx, delAddr3, types.ModuleName, pool))
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = pool
+	k.SetFeePool(ctx, feePool)
+
+	releaseTime := ctx.BlockTime().Add(time.Hour)
+	milestones := types.GrantMilestones{
+		{Amount: NewTestSysCoins(10, 0)},
+		{Amount: NewTestSysCoins(20, 0), ReleaseTime: releaseTime},
+		{Amount: NewTestSysCoins(30, 0)},
+	}
+	proposal := types.NewCommunityPoolGrantProposal("grant", "description", recipient, reviewer, milestones)
+
+	// the community pool is insufficient
+	bigProposal := proposal
+	bigProposal.Milestones = types.GrantMilestones{{Amount: NewTestSysCoins(101, 0)}}
+	require.Error(t, k.CreateGrant(ctx, 1, bigProposal))
+	_, found := k.GetGrant(ctx, 1)
+	require.False(t, found)
+
+	// the coins of all milestones are escrowed out of the community pool
+	require.Nil(t, k.CreateGrant(ctx, 1, proposal))
+	require.Equal(t, NewTestSysCoins(40, 0), k.GetFeePoolCommunityCoins(ctx))
+	_, broken := ModuleAccountInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// only the reviewer is allowed to confirm
+	balance := ak.GetAccount(ctx, recipient).GetCoins()
+	require.Error(t, k.ConfirmGrantMilestone(ctx, 1, delAddr3))
+	require.Error(t, k.ConfirmGrantMilestone(ctx, 2, reviewer))
+	require.Nil(t, k.ConfirmGrantMilestone(ctx, 1, reviewer))
+	require.Equal(t, balance.Add(NewTestSysCoins(10, 0)...), ak.GetAccount(ctx, recipient).GetCoins())
+
+	// the timed milestone can't be confirmed and is streamed once its release time is reached
+	require.Error(t, k.ConfirmGrantMilestone(ctx, 1, reviewer))
+	k.ReleaseDueGrantMilestones(ctx)
+	grant, found := k.GetGrant(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, uint64(1), grant.NextMilestone)
+
+	ctx = ctx.WithBlockTime(releaseTime)
+	k.ReleaseDueGrantMilestones(ctx)
+	grant, _ = k.GetGrant(ctx, 1)
+	require.Equal(t, uint64(2), grant.NextMilestone)
+	require.Equal(t, NewTestSysCoins(30, 0), grant.Released)
+	require.Equal(t, NewTestSysCoins(30, 0), grant.Remaining)
+	require.Equal(t, balance.Add(NewTestSysCoins(30, 0)...), ak.GetAccount(ctx, recipient).GetCoins())
+	_, broken = ModuleAccountInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// query grants by status and recipient
+	querier := NewQuerier(k)
+	bz, err := querier(ctx, []string{types.QueryGrants}, abci.RequestQuery{
+		Data: k.cdc.MustMarshalJSON(types.NewQueryGrantsParams(types.GrantStatusActive, recipient)),
+	})
+	require.Nil(t, err)
+	var grants types.Grants
+	k.cdc.MustUnmarshalJSON(bz, &grants)
+	require.Equal(t, types.Grants{grant}, grants)
+
+	// cancelling returns the unreleased coins to the community pool
+	require.Nil(t, k.CancelGrant(ctx, 1))
+	require.Equal(t, NewTestSysCoins(70, 0), k.GetFeePoolCommunityCoins(ctx))
+	grant, _ = k.GetGrant(ctx, 1)
+	require.Equal(t, types.GrantStatusCancelled, grant.Status)
+	require.True(t, grant.Remaining.IsZero())
+	require.Error(t, k.ReleaseGrantMilestone(ctx, 1))
+	require.Error(t, k.CancelGrant(ctx, 1))
+	_, broken = ModuleAccountInvariant(k)(ctx)
+	require.False(t, broken)
+
+	bz, err = querier(ctx, []string{types.QueryGrant}, abci.RequestQuery{
+		Data: k.cdc.MustMarshalJSON(types.NewQueryGrantParams(1)),
+	})
+	require.Nil(t, err)
+	var queried types.Grant
+	k.cdc.MustUnmarshalJSON(bz, &queried)
+	require.Equal(t, grant, queried)
+}
+
+func TestReleaseTimedGrantMilestoneByProposal(t *testing.T) {
+	ctx, _, k, _, supplyKeeper := CreateTestInputDefault(t, false, 1000)
+	ctx = ctx.WithBlockTime(time.Now())
+
+	pool := NewTestSysCoins(100, 0)
+	require.Nil(t, supplyKeeper.SendCoinsFromAccountToModule(ctx, delAddr3, types.ModuleName, pool))
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = pool
+	k.SetFeePool(ctx, feePool)
+
+	releaseTime := ctx.BlockTime().Add(time.Hour)
+	milestones := types.GrantMilestones{{Amount: NewTestSysCoins(10, 0), ReleaseTime: releaseTime}}
+	require.Nil(t, k.CreateGrant(ctx, 1,
+		types.NewCommunityPoolGrantProposal("grant", "description", delAddr1, nil, milestones)))
+
+	// a release proposal streams the timed milestone early and takes it out of the release queue
+	require.Nil(t, HandleCommunityPoolGrantReleaseProposal(ctx, k,
+		types.NewCommunityPoolGrantReleaseProposal("release", "description", 1)))
+	grant, _ := k.GetGrant(ctx, 1)
+	require.Equal(t, types.GrantStatusCompleted, grant.Status)
+
+	var queued bool
+	k.IterateGrantReleaseQueue(ctx, releaseTime, func(uint64) bool {
+		queued = true
+		return true
+	})
+	require.False(t, queued)
+}
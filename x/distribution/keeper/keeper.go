@@ -91,10 +91,18 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 
 	if !commission.IsZero() {
 		accAddr := sdk.AccAddress(valAddr)
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, accAddr)
-		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, commission)
+		// the operator who has bound a proxy pays the proxy fee out of the commission
+		rest, err := k.SettleProxyFee(ctx, accAddr, commission)
 		if err != nil {
-			return nil, types.ErrSendCoinsFromModuleToAccountFailed()
+			return nil, err
+		}
+
+		if !rest.IsZero() {
+			withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, accAddr)
+			err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, rest)
+			if err != nil {
+				return nil, types.ErrSendCoinsFromModuleToAccountFailed()
+			}
 		}
 	}
 
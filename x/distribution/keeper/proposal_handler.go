@@ -25,6 +25,30 @@ func HandleCommunityPoolSpendProposal(ctx sdk.Context, k Keeper, p types.Communi
 	return nil
 }
 
+// HandleCommunityPoolGrantProposal is a handler for executing a passed community pool grant proposal, the id of
+// the proposal becomes the id of the grant
+func HandleCommunityPoolGrantProposal(ctx sdk.Context, k Keeper, proposalID uint64,
+	p types.CommunityPoolGrantProposal) error {
+	if err := k.CreateGrant(ctx, proposalID, p); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("granted %s from the community pool to recipient %s in %d milestones",
+		p.Milestones.Total(), p.Recipient, len(p.Milestones)))
+	return nil
+}
+
+// HandleCommunityPoolGrantReleaseProposal is a handler for executing a passed community pool grant release proposal
+func HandleCommunityPoolGrantReleaseProposal(ctx sdk.Context, k Keeper, p types.CommunityPoolGrantReleaseProposal) error {
+	return k.ReleaseGrantMilestone(ctx, p.GrantID)
+}
+
+// HandleCommunityPoolGrantCancelProposal is a handler for executing a passed community pool grant cancel proposal
+func HandleCommunityPoolGrantCancelProposal(ctx sdk.Context, k Keeper, p types.CommunityPoolGrantCancelProposal) error {
+	return k.CancelGrant(ctx, p.GrantID)
+}
+
 // distributeFromFeePool distributes funds from the distribution module account to
 // a receiver address while updating the community pool
 func (k Keeper) distributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error {
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/distribution/types"
+)
+
+// SettleProxyFee takes the fee of the proxy bound by a delegator from the rewards of the delegator, which are held by
+// the distribution module account, and sends it to the withdraw address of the proxy. The rest of the rewards is
+// returned to be paid to the delegator. The commission withdrawn by a validator operator is the reward settled here
+func (k Keeper) SettleProxyFee(ctx sdk.Context, delAddr sdk.AccAddress, rewards sdk.SysCoins) (sdk.SysCoins, error) {
+	proxyAddr, feeRate, found := k.stakingKeeper.ProxyFeeRate(ctx, delAddr)
+	if !found || !feeRate.IsPositive() || rewards.IsZero() {
+		return rewards, nil
+	}
+
+	fee := rewards.MulDecTruncate(feeRate)
+	if fee.IsZero() {
+		return rewards, nil
+	}
+
+	withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, proxyAddr)
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, fee); err != nil {
+		return rewards, types.ErrSendCoinsFromModuleToAccountFailed()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProxyFee,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyProxy, proxyAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+		),
+	)
+
+	return rewards.Sub(fee), nil
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/distribution/types"
+	"github.com/okex/okexchain/x/staking"
+)
+
+func TestSettleProxyFee(t *testing.T) {
+	ctx, ak, k, sk, supplyKeeper := CreateTestInputDefault(t, false, 1000)
+	ctx = ctx.WithBlockTime(time.Now())
+	h := staking.NewHandler(sk)
+	proxyAddr, delAddr := delAddr1, delAddr2
+	for _, addr := range []sdk.AccAddress{proxyAddr, delAddr} {
+		_, err := h(ctx, staking.NewMsgDeposit(addr, NewTestSysCoin(10, 0)))
+		require.Nil(t, err)
+	}
+	_, err := h(ctx, staking.NewMsgRegProxy(proxyAddr, true))
+	require.Nil(t, err)
+	require.Nil(t, k.SetWithdrawAddr(ctx, proxyAddr, delAddr4))
+
+	// rewards held by the distribution module account
+	rewards := NewTestSysCoins(100, 0)
+	require.Nil(t, supplyKeeper.SendCoinsFromAccountToModule(ctx, delAddr3, types.ModuleName, rewards))
+
+	// nothing is taken from a delegator without proxy
+	rest, err := k.SettleProxyFee(ctx, delAddr, rewards)
+	require.Nil(t, err)
+	require.Equal(t, rewards, rest)
+
+	_, err = h(ctx, staking.NewMsgBindProxy(delAddr, proxyAddr))
+	require.Nil(t, err)
+
+	// nothing is taken by a proxy without fee
+	rest, err = k.SettleProxyFee(ctx, delAddr, rewards)
+	require.Nil(t, err)
+	require.Equal(t, rewards, rest)
+
+	feeRate := sdk.NewDecWithPrec(1, 1)
+	_, err = h(ctx, staking.NewMsgEditProxy(proxyAddr, staking.Description{}, &feeRate, nil))
+	require.Nil(t, err)
+
+	withdrawBalance := ak.GetAccount(ctx, delAddr4).GetCoins()
+	rest, err = k.SettleProxyFee(ctx, delAddr, rewards)
+	require.Nil(t, err)
+	require.Equal(t, NewTestSysCoins(90, 0), rest)
+	require.Equal(t, withdrawBalance.Add(NewTestSysCoins(10, 0)...), ak.GetAccount(ctx, delAddr4).GetCoins())
+	require.Equal(t, NewTestSysCoins(90, 0), supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins())
+}
+
+func TestWithdrawValidatorCommissionWithProxyFee(t *testing.T) {
+	ctx, ak, k, sk, supplyKeeper := CreateTestInputDefault(t, false, 1000)
+	ctx = ctx.WithBlockTime(time.Now())
+	h := staking.NewHandler(sk)
+	proxyAddr, operatorAddr := delAddr1, valAccAddr1
+	for _, addr := range []sdk.AccAddress{proxyAddr, operatorAddr} {
+		_, err := h(ctx, staking.NewMsgDeposit(addr, NewTestSysCoin(10, 0)))
+		require.Nil(t, err)
+	}
+	_, err := h(ctx, staking.NewMsgRegProxy(proxyAddr, true))
+	require.Nil(t, err)
+	feeRate := sdk.NewDecWithPrec(1, 1)
+	_, err = h(ctx, staking.NewMsgEditProxy(proxyAddr, staking.Description{}, &feeRate, nil))
+	require.Nil(t, err)
+	require.Nil(t, k.SetWithdrawAddr(ctx, proxyAddr, delAddr4))
+
+	// the commission accumulated by the validator is held by the distribution module account
+	commission := NewTestSysCoins(100, 0)
+	require.Nil(t, supplyKeeper.SendCoinsFromAccountToModule(ctx, delAddr3, types.ModuleName, commission))
+	k.SetValidatorAccumulatedCommission(ctx, valOpAddr1, commission)
+
+	// the whole commission is paid to the operator without proxy
+	operatorBalance := ak.GetAccount(ctx, operatorAddr).GetCoins()
+	withdrawn, err := k.WithdrawValidatorCommission(ctx, valOpAddr1)
+	require.Nil(t, err)
+	require.Equal(t, commission, withdrawn)
+	require.Equal(t, operatorBalance.Add(commission...), ak.GetAccount(ctx, operatorAddr).GetCoins())
+
+	// the proxy bound by the operator takes its fee out of the commission
+	_, err = h(ctx, staking.NewMsgBindProxy(operatorAddr, proxyAddr))
+	require.Nil(t, err)
+	require.Nil(t, supplyKeeper.SendCoinsFromAccountToModule(ctx, delAddr3, types.ModuleName, commission))
+	k.SetValidatorAccumulatedCommission(ctx, valOpAddr1, commission)
+
+	operatorBalance = ak.GetAccount(ctx, operatorAddr).GetCoins()
+	withdrawBalance := ak.GetAccount(ctx, delAddr4).GetCoins()
+	withdrawn, err = k.WithdrawValidatorCommission(ctx, valOpAddr1)
+	require.Nil(t, err)
+	require.Equal(t, commission, withdrawn)
+	require.Equal(t, operatorBalance.Add(NewTestSysCoins(90, 0)...), ak.GetAccount(ctx, operatorAddr).GetCoins())
+	require.Equal(t, withdrawBalance.Add(NewTestSysCoins(10, 0)...), ak.GetAccount(ctx, delAddr4).GetCoins())
+	require.True(t, supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins().IsZero())
+}
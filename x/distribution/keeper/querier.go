@@ -25,6 +25,12 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QueryCommunityPool:
 			return queryCommunityPool(ctx, path[1:], req, k)
 
+		case types.QueryGrant:
+			return queryGrant(ctx, path[1:], req, k)
+
+		case types.QueryGrants:
+			return queryGrants(ctx, path[1:], req, k)
+
 		default:
 			return nil, types.ErrUnknownDistributionQueryType()
 		}
@@ -103,3 +109,50 @@ func queryCommunityPool(ctx sdk.Context, _ []string, req abci.RequestQuery, k Ke
 
 	return bz, nil
 }
+
+func queryGrant(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryGrantParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, comm.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	grant, found := k.GetGrant(ctx, params.GrantID)
+	if !found {
+		return nil, types.ErrUnknownGrant(params.GrantID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, grant)
+	if err != nil {
+		return nil, comm.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryGrants(ctx sdk.Context, _ []string, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryGrantsParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, comm.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	grants := types.Grants{}
+	k.IterateGrants(ctx, func(grant types.Grant) (stop bool) {
+		if len(params.Status) != 0 && grant.Status != params.Status {
+			return false
+		}
+		if !params.Recipient.Empty() && !grant.Recipient.Equals(params.Recipient) {
+			return false
+		}
+		grants = append(grants, grant)
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, grants)
+	if err != nil {
+		return nil, comm.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return bz, nil
+}
@@ -149,7 +149,8 @@ func CreateTestInputDefault(t *testing.T, isCheckTx bool, initPower int64) (
 	// create four validators
 	for i := int64(0); i < 4; i++ {
 		msg := staking.NewMsgCreateValidator(valOpAddrs[i], valConsPks[i],
-			staking.Description{}, NewTestSysCoin(i+1, 0))
+			staking.Description{}, staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+			NewTestSysCoin(i+1, 0))
 		// assert initial state: zero current rewards
 		_, e := h(ctx, msg)
 		require.Nil(t, e)
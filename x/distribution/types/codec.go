@@ -8,7 +8,13 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgWithdrawValidatorCommission{}, "okexchain/distribution/MsgWithdrawReward", nil)
 	cdc.RegisterConcrete(MsgSetWithdrawAddress{}, "okexchain/distribution/MsgModifyWithdrawAddress", nil)
+	cdc.RegisterConcrete(MsgConfirmGrantMilestone{}, "okexchain/distribution/MsgConfirmGrantMilestone", nil)
 	cdc.RegisterConcrete(CommunityPoolSpendProposal{}, "okexchain/distribution/CommunityPoolSpendProposal", nil)
+	cdc.RegisterConcrete(CommunityPoolGrantProposal{}, "okexchain/distribution/CommunityPoolGrantProposal", nil)
+	cdc.RegisterConcrete(CommunityPoolGrantReleaseProposal{},
+		"okexchain/distribution/CommunityPoolGrantReleaseProposal", nil)
+	cdc.RegisterConcrete(CommunityPoolGrantCancelProposal{},
+		"okexchain/distribution/CommunityPoolGrantCancelProposal", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout module
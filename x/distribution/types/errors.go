@@ -2,6 +2,9 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -28,6 +31,12 @@ const (
 	CodeBadDistribution                             uint32 = 67816
 	CodeInvalidProposalAmount                       uint32 = 67817
 	CodeEmptyProposalRecipient                      uint32 = 67818
+	CodeInvalidGrantMilestones                      uint32 = 67819
+	CodeUnknownGrant                                uint32 = 67820
+	CodeGrantNotActive                              uint32 = 67821
+	CodeNotGrantReviewer                            uint32 = 67822
+	CodeGrantMilestoneTimed                         uint32 = 67823
+	CodeNilReviewerAddr                             uint32 = 67824
 )
 
 func ErrNilDelegatorAddr() sdk.Error {
@@ -89,3 +98,29 @@ func ErrInvalidProposalAmount() sdk.Error {
 func ErrEmptyProposalRecipient() sdk.Error {
 	return sdkerrors.New(DefaultCodespace, CodeEmptyProposalRecipient, "invalid community pool spend proposal recipient")
 }
+
+func ErrInvalidGrantMilestones(reason string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeInvalidGrantMilestones, fmt.Sprintf("invalid grant milestones: %s", reason))
+}
+
+func ErrUnknownGrant(grantID uint64) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeUnknownGrant, fmt.Sprintf("grant %d does not exist", grantID))
+}
+
+func ErrGrantNotActive(grantID uint64, status GrantStatus) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeGrantNotActive, fmt.Sprintf("grant %d is %s", grantID, status))
+}
+
+func ErrNotGrantReviewer(addr sdk.AccAddress, grantID uint64) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeNotGrantReviewer,
+		fmt.Sprintf("%s is not the reviewer of grant %d", addr, grantID))
+}
+
+func ErrGrantMilestoneTimed(grantID, milestone uint64, releaseTime time.Time) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeGrantMilestoneTimed,
+		fmt.Sprintf("milestone %d of grant %d is released automatically at %s", milestone, grantID, releaseTime))
+}
+
+func ErrNilReviewerAddr() sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeNilReviewerAddr, "reviewer address is empty")
+}
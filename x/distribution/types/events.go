@@ -6,9 +6,18 @@ const (
 	EventTypeCommission         = "commission"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeProxyFee           = "proxy_fee"
+	EventTypeCreateGrant        = "create_grant"
+	EventTypeReleaseGrant       = "release_grant"
+	EventTypeCancelGrant        = "cancel_grant"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyProxy           = "proxy"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyGrantID         = "grant_id"
+	AttributeKeyMilestone       = "milestone"
+	AttributeKeyRecipient       = "recipient"
 
 	AttributeValueCategory = ModuleName
 )
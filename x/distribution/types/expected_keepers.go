@@ -35,6 +35,9 @@ type StakingKeeper interface {
 
 	GetLastTotalPower(ctx sdk.Context) sdk.Int
 	GetLastValidatorPower(ctx sdk.Context, valAddr sdk.ValAddress) int64
+
+	// get the proxy bound by a delegator and the fee rate it takes from the rewards of the delegator
+	ProxyFeeRate(ctx sdk.Context, delAddr sdk.AccAddress) (proxyAddr sdk.AccAddress, feeRate sdk.Dec, found bool)
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -24,11 +24,13 @@ type GenesisState struct {
 	DelegatorWithdrawInfos          []DelegatorWithdrawInfo                `json:"delegator_withdraw_infos" yaml:"delegator_withdraw_infos"`
 	PreviousProposer                sdk.ConsAddress                        `json:"previous_proposer" yaml:"previous_proposer"`
 	ValidatorAccumulatedCommissions []ValidatorAccumulatedCommissionRecord `json:"validator_accumulated_commissions" yaml:"validator_accumulated_commissions"`
+	Grants                          Grants                                 `json:"grants" yaml:"grants"`
 }
 
 // NewGenesisState creates a new object of GenesisState
 func NewGenesisState( params Params, feePool FeePool,
-	dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, acc []ValidatorAccumulatedCommissionRecord) GenesisState {
+	dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, acc []ValidatorAccumulatedCommissionRecord,
+	grants Grants) GenesisState {
 
 	return GenesisState{
 		Params:                          params,
@@ -36,6 +38,7 @@ func NewGenesisState( params Params, feePool FeePool,
 		DelegatorWithdrawInfos:          dwis,
 		PreviousProposer:                pp,
 		ValidatorAccumulatedCommissions: acc,
+		Grants:                          grants,
 	}
 }
 
@@ -47,6 +50,7 @@ func DefaultGenesisState() GenesisState {
 		DelegatorWithdrawInfos:          []DelegatorWithdrawInfo{},
 		PreviousProposer:                nil,
 		ValidatorAccumulatedCommissions: []ValidatorAccumulatedCommissionRecord{},
+		Grants:                          Grants{},
 	}
 }
 
@@ -55,5 +59,10 @@ func ValidateGenesis(gs GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	for _, grant := range gs.Grants {
+		if err := grant.Validate(); err != nil {
+			return err
+		}
+	}
 	return gs.FeePool.ValidateGenesis()
 }
@@ -0,0 +1,186 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxGrantMilestones is the maximum number of milestones in a community pool grant
+const MaxGrantMilestones = 16
+
+// GrantStatus is the status of a community pool grant
+type GrantStatus string
+
+// valid grant statuses
+const (
+	GrantStatusActive    GrantStatus = "Active"
+	GrantStatusCompleted GrantStatus = "Completed"
+	GrantStatusCancelled GrantStatus = "Cancelled"
+)
+
+// GrantStatusFromString turns a string into a GrantStatus
+func GrantStatusFromString(str string) (GrantStatus, error) {
+	switch status := GrantStatus(str); status {
+	case GrantStatusActive, GrantStatusCompleted, GrantStatusCancelled:
+		return status, nil
+	default:
+		return "", fmt.Errorf("'%s' is not a valid grant status", str)
+	}
+}
+
+// GrantMilestone is a tranche of a community pool grant. A milestone with a release time is streamed to the
+// recipient automatically once the block time reaches it, otherwise it waits for the confirmation of the reviewer
+// or a passed release proposal
+type GrantMilestone struct {
+	Amount      sdk.SysCoins `json:"amount" yaml:"amount"`
+	ReleaseTime time.Time    `json:"release_time" yaml:"release_time"`
+}
+
+// IsTimed shows whether the milestone is released automatically at its release time
+func (m GrantMilestone) IsTimed() bool {
+	return !m.ReleaseTime.IsZero()
+}
+
+// String implements the Stringer interface
+func (m GrantMilestone) String() string {
+	if m.IsTimed() {
+		return fmt.Sprintf("%s at %s", m.Amount, m.ReleaseTime)
+	}
+	return fmt.Sprintf("%s on confirmation", m.Amount)
+}
+
+// GrantMilestones is a collection of GrantMilestone
+type GrantMilestones []GrantMilestone
+
+// Total returns the sum of all milestone amounts
+func (ms GrantMilestones) Total() (total sdk.SysCoins) {
+	for _, m := range ms {
+		total = total.Add(m.Amount...)
+	}
+	return
+}
+
+// Validate checks the milestones of a grant proposal
+func (ms GrantMilestones) Validate() error {
+	if len(ms) == 0 || len(ms) > MaxGrantMilestones {
+		return ErrInvalidGrantMilestones(fmt.Sprintf("the number of milestones should be between 1 and %d",
+			MaxGrantMilestones))
+	}
+
+	var lastReleaseTime time.Time
+	for i, m := range ms {
+		if !m.Amount.IsValid() || m.Amount.IsZero() {
+			return ErrInvalidGrantMilestones(fmt.Sprintf("invalid amount of milestone %d: %s", i, m.Amount))
+		}
+		if !m.IsTimed() {
+			continue
+		}
+		if m.ReleaseTime.Before(lastReleaseTime) {
+			return ErrInvalidGrantMilestones(fmt.Sprintf("release time of milestone %d is before the previous one", i))
+		}
+		lastReleaseTime = m.ReleaseTime
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (ms GrantMilestones) String() string {
+	var b strings.Builder
+	for i, m := range ms {
+		b.WriteString(fmt.Sprintf("\n    %d: %s", i, m))
+	}
+	return b.String()
+}
+
+// Grant is a community pool grant created by a passed CommunityPoolGrantProposal. The coins of the unreleased
+// milestones are escrowed out of the community pool and returned to it if the grant is cancelled
+type Grant struct {
+	GrantID       uint64          `json:"grant_id" yaml:"grant_id"`
+	Title         string          `json:"title" yaml:"title"`
+	Recipient     sdk.AccAddress  `json:"recipient" yaml:"recipient"`
+	Reviewer      sdk.AccAddress  `json:"reviewer" yaml:"reviewer"`
+	Milestones    GrantMilestones `json:"milestones" yaml:"milestones"`
+	NextMilestone uint64          `json:"next_milestone" yaml:"next_milestone"`
+	Status        GrantStatus     `json:"status" yaml:"status"`
+	Released      sdk.SysCoins    `json:"released" yaml:"released"`
+	Remaining     sdk.SysCoins    `json:"remaining" yaml:"remaining"`
+}
+
+// NewGrant creates a new active grant with all its milestones escrowed
+func NewGrant(grantID uint64, title string, recipient, reviewer sdk.AccAddress, milestones GrantMilestones) Grant {
+	return Grant{
+		GrantID:    grantID,
+		Title:      title,
+		Recipient:  recipient,
+		Reviewer:   reviewer,
+		Milestones: milestones,
+		Status:     GrantStatusActive,
+		Released:   sdk.SysCoins{},
+		Remaining:  milestones.Total(),
+	}
+}
+
+// PendingMilestone returns the next milestone to be released of an active grant
+func (g Grant) PendingMilestone() (GrantMilestone, bool) {
+	if g.Status != GrantStatusActive || g.NextMilestone >= uint64(len(g.Milestones)) {
+		return GrantMilestone{}, false
+	}
+	return g.Milestones[g.NextMilestone], true
+}
+
+// Validate checks the consistency of a grant, used at genesis
+func (g Grant) Validate() error {
+	if g.Recipient.Empty() {
+		return ErrEmptyProposalRecipient()
+	}
+	if err := g.Milestones.Validate(); err != nil {
+		return err
+	}
+	if _, err := GrantStatusFromString(string(g.Status)); err != nil {
+		return err
+	}
+	if g.NextMilestone > uint64(len(g.Milestones)) {
+		return fmt.Errorf("next milestone %d of grant %d is out of range", g.NextMilestone, g.GrantID)
+	}
+	if !g.Released.IsValid() || !g.Remaining.IsValid() {
+		return fmt.Errorf("invalid released or remaining coins of grant %d", g.GrantID)
+	}
+	unreleased := g.Milestones[g.NextMilestone:].Total()
+	if g.Status == GrantStatusActive && !(g.Remaining.IsAllGTE(unreleased) && unreleased.IsAllGTE(g.Remaining)) {
+		return fmt.Errorf("remaining coins of grant %d don't match its unreleased milestones", g.GrantID)
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (g Grant) String() string {
+	return fmt.Sprintf(`Grant %d:
+  Title:          %s
+  Recipient:      %s
+  Reviewer:       %s
+  Status:         %s
+  Next Milestone: %d
+  Released:       %s
+  Remaining:      %s
+  Milestones:     %s`,
+		g.GrantID, g.Title, g.Recipient, g.Reviewer, g.Status, g.NextMilestone, g.Released, g.Remaining,
+		g.Milestones)
+}
+
+// Grants is a collection of Grant
+type Grants []Grant
+
+// String implements the Stringer interface
+func (gs Grants) String() string {
+	if len(gs) == 0 {
+		return "[]"
+	}
+	out := make([]string, len(gs))
+	for i, g := range gs {
+		out[i] = g.String()
+	}
+	return strings.Join(out, "\n")
+}
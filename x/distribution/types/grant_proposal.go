@@ -0,0 +1,169 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	govtypes "github.com/okex/okexchain/x/gov/types"
+)
+
+const (
+	// ProposalTypeCommunityPoolGrant defines the type for a CommunityPoolGrantProposal
+	ProposalTypeCommunityPoolGrant = "CommunityPoolGrant"
+	// ProposalTypeCommunityPoolGrantRelease defines the type for a CommunityPoolGrantReleaseProposal
+	ProposalTypeCommunityPoolGrantRelease = "CommunityPoolGrantRelease"
+	// ProposalTypeCommunityPoolGrantCancel defines the type for a CommunityPoolGrantCancelProposal
+	ProposalTypeCommunityPoolGrantCancel = "CommunityPoolGrantCancel"
+)
+
+// Assert the grant proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = CommunityPoolGrantProposal{}
+	_ govtypes.Content = CommunityPoolGrantReleaseProposal{}
+	_ govtypes.Content = CommunityPoolGrantCancelProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeCommunityPoolGrant)
+	govtypes.RegisterProposalTypeCodec(CommunityPoolGrantProposal{}, "okexchain/distribution/CommunityPoolGrantProposal")
+	govtypes.RegisterProposalType(ProposalTypeCommunityPoolGrantRelease)
+	govtypes.RegisterProposalTypeCodec(CommunityPoolGrantReleaseProposal{},
+		"okexchain/distribution/CommunityPoolGrantReleaseProposal")
+	govtypes.RegisterProposalType(ProposalTypeCommunityPoolGrantCancel)
+	govtypes.RegisterProposalTypeCodec(CommunityPoolGrantCancelProposal{},
+		"okexchain/distribution/CommunityPoolGrantCancelProposal")
+}
+
+// CommunityPoolGrantProposal grants coins of the community pool to a recipient in milestones. Once the proposal
+// passes, the id of the proposal becomes the id of the grant
+type CommunityPoolGrantProposal struct {
+	Title       string          `json:"title" yaml:"title"`
+	Description string          `json:"description" yaml:"description"`
+	Recipient   sdk.AccAddress  `json:"recipient" yaml:"recipient"`
+	Reviewer    sdk.AccAddress  `json:"reviewer" yaml:"reviewer"`
+	Milestones  GrantMilestones `json:"milestones" yaml:"milestones"`
+}
+
+// NewCommunityPoolGrantProposal creates a new community pool grant proposal
+func NewCommunityPoolGrantProposal(title, description string, recipient, reviewer sdk.AccAddress,
+	milestones GrantMilestones) CommunityPoolGrantProposal {
+	return CommunityPoolGrantProposal{title, description, recipient, reviewer, milestones}
+}
+
+// GetTitle returns the title of a community pool grant proposal
+func (cgp CommunityPoolGrantProposal) GetTitle() string { return cgp.Title }
+
+// GetDescription returns the description of a community pool grant proposal
+func (cgp CommunityPoolGrantProposal) GetDescription() string { return cgp.Description }
+
+// ProposalRoute returns the routing key of a community pool grant proposal
+func (cgp CommunityPoolGrantProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool grant proposal
+func (cgp CommunityPoolGrantProposal) ProposalType() string { return ProposalTypeCommunityPoolGrant }
+
+// ValidateBasic runs basic stateless validity checks
+func (cgp CommunityPoolGrantProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(ModuleName, cgp); err != nil {
+		return err
+	}
+	if cgp.Recipient.Empty() {
+		return ErrEmptyProposalRecipient()
+	}
+	return cgp.Milestones.Validate()
+}
+
+// String implements the Stringer interface
+func (cgp CommunityPoolGrantProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Community Pool Grant Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Reviewer:    %s
+  Milestones:  %s
+`, cgp.Title, cgp.Description, cgp.Recipient, cgp.Reviewer, cgp.Milestones))
+	return b.String()
+}
+
+// CommunityPoolGrantReleaseProposal releases the pending milestone of an active grant
+type CommunityPoolGrantReleaseProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	GrantID     uint64 `json:"grant_id" yaml:"grant_id"`
+}
+
+// NewCommunityPoolGrantReleaseProposal creates a new community pool grant release proposal
+func NewCommunityPoolGrantReleaseProposal(title, description string, grantID uint64) CommunityPoolGrantReleaseProposal {
+	return CommunityPoolGrantReleaseProposal{title, description, grantID}
+}
+
+// GetTitle returns the title of a community pool grant release proposal
+func (crp CommunityPoolGrantReleaseProposal) GetTitle() string { return crp.Title }
+
+// GetDescription returns the description of a community pool grant release proposal
+func (crp CommunityPoolGrantReleaseProposal) GetDescription() string { return crp.Description }
+
+// ProposalRoute returns the routing key of a community pool grant release proposal
+func (crp CommunityPoolGrantReleaseProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool grant release proposal
+func (crp CommunityPoolGrantReleaseProposal) ProposalType() string {
+	return ProposalTypeCommunityPoolGrantRelease
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (crp CommunityPoolGrantReleaseProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(ModuleName, crp)
+}
+
+// String implements the Stringer interface
+func (crp CommunityPoolGrantReleaseProposal) String() string {
+	return fmt.Sprintf(`Community Pool Grant Release Proposal:
+  Title:       %s
+  Description: %s
+  Grant ID:    %d
+`, crp.Title, crp.Description, crp.GrantID)
+}
+
+// CommunityPoolGrantCancelProposal cancels an active grant and returns its unreleased coins to the community pool
+type CommunityPoolGrantCancelProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	GrantID     uint64 `json:"grant_id" yaml:"grant_id"`
+}
+
+// NewCommunityPoolGrantCancelProposal creates a new community pool grant cancel proposal
+func NewCommunityPoolGrantCancelProposal(title, description string, grantID uint64) CommunityPoolGrantCancelProposal {
+	return CommunityPoolGrantCancelProposal{title, description, grantID}
+}
+
+// GetTitle returns the title of a community pool grant cancel proposal
+func (ccp CommunityPoolGrantCancelProposal) GetTitle() string { return ccp.Title }
+
+// GetDescription returns the description of a community pool grant cancel proposal
+func (ccp CommunityPoolGrantCancelProposal) GetDescription() string { return ccp.Description }
+
+// ProposalRoute returns the routing key of a community pool grant cancel proposal
+func (ccp CommunityPoolGrantCancelProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a community pool grant cancel proposal
+func (ccp CommunityPoolGrantCancelProposal) ProposalType() string {
+	return ProposalTypeCommunityPoolGrantCancel
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (ccp CommunityPoolGrantCancelProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(ModuleName, ccp)
+}
+
+// String implements the Stringer interface
+func (ccp CommunityPoolGrantCancelProposal) String() string {
+	return fmt.Sprintf(`Community Pool Grant Cancel Proposal:
+  Title:       %s
+  Description: %s
+  Grant ID:    %d
+`, ccp.Title, ccp.Description, ccp.GrantID)
+}
@@ -1,6 +1,11 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName is the module name constant used in many places
@@ -27,11 +32,17 @@ const (
 // - 0x03<accAddr_Bytes>: sdk.AccAddress
 //
 // - 0x07<valAddr_Bytes>: ValidatorCurrentRewards
+//
+// - 0x10<grantID_Bytes>: Grant
+//
+// - 0x11<releaseTime_Bytes><grantID_Bytes>: grantID
 var (
 	FeePoolKey                           = []byte{0x00} // key for global distribution state
 	ProposerKey                          = []byte{0x01} // key for the proposer operator address
 	DelegatorWithdrawAddrPrefix          = []byte{0x03} // key for delegator withdraw address
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
+	GrantKeyPrefix                       = []byte{0x10} // key for community pool grants
+	GrantReleaseQueuePrefix              = []byte{0x11} // key for the queue of timed grant milestones
 )
 
 // GetDelegatorWithdrawInfoAddress returns an address from a delegator's withdraw info key
@@ -61,3 +72,30 @@ func GetDelegatorWithdrawAddrKey(delAddr sdk.AccAddress) []byte {
 func GetValidatorAccumulatedCommissionKey(v sdk.ValAddress) []byte {
 	return append(ValidatorAccumulatedCommissionPrefix, v.Bytes()...)
 }
+
+// GetGrantIDBytes returns the byte representation of the grantID
+func GetGrantIDBytes(grantID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, grantID)
+	return bz
+}
+
+// GetGrantIDFromBytes returns grantID in uint64 format from a byte array
+func GetGrantIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GetGrantKey returns the key for a community pool grant
+func GetGrantKey(grantID uint64) []byte {
+	return append(GrantKeyPrefix, GetGrantIDBytes(grantID)...)
+}
+
+// GetGrantReleaseQueueTimeKey returns the key prefix of the timed grant milestones released at the given time
+func GetGrantReleaseQueueTimeKey(releaseTime time.Time) []byte {
+	return append(GrantReleaseQueuePrefix, sdk.FormatTimeBytes(releaseTime)...)
+}
+
+// GetGrantReleaseQueueKey returns the key of a timed grant milestone in the release queue
+func GetGrantReleaseQueueKey(grantID uint64, releaseTime time.Time) []byte {
+	return append(GetGrantReleaseQueueTimeKey(releaseTime), GetGrantIDBytes(grantID)...)
+}
@@ -6,7 +6,7 @@ import (
 )
 
 // Verify interface at compile time
-var _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawValidatorCommission{}
+var _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawValidatorCommission{}, &MsgConfirmGrantMilestone{}
 
 // msg struct for changing the withdraw address for a delegator (or validator self-delegation)
 type MsgSetWithdrawAddress struct {
@@ -78,3 +78,38 @@ func (msg MsgWithdrawValidatorCommission) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+// msg struct for the reviewer of a grant to release its pending milestone
+type MsgConfirmGrantMilestone struct {
+	GrantID  uint64         `json:"grant_id" yaml:"grant_id"`
+	Reviewer sdk.AccAddress `json:"reviewer" yaml:"reviewer"`
+}
+
+func NewMsgConfirmGrantMilestone(grantID uint64, reviewer sdk.AccAddress) MsgConfirmGrantMilestone {
+	return MsgConfirmGrantMilestone{
+		GrantID:  grantID,
+		Reviewer: reviewer,
+	}
+}
+
+func (msg MsgConfirmGrantMilestone) Route() string { return ModuleName }
+func (msg MsgConfirmGrantMilestone) Type() string  { return "confirm_grant_milestone" }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgConfirmGrantMilestone) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Reviewer}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgConfirmGrantMilestone) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgConfirmGrantMilestone) ValidateBasic() sdk.Error {
+	if msg.Reviewer.Empty() {
+		return ErrNilReviewerAddr()
+	}
+	return nil
+}
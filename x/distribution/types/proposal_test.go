@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -33,3 +34,41 @@ func TestNewCommunityPoolSpendProposal(t *testing.T) {
 	proposal.Recipient = nil
 	require.Error(t, proposal.ValidateBasic())
 }
+
+func TestNewCommunityPoolGrantProposal(t *testing.T) {
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	now := time.Now()
+	milestones := GrantMilestones{
+		{Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt()))},
+		{Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())), ReleaseTime: now},
+	}
+	proposal := NewCommunityPoolGrantProposal("Grant", "Build a wallet", recipient, nil, milestones)
+
+	require.Equal(t, RouterKey, proposal.ProposalRoute())
+	require.Equal(t, ProposalTypeCommunityPoolGrant, proposal.ProposalType())
+	require.Nil(t, proposal.ValidateBasic())
+	require.NotPanics(t, func() {
+		_ = proposal.String()
+	})
+
+	proposal.Recipient = nil
+	require.Error(t, proposal.ValidateBasic())
+	proposal.Recipient = recipient
+	proposal.Milestones = nil
+	require.Error(t, proposal.ValidateBasic())
+	proposal.Milestones = GrantMilestones{{Amount: sdk.SysCoins{}}}
+	require.Error(t, proposal.ValidateBasic())
+	proposal.Milestones = make(GrantMilestones, MaxGrantMilestones+1)
+	for i := range proposal.Milestones {
+		proposal.Milestones[i] = milestones[0]
+	}
+	require.Error(t, proposal.ValidateBasic())
+
+	// timed milestones are released in order
+	proposal.Milestones = GrantMilestones{
+		{Amount: milestones[0].Amount, ReleaseTime: now},
+		milestones[0],
+		{Amount: milestones[0].Amount, ReleaseTime: now.Add(-time.Second)},
+	}
+	require.Error(t, proposal.ValidateBasic())
+}
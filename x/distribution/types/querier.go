@@ -8,6 +8,8 @@ const (
 	QueryValidatorCommission = "validator_commission"
 	QueryWithdrawAddr        = "withdraw_addr"
 	QueryCommunityPool       = "community_pool"
+	QueryGrant               = "grant"
+	QueryGrants              = "grants"
 
 	ParamCommunityTax        = "community_tax"
 	ParamWithdrawAddrEnabled = "withdraw_addr_enabled"
@@ -34,3 +36,27 @@ type QueryDelegatorWithdrawAddrParams struct {
 func NewQueryDelegatorWithdrawAddrParams(delegatorAddr sdk.AccAddress) QueryDelegatorWithdrawAddrParams {
 	return QueryDelegatorWithdrawAddrParams{DelegatorAddress: delegatorAddr}
 }
+
+// QueryGrantParams is the struct of params for query 'custom/distr/grant'
+type QueryGrantParams struct {
+	GrantID uint64 `json:"grant_id" yaml:"grant_id"`
+}
+
+// NewQueryGrantParams creates a new instance of QueryGrantParams
+func NewQueryGrantParams(grantID uint64) QueryGrantParams {
+	return QueryGrantParams{GrantID: grantID}
+}
+
+// QueryGrantsParams is the struct of params for query 'custom/distr/grants', empty fields match any grant
+type QueryGrantsParams struct {
+	Status    GrantStatus    `json:"status" yaml:"status"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+}
+
+// NewQueryGrantsParams creates a new instance of QueryGrantsParams
+func NewQueryGrantsParams(status GrantStatus, recipient sdk.AccAddress) QueryGrantsParams {
+	return QueryGrantsParams{
+		Status:    status,
+		Recipient: recipient,
+	}
+}
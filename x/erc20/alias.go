@@ -0,0 +1,23 @@
+package erc20
+
+import (
+	"github.com/okex/okexchain/x/erc20/keeper"
+	"github.com/okex/okexchain/x/erc20/types"
+)
+
+const (
+	StoreKey         = types.StoreKey
+	DefaultCodespace = types.DefaultCodespace
+	ModuleName       = types.ModuleName
+	RouterKey        = types.RouterKey
+	QuerierRoute     = types.QuerierRoute
+)
+
+var (
+	NewKeeper          = keeper.NewKeeper
+	RegisterInvariants = keeper.RegisterInvariants
+)
+
+type (
+	Keeper = keeper.Keeper
+)
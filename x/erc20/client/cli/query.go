@@ -0,0 +1,150 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	client "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/okex/okexchain/x/erc20/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	// Group erc20 queries under a subcommand
+	erc20QueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	erc20QueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryTokenMapping(queryRoute, cdc),
+			GetCmdQueryTokenMappings(queryRoute, cdc),
+			GetCmdQueryBalance(queryRoute, cdc),
+		)...,
+	)
+
+	return erc20QueryCmd
+}
+
+// GetCmdQueryTokenMapping gets the token mapping query command.
+func GetCmdQueryTokenMapping(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "token-mapping [symbol|contract]",
+		Short: "query the token mapping of a native token or an erc20 contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the erc20 contract and the total supply of a token mapping by the symbol of native token
+or the hex address of erc20 contract.
+
+Example:
+$ %s query erc20 token-mapping xxb
+$ %s query erc20 token-mapping 0x8b0dFa1C1e4a2D9a5a6F7D27E43e1F9fB2Fb6f0b
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params, queryType := types.NewQueryTokenMappingParams(args[0], ""), types.QueryTokenMapping
+			if ethcmn.IsHexAddress(args[0]) {
+				params, queryType = types.NewQueryTokenMappingParams("", args[0]), types.QueryTokenMappingByContract
+			}
+			bytes, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, queryType)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var tm types.TokenMappingResponse
+			cdc.MustUnmarshalJSON(resp, &tm)
+			return cliCtx.PrintOutput(tm)
+		},
+	}
+}
+
+// GetCmdQueryTokenMappings gets the token mappings query command.
+func GetCmdQueryTokenMappings(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "token-mappings",
+		Short: "query all the token mappings",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the native tokens mapped to erc20 contracts.
+
+Example:
+$ %s query erc20 token-mappings
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryTokenMappings)
+			resp, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var tms []types.TokenMappingResponse
+			cdc.MustUnmarshalJSON(resp, &tms)
+			return cliCtx.PrintOutput(tms)
+		},
+	}
+}
+
+// GetCmdQueryBalance gets the erc20 balance query command.
+func GetCmdQueryBalance(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "balance [symbol] [address]",
+		Short: "query the erc20 balance of an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the erc20 balance of an address in the contract mapped to a native token.
+
+Example:
+$ %s query erc20 balance xxb okexchain1hw4r48aww06ldrfeuq2v438ujnl6alsz0685a0
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			bytes, err := cdc.MarshalJSON(types.NewQueryBalanceParams(args[0], addr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryBalance)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var balance sdk.SysCoin
+			cdc.MustUnmarshalJSON(resp, &balance)
+			return cliCtx.PrintOutput(balance)
+		},
+	}
+}
@@ -0,0 +1,166 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	client "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	erc20utils "github.com/okex/okexchain/x/erc20/client/utils"
+	"github.com/okex/okexchain/x/erc20/types"
+	"github.com/okex/okexchain/x/gov"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	erc20TxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		SuggestionsMinimumDistance: 2,
+	}
+
+	erc20TxCmd.AddCommand(client.PostCommands(
+		GetCmdCreateTokenMapping(cdc),
+		GetCmdConvertNativeToERC20(cdc),
+		GetCmdConvertERC20ToNative(cdc),
+	)...)
+	return erc20TxCmd
+}
+
+// GetCmdCreateTokenMapping gets the command to map a native token to a new erc20 contract by its owner
+func GetCmdCreateTokenMapping(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-token-mapping [symbol]",
+		Short: "map a native token to a new erc20 contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deploy an erc20 contract for a native token and map the token to it. Only the owner of the
+token is allowed to do it, otherwise it has to be done by governance.
+
+Example:
+$ %s tx erc20 create-token-mapping xxb --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgCreateTokenMapping(cliCtx.GetFromAddress(), args[0])
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdConvertNativeToERC20 gets the command to convert native coins into erc20 tokens
+func GetCmdConvertNativeToERC20(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "convert-native-to-erc20 [amount]",
+		Short: "convert native coins into erc20 tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Lock native coins and mint the same amount of tokens in the mapped erc20 contract to the
+same address.
+
+Example:
+$ %s tx erc20 convert-native-to-erc20 10xxb --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseDecCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgConvertNativeToERC20(cliCtx.GetFromAddress(), amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdConvertERC20ToNative gets the command to convert erc20 tokens back into native coins
+func GetCmdConvertERC20ToNative(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "convert-erc20-to-native [amount]",
+		Short: "convert erc20 tokens into native coins",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn tokens in the erc20 contract mapped to a native token and unlock the same amount of
+native coins to the same address.
+
+Example:
+$ %s tx erc20 convert-erc20-to-native 10xxb --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseDecCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgConvertERC20ToNative(cliCtx.GetFromAddress(), amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdTokenMappingProposal implements a command handler for submitting a token mapping proposal transaction
+func GetCmdTokenMappingProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "token-mapping [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to map a native token to a new erc20 contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a token mapping proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal token-mapping <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+ "title": "token mapping of xxb",
+ "description": "map xxb to a new erc20 contract",
+ "symbol": "xxb",
+ "deposit": [
+   {
+     "denom": "%s",
+     "amount": "100"
+   }
+ ]
+}
+`, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := erc20utils.ParseTokenMappingProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewTokenMappingProposal(proposal.Title, proposal.Description, proposal.Symbol)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,13 @@
+package client
+
+import (
+	"github.com/okex/okexchain/x/erc20/client/cli"
+	"github.com/okex/okexchain/x/erc20/client/rest"
+	govcli "github.com/okex/okexchain/x/gov/client"
+)
+
+var (
+	// TokenMappingProposalHandler alias gov NewProposalHandler
+	TokenMappingProposalHandler = govcli.NewProposalHandler(cli.GetCmdTokenMappingProposal,
+		rest.TokenMappingProposalRESTHandler)
+)
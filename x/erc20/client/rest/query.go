@@ -0,0 +1,116 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/gorilla/mux"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/erc20/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	// get all the token mappings
+	r.HandleFunc(
+		"/erc20/token_mappings",
+		queryTokenMappingsHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// get the token mapping by the symbol of native token or the address of erc20 contract
+	r.HandleFunc(
+		"/erc20/token_mapping/{symbolOrContract}",
+		queryTokenMappingHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// get the erc20 balance of an address
+	r.HandleFunc(
+		"/erc20/balance/{symbol}/{accAddr}",
+		queryBalanceHandlerFn(cliCtx),
+	).Methods("GET")
+}
+
+func queryTokenMappingsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryTokenMappings)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			common.HandleErrorResponseV2(w, http.StatusInternalServerError, common.ErrorABCIQueryFails)
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryTokenMappingHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbolOrContract := mux.Vars(r)["symbolOrContract"]
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		params, queryType := types.NewQueryTokenMappingParams(symbolOrContract, ""), types.QueryTokenMapping
+		if ethcmn.IsHexAddress(symbolOrContract) {
+			params, queryType = types.NewQueryTokenMappingParams("", symbolOrContract), types.QueryTokenMappingByContract
+		}
+		jsonBytes, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorCodecFails)
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, queryType)
+		res, height, err := cliCtx.QueryWithData(route, jsonBytes)
+		if err != nil {
+			common.HandleErrorResponseV2(w, http.StatusInternalServerError, common.ErrorABCIQueryFails)
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryBalanceHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		varsMap := mux.Vars(r)
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		accAddr, err := sdk.AccAddressFromBech32(varsMap["accAddr"])
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeCreateAddrFromBech32Failed, err.Error())
+			return
+		}
+
+		jsonBytes, err := cliCtx.Codec.MarshalJSON(types.NewQueryBalanceParams(varsMap["symbol"], accAddr))
+		if err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorCodecFails)
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryBalance)
+		res, height, err := cliCtx.QueryWithData(route, jsonBytes)
+		if err != nil {
+			common.HandleErrorResponseV2(w, http.StatusInternalServerError, common.ErrorABCIQueryFails)
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -0,0 +1,18 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+
+	govRest "github.com/okex/okexchain/x/gov/client/rest"
+)
+
+// RegisterRoutes registers erc20-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+}
+
+// TokenMappingProposalRESTHandler defines erc20 proposal handler
+func TokenMappingProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
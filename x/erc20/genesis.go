@@ -0,0 +1,65 @@
+package erc20
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+
+	"github.com/okex/okexchain/x/erc20/keeper"
+	"github.com/okex/okexchain/x/erc20/types"
+)
+
+// InitGenesis initializes the token mappings and the erc20 balances, and deploys the erc20 contracts
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	contracts := make(map[string]ethcmn.Address, len(data.TokenMappings))
+	for _, tm := range data.TokenMappings {
+		if err := k.DeployContract(ctx, tm); err != nil {
+			panic(err)
+		}
+		k.SetTokenMapping(ctx, tm)
+		contracts[tm.Symbol] = tm.ContractAddress()
+	}
+
+	for _, balance := range data.Balances {
+		k.Mint(ctx, contracts[balance.Symbol], ethcmn.BytesToAddress(balance.Address), balance.Amount)
+	}
+
+	for _, allowance := range data.Allowances {
+		k.SetAllowance(ctx, contracts[allowance.Symbol], ethcmn.BytesToAddress(allowance.Owner),
+			ethcmn.BytesToAddress(allowance.Spender), allowance.Amount)
+	}
+
+	// init module account
+	if moduleAcc := k.SupplyKeeper().GetModuleAccount(ctx, types.ModuleName); moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+}
+
+// ExportGenesis writes the current store values to a genesis file, which can be imported again with InitGenesis
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	tokenMappings := k.GetTokenMappings(ctx)
+
+	balances := make([]types.Balance, 0)
+	allowances := make([]types.Allowance, 0)
+	for _, tm := range tokenMappings {
+		symbol := tm.Symbol
+		k.IterateBalances(ctx, tm.ContractAddress(), func(holder ethcmn.Address, amount sdk.Dec) (stop bool) {
+			balances = append(balances, types.Balance{Symbol: symbol, Address: holder.Bytes(), Amount: amount})
+			return false
+		})
+		k.IterateAllowances(ctx, tm.ContractAddress(),
+			func(owner, spender ethcmn.Address, amount sdk.Dec) (stop bool) {
+				allowances = append(allowances, types.Allowance{
+					Symbol:  symbol,
+					Owner:   owner.Bytes(),
+					Spender: spender.Bytes(),
+					Amount:  amount,
+				})
+				return false
+			},
+		)
+	}
+
+	return types.NewGenesisState(tokenMappings, balances, allowances)
+}
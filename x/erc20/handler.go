@@ -0,0 +1,113 @@
+package erc20
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/common/perf"
+	"github.com/okex/okexchain/x/erc20/keeper"
+	"github.com/okex/okexchain/x/erc20/types"
+)
+
+// NewHandler creates an sdk.Handler for all the erc20 type messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		var handlerFun func() (*sdk.Result, error)
+		var name string
+		switch msg := msg.(type) {
+		case types.MsgCreateTokenMapping:
+			name = "handleMsgCreateTokenMapping"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgCreateTokenMapping(ctx, k, msg)
+			}
+		case types.MsgConvertNativeToERC20:
+			name = "handleMsgConvertNativeToERC20"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgConvertNativeToERC20(ctx, k, msg)
+			}
+		case types.MsgConvertERC20ToNative:
+			name = "handleMsgConvertERC20ToNative"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgConvertERC20ToNative(ctx, k, msg)
+			}
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
+			return types.ErrUnknownErc20MsgType(errMsg).Result()
+		}
+
+		seq := perf.GetPerf().OnDeliverTxEnter(ctx, types.ModuleName, name)
+		defer perf.GetPerf().OnDeliverTxExit(ctx, types.ModuleName, name, seq)
+
+		res, err := handlerFun()
+		common.SanityCheckHandler(res, err)
+		return res, err
+	}
+}
+
+func handleMsgCreateTokenMapping(ctx sdk.Context, k keeper.Keeper, msg types.MsgCreateTokenMapping) (*sdk.Result,
+	error) {
+	// only the owner of the token is allowed to map it without governance
+	if !k.TokenExist(ctx, msg.Symbol) {
+		return types.ErrTokenNotExist(msg.Symbol).Result()
+	}
+	if !k.GetTokenOwner(ctx, msg.Symbol).Equals(msg.Owner) {
+		return types.ErrInvalidTokenOwner(msg.Owner, msg.Symbol).Result()
+	}
+
+	tm, sdkErr := k.CreateTokenMapping(ctx, msg.Symbol)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateTokenMapping,
+			sdk.NewAttribute(types.AttributeKeySymbol, tm.Symbol),
+			sdk.NewAttribute(types.AttributeKeyContract, tm.Contract),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	})
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgConvertNativeToERC20(ctx sdk.Context, k keeper.Keeper, msg types.MsgConvertNativeToERC20) (*sdk.Result,
+	error) {
+	if sdkErr := k.ConvertNativeToERC20(ctx, msg.Sender, msg.Amount); sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	ctx.EventManager().EmitEvents(convertEvents(types.EventTypeConvertNativeToERC20, msg.Sender, msg.Amount))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgConvertERC20ToNative(ctx sdk.Context, k keeper.Keeper, msg types.MsgConvertERC20ToNative) (*sdk.Result,
+	error) {
+	if sdkErr := k.ConvertERC20ToNative(ctx, msg.Sender, msg.Amount); sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	ctx.EventManager().EmitEvents(convertEvents(types.EventTypeConvertERC20ToNative, msg.Sender, msg.Amount))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func convertEvents(eventType string, sender sdk.AccAddress, amount sdk.SysCoin) sdk.Events {
+	return sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyAddress, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		),
+	}
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+
+	"github.com/okex/okexchain/x/erc20/types"
+	"github.com/okex/okexchain/x/evm/precompile"
+	evmtypes "github.com/okex/okexchain/x/evm/types"
+)
+
+var erc20ABI = precompile.MustParseABI(`[
+	{"type":"function","name":"name","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"decimals","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+	{"type":"function","name":"totalSupply","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view",
+		"inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"allowance","stateMutability":"view",
+		"inputs":[{"name":"owner","type":"address"},{"name":"spender","type":"address"}],
+		"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable",
+		"inputs":[{"name":"recipient","type":"address"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"approve","stateMutability":"nonpayable",
+		"inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"transferFrom","stateMutability":"nonpayable",
+		"inputs":[{"name":"sender","type":"address"},{"name":"recipient","type":"address"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]},
+	{"type":"event","name":"Transfer","anonymous":false,
+		"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]},
+	{"type":"event","name":"Approval","anonymous":false,
+		"inputs":[{"name":"owner","type":"address","indexed":true},{"name":"spender","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]}
+]`)
+
+const (
+	queryGas    = 1000
+	transferGas = 10000
+	approveGas  = 10000
+)
+
+var _ evmtypes.NativeContractResolver = Keeper{}
+
+// GetNativeContract returns the erc20 contract of the token mapping at addr
+func (k Keeper) GetNativeContract(ctx sdk.Context, addr ethcmn.Address) (evmtypes.NativeContract, bool) {
+	tm, found := k.GetTokenMappingByContract(ctx, addr)
+	if !found {
+		return nil, false
+	}
+
+	c := erc20Contract{keeper: k, tokenSymbol: tm.Symbol, address: addr}
+	return precompile.NewContract(erc20ABI, map[string]precompile.Method{
+		"name":         {Gas: queryGas, Run: c.name},
+		"symbol":       {Gas: queryGas, Run: c.symbol},
+		"decimals":     {Gas: queryGas, Run: c.decimals},
+		"totalSupply":  {Gas: queryGas, Run: c.totalSupply},
+		"balanceOf":    {Gas: queryGas, Run: c.balanceOf},
+		"allowance":    {Gas: queryGas, Run: c.allowance},
+		"transfer":     {Gas: transferGas, Write: true, Run: c.transfer},
+		"approve":      {Gas: approveGas, Write: true, Run: c.approve},
+		"transferFrom": {Gas: transferGas + approveGas, Write: true, Run: c.transferFrom},
+	}), true
+}
+
+// erc20Contract implements the erc20 standard with the balances in the erc20 store. The amounts are uint256 with the
+// same precision as the native coins
+type erc20Contract struct {
+	keeper      Keeper
+	tokenSymbol string
+	address     ethcmn.Address
+}
+
+func (c erc20Contract) name(ctx sdk.Context, _ ethcmn.Address, _ []interface{}) ([]interface{}, error) {
+	return []interface{}{c.keeper.tokenKeeper.GetTokenInfo(ctx, c.tokenSymbol).WholeName}, nil
+}
+
+func (c erc20Contract) symbol(_ sdk.Context, _ ethcmn.Address, _ []interface{}) ([]interface{}, error) {
+	return []interface{}{c.tokenSymbol}, nil
+}
+
+func (c erc20Contract) decimals(_ sdk.Context, _ ethcmn.Address, _ []interface{}) ([]interface{}, error) {
+	return []interface{}{uint8(types.Decimals)}, nil
+}
+
+func (c erc20Contract) totalSupply(ctx sdk.Context, _ ethcmn.Address, _ []interface{}) ([]interface{}, error) {
+	return []interface{}{c.keeper.GetTotalSupply(ctx, c.address).BigInt()}, nil
+}
+
+func (c erc20Contract) balanceOf(ctx sdk.Context, _ ethcmn.Address, args []interface{}) ([]interface{}, error) {
+	return []interface{}{c.keeper.GetBalance(ctx, c.address, args[0].(ethcmn.Address)).BigInt()}, nil
+}
+
+func (c erc20Contract) allowance(ctx sdk.Context, _ ethcmn.Address, args []interface{}) ([]interface{}, error) {
+	owner, spender := args[0].(ethcmn.Address), args[1].(ethcmn.Address)
+	return []interface{}{c.keeper.GetAllowance(ctx, c.address, owner, spender).BigInt()}, nil
+}
+
+func (c erc20Contract) transfer(ctx sdk.Context, caller ethcmn.Address, args []interface{}) ([]interface{}, error) {
+	recipient, amount := args[0].(ethcmn.Address), args[1].(*big.Int)
+	if err := c.doTransfer(ctx, caller, recipient, amount); err != nil {
+		return nil, err
+	}
+	return []interface{}{true}, nil
+}
+
+func (c erc20Contract) approve(ctx sdk.Context, caller ethcmn.Address, args []interface{}) ([]interface{}, error) {
+	spender, amount := args[0].(ethcmn.Address), args[1].(*big.Int)
+	c.keeper.SetAllowance(ctx, c.address, caller, spender, precompile.DecFromBigInt(amount))
+	c.emitLog(ctx, "Approval", caller, spender, amount)
+	return []interface{}{true}, nil
+}
+
+func (c erc20Contract) transferFrom(ctx sdk.Context, caller ethcmn.Address, args []interface{}) ([]interface{},
+	error) {
+	sender, recipient, amount := args[0].(ethcmn.Address), args[1].(ethcmn.Address), args[2].(*big.Int)
+	allowance, dec := c.keeper.GetAllowance(ctx, c.address, sender, caller), precompile.DecFromBigInt(amount)
+	if allowance.LT(dec) {
+		return nil, types.ErrInsufficientAllowance(allowance.String(), dec.String())
+	}
+	c.keeper.SetAllowance(ctx, c.address, sender, caller, allowance.Sub(dec))
+
+	if err := c.doTransfer(ctx, sender, recipient, amount); err != nil {
+		return nil, err
+	}
+	return []interface{}{true}, nil
+}
+
+func (c erc20Contract) doTransfer(ctx sdk.Context, from, to ethcmn.Address, amount *big.Int) error {
+	if sdkErr := c.keeper.Transfer(ctx, c.address, from, to, precompile.DecFromBigInt(amount)); sdkErr != nil {
+		return sdkErr
+	}
+	c.emitLog(ctx, "Transfer", from, to, amount)
+	return nil
+}
+
+// emitLog emits the erc20 event with two indexed addresses and the amount
+func (c erc20Contract) emitLog(ctx sdk.Context, event string, from, to ethcmn.Address, amount *big.Int) {
+	data, err := abi.Arguments{erc20ABI.Events[event].Inputs[2]}.Pack(amount)
+	if err != nil {
+		panic(err)
+	}
+	topics := []ethcmn.Hash{erc20ABI.Events[event].ID, from.Hash(), to.Hash()}
+	evmtypes.AddNativeContractLog(ctx, topics, data)
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+
+	"github.com/okex/okexchain/x/erc20/types"
+)
+
+// GetTotalSupply gets the total supply of the erc20 contract
+func (k Keeper) GetTotalSupply(ctx sdk.Context, contract ethcmn.Address) sdk.Dec {
+	return k.getAmount(ctx, types.GetTotalSupplyKey(contract))
+}
+
+// GetBalance gets the balance of holder in the erc20 contract
+func (k Keeper) GetBalance(ctx sdk.Context, contract, holder ethcmn.Address) sdk.Dec {
+	return k.getAmount(ctx, types.GetBalanceKey(contract, holder))
+}
+
+// SetBalance sets the balance of holder in the erc20 contract. The zero balance is deleted from the store
+func (k Keeper) SetBalance(ctx sdk.Context, contract, holder ethcmn.Address, amount sdk.Dec) {
+	k.setAmount(ctx, types.GetBalanceKey(contract, holder), amount)
+}
+
+// GetAllowance gets the amount which spender is allowed to transfer from owner in the erc20 contract
+func (k Keeper) GetAllowance(ctx sdk.Context, contract, owner, spender ethcmn.Address) sdk.Dec {
+	return k.getAmount(ctx, types.GetAllowanceKey(contract, owner, spender))
+}
+
+// SetAllowance sets the amount which spender is allowed to transfer from owner in the erc20 contract
+func (k Keeper) SetAllowance(ctx sdk.Context, contract, owner, spender ethcmn.Address, amount sdk.Dec) {
+	k.setAmount(ctx, types.GetAllowanceKey(contract, owner, spender), amount)
+}
+
+// IterateBalances iterates over all the non-zero balances of the erc20 contract
+func (k Keeper) IterateBalances(ctx sdk.Context, contract ethcmn.Address,
+	handler func(holder ethcmn.Address, amount sdk.Dec) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetBalancesPrefix(contract))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Dec
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &amount)
+		if handler(types.SplitBalanceKey(iterator.Key()), amount) {
+			break
+		}
+	}
+}
+
+// IterateAllowances iterates over all the non-zero allowances of the erc20 contract
+func (k Keeper) IterateAllowances(ctx sdk.Context, contract ethcmn.Address,
+	handler func(owner, spender ethcmn.Address, amount sdk.Dec) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetAllowancesPrefix(contract))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Dec
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &amount)
+		owner, spender := types.SplitAllowanceKey(iterator.Key())
+		if handler(owner, spender, amount) {
+			break
+		}
+	}
+}
+
+// Transfer transfers the erc20 tokens from one holder to another
+func (k Keeper) Transfer(ctx sdk.Context, contract, from, to ethcmn.Address, amount sdk.Dec) sdk.Error {
+	balance := k.GetBalance(ctx, contract, from)
+	if balance.LT(amount) {
+		return types.ErrInsufficientBalance(balance.String(), amount.String())
+	}
+	k.SetBalance(ctx, contract, from, balance.Sub(amount))
+	k.SetBalance(ctx, contract, to, k.GetBalance(ctx, contract, to).Add(amount))
+	return nil
+}
+
+// Mint issues the erc20 tokens to the holder and increases the total supply
+func (k Keeper) Mint(ctx sdk.Context, contract, to ethcmn.Address, amount sdk.Dec) {
+	k.SetBalance(ctx, contract, to, k.GetBalance(ctx, contract, to).Add(amount))
+	k.setAmount(ctx, types.GetTotalSupplyKey(contract), k.GetTotalSupply(ctx, contract).Add(amount))
+}
+
+// Burn destroys the erc20 tokens of the holder and decreases the total supply
+func (k Keeper) Burn(ctx sdk.Context, contract, from ethcmn.Address, amount sdk.Dec) sdk.Error {
+	balance := k.GetBalance(ctx, contract, from)
+	if balance.LT(amount) {
+		return types.ErrInsufficientBalance(balance.String(), amount.String())
+	}
+	k.SetBalance(ctx, contract, from, balance.Sub(amount))
+	k.setAmount(ctx, types.GetTotalSupplyKey(contract), k.GetTotalSupply(ctx, contract).Sub(amount))
+	return nil
+}
+
+// ConvertNativeToERC20 locks the native coins of sender in the module account and mints the same amount of erc20
+// tokens to the eth address of sender
+func (k Keeper) ConvertNativeToERC20(ctx sdk.Context, sender sdk.AccAddress, amount sdk.SysCoin) sdk.Error {
+	tm, found := k.GetTokenMapping(ctx, amount.Denom)
+	if !found {
+		return types.ErrTokenMappingNotExist(amount.Denom)
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName,
+		sdk.SysCoins{amount}); err != nil {
+		return types.ErrSendCoinsFailed(err.Error())
+	}
+	k.Mint(ctx, tm.ContractAddress(), ethcmn.BytesToAddress(sender), amount.Amount)
+	return nil
+}
+
+// ConvertERC20ToNative burns the erc20 tokens of the eth address of sender and unlocks the same amount of native coins
+// from the module account to sender
+func (k Keeper) ConvertERC20ToNative(ctx sdk.Context, sender sdk.AccAddress, amount sdk.SysCoin) sdk.Error {
+	tm, found := k.GetTokenMapping(ctx, amount.Denom)
+	if !found {
+		return types.ErrTokenMappingNotExist(amount.Denom)
+	}
+
+	if sdkErr := k.Burn(ctx, tm.ContractAddress(), ethcmn.BytesToAddress(sender), amount.Amount); sdkErr != nil {
+		return sdkErr
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender,
+		sdk.SysCoins{amount}); err != nil {
+		return types.ErrSendCoinsFailed(err.Error())
+	}
+	return nil
+}
+
+func (k Keeper) getAmount(ctx sdk.Context, key []byte) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+	var amount sdk.Dec
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &amount)
+	return amount
+}
+
+func (k Keeper) setAmount(ctx sdk.Context, key []byte, amount sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	if amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, k.cdc.MustMarshalBinaryLengthPrefixed(amount))
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+
+	"github.com/okex/okexchain/x/erc20/types"
+)
+
+// RegisterInvariants registers all erc20 invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account", moduleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-supply", totalSupplyInvariant(k))
+}
+
+// moduleAccountInvariant checks if erc20 ModuleAccount is consistent with the total supplies of the erc20 contracts
+func moduleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		// iterate all token mappings, then calculate the native coins locked for the erc20 tokens
+		expectedModuleAccAmount := sdk.SysCoins{}
+		for _, tm := range k.GetTokenMappings(ctx) {
+			supply := k.GetTotalSupply(ctx, tm.ContractAddress())
+			expectedModuleAccAmount = expectedModuleAccAmount.Add2(sdk.SysCoins{sdk.NewDecCoinFromDec(tm.Symbol, supply)})
+		}
+
+		// get erc20 module account
+		moduleAccAmount := k.SupplyKeeper().GetModuleAccount(ctx, types.ModuleName).GetCoins()
+
+		// make a comparison
+		broken := !moduleAccAmount.IsEqual(expectedModuleAccAmount)
+
+		return sdk.FormatInvariant(types.ModuleName, "ModuleAccount coins",
+			fmt.Sprintf("\texpected erc20 ModuleAccount coins: %s\n"+
+				"\tacutal erc20 ModuleAccount coins: %s\n",
+				expectedModuleAccAmount, moduleAccAmount)), broken
+	}
+}
+
+// totalSupplyInvariant checks if the total supply of each erc20 contract is equal to the sum of the balances
+func totalSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		for _, tm := range k.GetTokenMappings(ctx) {
+			totalBalance := sdk.ZeroDec()
+			k.IterateBalances(ctx, tm.ContractAddress(), func(_ ethcmn.Address, amount sdk.Dec) (stop bool) {
+				totalBalance = totalBalance.Add(amount)
+				return false
+			})
+
+			if supply := k.GetTotalSupply(ctx, tm.ContractAddress()); !supply.Equal(totalBalance) {
+				broken = true
+				msg += fmt.Sprintf("\ttotal supply of %s: %s, sum of balances: %s\n", tm.Symbol, supply, totalBalance)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "total supply", msg), broken
+	}
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/okex/okexchain/x/erc20/types"
+)
+
+// Keeper of the erc20 store
+type Keeper struct {
+	storeKey     sdk.StoreKey
+	cdc          *codec.Codec
+	supplyKeeper types.SupplyKeeper
+	tokenKeeper  types.TokenKeeper
+	evmKeeper    types.EvmKeeper
+	govKeeper    types.GovKeeper
+}
+
+// NewKeeper creates an erc20 keeper
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, supplyKeeper types.SupplyKeeper, tokenKeeper types.TokenKeeper,
+	evmKeeper types.EvmKeeper) Keeper {
+	return Keeper{
+		storeKey:     key,
+		cdc:          cdc,
+		supplyKeeper: supplyKeeper,
+		tokenKeeper:  tokenKeeper,
+		evmKeeper:    evmKeeper,
+	}
+}
+
+// SupplyKeeper returns the supply keeper
+func (k Keeper) SupplyKeeper() types.SupplyKeeper {
+	return k.supplyKeeper
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// SetGovKeeper sets keeper of gov
+func (k *Keeper) SetGovKeeper(gk types.GovKeeper) {
+	k.govKeeper = gk
+}
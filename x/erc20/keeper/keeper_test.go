@@ -0,0 +1,239 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/okex/okexchain/app"
+	ethermint "github.com/okex/okexchain/app/types"
+	"github.com/okex/okexchain/x/erc20"
+	"github.com/okex/okexchain/x/erc20/types"
+	"github.com/okex/okexchain/x/evm/precompile"
+	evmtypes "github.com/okex/okexchain/x/evm/types"
+	tokentypes "github.com/okex/okexchain/x/token/types"
+)
+
+const (
+	testSymbol = "xxb"
+	erc20ABI   = `[
+	{"type":"function","name":"symbol","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"string"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view",
+		"inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable",
+		"inputs":[{"name":"recipient","type":"address"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"approve","stateMutability":"nonpayable",
+		"inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"transferFrom","stateMutability":"nonpayable",
+		"inputs":[{"name":"sender","type":"address"},{"name":"recipient","type":"address"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]},
+	{"type":"event","name":"Transfer","anonymous":false,
+		"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]}
+]`
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx       sdk.Context
+	app       *app.OKExChainApp
+	handler   sdk.Handler
+	abi       abi.ABI
+	owner     sdk.AccAddress
+	recipient ethcmn.Address
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 1, ChainID: "ethermint-1"})
+	suite.handler = erc20.NewHandler(suite.app.Erc20Keeper)
+	suite.abi = precompile.MustParseABI(erc20ABI)
+
+	suite.owner = sdk.AccAddress(ethcmn.BytesToAddress([]byte("owner")).Bytes())
+	suite.recipient = ethcmn.BytesToAddress([]byte("recipient"))
+	acc := &ethermint.EthAccount{
+		BaseAccount: auth.NewBaseAccount(suite.owner, sdk.NewCoins(sdk.NewDecCoin(testSymbol, sdk.NewInt(100))),
+			nil, 0, 0),
+		CodeHash: ethcrypto.Keccak256(nil),
+	}
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	suite.app.TokenKeeper.NewToken(suite.ctx, tokentypes.Token{
+		Symbol:              testSymbol,
+		OriginalSymbol:      testSymbol,
+		WholeName:           "xxb token",
+		OriginalTotalSupply: sdk.NewDec(100),
+		Owner:               suite.owner,
+	})
+
+	params := evmtypes.DefaultParams()
+	params.EnableCreate = true
+	params.EnableCall = true
+	suite.app.EvmKeeper.SetParams(suite.ctx, params)
+}
+
+func (suite *KeeperTestSuite) contract() ethcmn.Address {
+	return types.ContractAddress(testSymbol)
+}
+
+func (suite *KeeperTestSuite) createTokenMapping() {
+	_, err := suite.handler(suite.ctx, types.NewMsgCreateTokenMapping(suite.owner, testSymbol))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) assertInvariants() {
+	for _, route := range suite.app.CrisisKeeper.Routes() {
+		if route.ModuleName != types.ModuleName {
+			continue
+		}
+		msg, broken := route.Invar(suite.ctx)
+		suite.Require().False(broken, msg)
+	}
+}
+
+func (suite *KeeperTestSuite) transition(sender ethcmn.Address, payload []byte) (*evmtypes.ResultData, error) {
+	csdb := evmtypes.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	contract := suite.contract()
+	st := evmtypes.StateTransition{
+		AccountNonce: 0,
+		Price:        big.NewInt(1),
+		GasLimit:     1000000,
+		Recipient:    &contract,
+		Amount:       big.NewInt(0),
+		Payload:      payload,
+		ChainID:      big.NewInt(1),
+		Csdb:         csdb,
+		TxHash:       &ethcmn.Hash{},
+		Sender:       sender,
+	}
+	res, err := st.TransitionDb(suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), evmtypes.DefaultChainConfig())
+	if err != nil {
+		return nil, err
+	}
+	data, err := evmtypes.DecodeResultData(res.Result.Data)
+	suite.Require().NoError(err)
+	return &data, nil
+}
+
+func (suite *KeeperTestSuite) TestCreateTokenMapping() {
+	// only the owner of the token is allowed to create the mapping
+	_, err := suite.handler(suite.ctx, types.NewMsgCreateTokenMapping(sdk.AccAddress(suite.recipient.Bytes()), testSymbol))
+	suite.Require().Error(err)
+	_, err = suite.handler(suite.ctx, types.NewMsgCreateTokenMapping(suite.owner, "nonexistent"))
+	suite.Require().Error(err)
+
+	suite.createTokenMapping()
+	tm, found := suite.app.Erc20Keeper.GetTokenMapping(suite.ctx, testSymbol)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.contract(), tm.ContractAddress())
+	tm, found = suite.app.Erc20Keeper.GetTokenMappingByContract(suite.ctx, suite.contract())
+	suite.Require().True(found)
+	suite.Require().Equal(testSymbol, tm.Symbol)
+
+	csdb := evmtypes.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	suite.Require().Equal(evmtypes.NativeContractCode, csdb.GetCode(suite.contract()))
+
+	// the token is mapped only once
+	_, err = suite.handler(suite.ctx, types.NewMsgCreateTokenMapping(suite.owner, testSymbol))
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestConvert() {
+	amount := sdk.NewDecCoin(testSymbol, sdk.NewInt(30))
+	_, err := suite.handler(suite.ctx, types.NewMsgConvertNativeToERC20(suite.owner, amount))
+	suite.Require().Error(err)
+
+	suite.createTokenMapping()
+	_, err = suite.handler(suite.ctx, types.NewMsgConvertNativeToERC20(suite.owner, amount))
+	suite.Require().NoError(err)
+	holder := ethcmn.BytesToAddress(suite.owner)
+	suite.Require().Equal(sdk.NewDec(30), suite.app.Erc20Keeper.GetBalance(suite.ctx, suite.contract(), holder))
+	suite.Require().Equal(sdk.NewDec(30), suite.app.Erc20Keeper.GetTotalSupply(suite.ctx, suite.contract()))
+	suite.Require().Equal(sdk.NewDec(70), suite.app.AccountKeeper.GetAccount(suite.ctx, suite.owner).GetCoins().
+		AmountOf(testSymbol))
+	suite.assertInvariants()
+
+	// more than the erc20 balance
+	_, err = suite.handler(suite.ctx, types.NewMsgConvertERC20ToNative(suite.owner,
+		sdk.NewDecCoin(testSymbol, sdk.NewInt(31))))
+	suite.Require().Error(err)
+
+	_, err = suite.handler(suite.ctx, types.NewMsgConvertERC20ToNative(suite.owner,
+		sdk.NewDecCoin(testSymbol, sdk.NewInt(10))))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(20), suite.app.Erc20Keeper.GetBalance(suite.ctx, suite.contract(), holder))
+	suite.Require().Equal(sdk.NewDec(80), suite.app.AccountKeeper.GetAccount(suite.ctx, suite.owner).GetCoins().
+		AmountOf(testSymbol))
+	suite.assertInvariants()
+}
+
+func (suite *KeeperTestSuite) TestContractCall() {
+	suite.createTokenMapping()
+	_, err := suite.handler(suite.ctx, types.NewMsgConvertNativeToERC20(suite.owner,
+		sdk.NewDecCoin(testSymbol, sdk.NewInt(50))))
+	suite.Require().NoError(err)
+	holder := ethcmn.BytesToAddress(suite.owner)
+
+	// query
+	payload, err := suite.abi.Pack("symbol")
+	suite.Require().NoError(err)
+	data, err := suite.transition(holder, payload)
+	suite.Require().NoError(err)
+	res, err := suite.abi.Unpack("symbol", data.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Equal(testSymbol, res[0])
+
+	// transfer emits the Transfer event
+	payload, err = suite.abi.Pack("transfer", suite.recipient, sdk.NewDec(20).BigInt())
+	suite.Require().NoError(err)
+	data, err = suite.transition(holder, payload)
+	suite.Require().NoError(err)
+	suite.Require().Len(data.Logs, 1)
+	suite.Require().Equal(suite.contract(), data.Logs[0].Address)
+	suite.Require().Equal(suite.abi.Events["Transfer"].ID, data.Logs[0].Topics[0])
+	suite.Require().Equal(holder.Hash(), data.Logs[0].Topics[1])
+	suite.Require().Equal(suite.recipient.Hash(), data.Logs[0].Topics[2])
+	suite.Require().Equal(sdk.NewDec(20), suite.app.Erc20Keeper.GetBalance(suite.ctx, suite.contract(), suite.recipient))
+
+	// transfer more than the balance is reverted
+	payload, err = suite.abi.Pack("transfer", suite.recipient, sdk.NewDec(31).BigInt())
+	suite.Require().NoError(err)
+	_, err = suite.transition(holder, payload)
+	suite.Require().Error(err)
+
+	// transferFrom within the allowance
+	payload, err = suite.abi.Pack("approve", suite.recipient, sdk.NewDec(10).BigInt())
+	suite.Require().NoError(err)
+	_, err = suite.transition(holder, payload)
+	suite.Require().NoError(err)
+	payload, err = suite.abi.Pack("transferFrom", holder, suite.recipient, sdk.NewDec(11).BigInt())
+	suite.Require().NoError(err)
+	_, err = suite.transition(suite.recipient, payload)
+	suite.Require().Error(err)
+	payload, err = suite.abi.Pack("transferFrom", holder, suite.recipient, sdk.NewDec(10).BigInt())
+	suite.Require().NoError(err)
+	_, err = suite.transition(suite.recipient, payload)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(20), suite.app.Erc20Keeper.GetBalance(suite.ctx, suite.contract(), holder))
+	suite.Require().Equal(sdk.NewDec(30), suite.app.Erc20Keeper.GetBalance(suite.ctx, suite.contract(), suite.recipient))
+	suite.Require().True(suite.app.Erc20Keeper.GetAllowance(suite.ctx, suite.contract(), holder, suite.recipient).IsZero())
+
+	payload, err = suite.abi.Pack("balanceOf", suite.recipient)
+	suite.Require().NoError(err)
+	data, err = suite.transition(holder, payload)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(30).BigInt(), new(big.Int).SetBytes(data.Ret))
+	suite.assertInvariants()
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/erc20/types"
+)
+
+// NewQuerier creates a new querier for erc20 clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryTokenMapping:
+			return queryTokenMapping(ctx, req, k)
+		case types.QueryTokenMappingByContract:
+			return queryTokenMappingByContract(ctx, req, k)
+		case types.QueryTokenMappings:
+			return queryTokenMappings(ctx, k)
+		case types.QueryBalance:
+			return queryBalance(ctx, req, k)
+		default:
+			return nil, types.ErrUnknownErc20QueryType("failed. unknown erc20 query endpoint")
+		}
+	}
+}
+
+func queryTokenMapping(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenMappingParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	tm, found := k.GetTokenMapping(ctx, params.Symbol)
+	if !found {
+		return nil, types.ErrTokenMappingNotExist(params.Symbol)
+	}
+	return marshalTokenMapping(ctx, k, tm)
+}
+
+func queryTokenMappingByContract(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokenMappingParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+	if !ethcmn.IsHexAddress(params.Contract) {
+		return nil, types.ErrInvalidContract(params.Contract)
+	}
+
+	tm, found := k.GetTokenMappingByContract(ctx, ethcmn.HexToAddress(params.Contract))
+	if !found {
+		return nil, types.ErrTokenMappingNotExist(params.Contract)
+	}
+	return marshalTokenMapping(ctx, k, tm)
+}
+
+func queryTokenMappings(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	tms := k.GetTokenMappings(ctx)
+	resp := make([]types.TokenMappingResponse, 0, len(tms))
+	for _, tm := range tms {
+		resp = append(resp, types.NewTokenMappingResponse(tm, k.GetTotalSupply(ctx, tm.ContractAddress())))
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, resp)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return res, nil
+}
+
+func queryBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryBalanceParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	tm, found := k.GetTokenMapping(ctx, params.Symbol)
+	if !found {
+		return nil, types.ErrTokenMappingNotExist(params.Symbol)
+	}
+	balance := k.GetBalance(ctx, tm.ContractAddress(), ethcmn.BytesToAddress(params.Address))
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, sdk.NewDecCoinFromDec(tm.Symbol, balance))
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return res, nil
+}
+
+func marshalTokenMapping(ctx sdk.Context, k Keeper, tm types.TokenMapping) ([]byte, sdk.Error) {
+	resp := types.NewTokenMappingResponse(tm, k.GetTotalSupply(ctx, tm.ContractAddress()))
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, resp)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+	return res, nil
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+
+	"github.com/okex/okexchain/x/erc20/types"
+)
+
+// CreateTokenMapping deploys the erc20 contract of the native token and maps the token to it
+func (k Keeper) CreateTokenMapping(ctx sdk.Context, symbol string) (types.TokenMapping, sdk.Error) {
+	if sdkErr := k.CheckTokenMapping(ctx, symbol); sdkErr != nil {
+		return types.TokenMapping{}, sdkErr
+	}
+
+	tm := types.NewTokenMapping(symbol, types.ContractAddress(symbol))
+	if sdkErr := k.DeployContract(ctx, tm); sdkErr != nil {
+		return types.TokenMapping{}, sdkErr
+	}
+	k.SetTokenMapping(ctx, tm)
+	return tm, nil
+}
+
+// DeployContract deploys the erc20 contract of the token mapping in evm
+func (k Keeper) DeployContract(ctx sdk.Context, tm types.TokenMapping) sdk.Error {
+	if err := k.evmKeeper.DeployNativeContract(ctx, tm.ContractAddress()); err != nil {
+		return types.ErrDeployContractFailed(err.Error())
+	}
+	return nil
+}
+
+// CheckTokenMapping checks whether the native token can be mapped to a new erc20 contract
+func (k Keeper) CheckTokenMapping(ctx sdk.Context, symbol string) sdk.Error {
+	if !k.tokenKeeper.TokenExist(ctx, symbol) {
+		return types.ErrTokenNotExist(symbol)
+	}
+	if _, found := k.GetTokenMapping(ctx, symbol); found {
+		return types.ErrTokenMappingAlreadyExist(symbol)
+	}
+	return nil
+}
+
+// SetTokenMapping sets the token mapping into the store
+func (k Keeper) SetTokenMapping(ctx sdk.Context, tm types.TokenMapping) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenMappingKey(tm.Symbol), k.cdc.MustMarshalBinaryLengthPrefixed(tm))
+	store.Set(types.GetContractKey(tm.ContractAddress()), []byte(tm.Symbol))
+}
+
+// GetTokenMapping gets the token mapping of the symbol
+func (k Keeper) GetTokenMapping(ctx sdk.Context, symbol string) (tm types.TokenMapping, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTokenMappingKey(symbol))
+	if bz == nil {
+		return tm, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &tm)
+	return tm, true
+}
+
+// GetTokenMappingByContract gets the token mapping of the erc20 contract
+func (k Keeper) GetTokenMappingByContract(ctx sdk.Context, contract ethcmn.Address) (types.TokenMapping, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractKey(contract))
+	if bz == nil {
+		return types.TokenMapping{}, false
+	}
+	return k.GetTokenMapping(ctx, string(bz))
+}
+
+// GetTokenMappings gets all the token mappings
+func (k Keeper) GetTokenMappings(ctx sdk.Context) (tms types.TokenMappings) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.TokenMappingPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tm types.TokenMapping
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &tm)
+		tms = append(tms, tm)
+	}
+	return
+}
+
+// TokenExist returns true if the native token exists
+func (k Keeper) TokenExist(ctx sdk.Context, symbol string) bool {
+	return k.tokenKeeper.TokenExist(ctx, symbol)
+}
+
+// GetTokenOwner returns the owner of the native token
+func (k Keeper) GetTokenOwner(ctx sdk.Context, symbol string) sdk.AccAddress {
+	return k.tokenKeeper.GetTokenInfo(ctx, symbol).Owner
+}
@@ -0,0 +1,40 @@
+package erc20
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/erc20/types"
+	govTypes "github.com/okex/okexchain/x/gov/types"
+)
+
+// NewTokenMappingProposalHandler handles "gov" type message in "erc20"
+func NewTokenMappingProposalHandler(k *Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, proposal *govTypes.Proposal) (err sdk.Error) {
+		switch content := proposal.Content.(type) {
+		case types.TokenMappingProposal:
+			return handleTokenMappingProposal(ctx, k, proposal)
+		default:
+			return common.ErrUnknownProposalType(DefaultCodespace, content.ProposalType())
+		}
+	}
+}
+
+func handleTokenMappingProposal(ctx sdk.Context, k *Keeper, proposal *govTypes.Proposal) sdk.Error {
+	tokenMappingProposal, ok := proposal.Content.(types.TokenMappingProposal)
+	if !ok {
+		return types.ErrUnexpectedProposalType(proposal.Content.ProposalType())
+	}
+
+	tm, sdkErr := k.CreateTokenMapping(ctx, tokenMappingProposal.Symbol)
+	if sdkErr != nil {
+		return sdkErr
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateTokenMapping,
+		sdk.NewAttribute(types.AttributeKeySymbol, tm.Symbol),
+		sdk.NewAttribute(types.AttributeKeyContract, tm.Contract),
+	))
+	return nil
+}
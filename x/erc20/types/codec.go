@@ -0,0 +1,23 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers concrete types on codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCreateTokenMapping{}, "okexchain/erc20/MsgCreateTokenMapping", nil)
+	cdc.RegisterConcrete(MsgConvertNativeToERC20{}, "okexchain/erc20/MsgConvertNativeToERC20", nil)
+	cdc.RegisterConcrete(MsgConvertERC20ToNative{}, "okexchain/erc20/MsgConvertERC20ToNative", nil)
+	cdc.RegisterConcrete(TokenMappingProposal{}, "okexchain/erc20/TokenMappingProposal", nil)
+}
+
+// ModuleCdc defines the module codec
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	DefaultCodespace string = ModuleName
+
+	CodeInvalidSymbol              uint32 = 69000
+	CodeInvalidContract            uint32 = 69001
+	CodeTokenNotExist              uint32 = 69002
+	CodeTokenMappingAlreadyExist   uint32 = 69003
+	CodeTokenMappingNotExist       uint32 = 69004
+	CodeInvalidTokenOwner          uint32 = 69005
+	CodeNilAddress                 uint32 = 69006
+	CodeInvalidAmount              uint32 = 69007
+	CodeInsufficientBalance        uint32 = 69008
+	CodeInsufficientAllowance      uint32 = 69009
+	CodeUnknownErc20MsgType        uint32 = 69010
+	CodeUnknownErc20QueryType      uint32 = 69011
+	CodeUnexpectedProposalType     uint32 = 69012
+	CodeDeployContractFailed       uint32 = 69013
+	CodeSendCoinsFailed            uint32 = 69014
+	CodeInvalidGenesisTokenMapping uint32 = 69015
+)
+
+// ErrInvalidSymbol returns an error when the symbol of a token is invalid
+func ErrInvalidSymbol(symbol string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidSymbol,
+		fmt.Sprintf("failed. invalid token symbol: %s", symbol))}
+}
+
+// ErrInvalidContract returns an error when the address of an erc20 contract is invalid
+func ErrInvalidContract(contract string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidContract,
+		fmt.Sprintf("failed. invalid erc20 contract address: %s", contract))}
+}
+
+// ErrTokenNotExist returns an error when the token doesn't exist
+func ErrTokenNotExist(symbol string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeTokenNotExist,
+		fmt.Sprintf("failed. token %s does not exist", symbol))}
+}
+
+// ErrTokenMappingAlreadyExist returns an error when the token has been mapped to an erc20 contract
+func ErrTokenMappingAlreadyExist(symbol string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeTokenMappingAlreadyExist,
+		fmt.Sprintf("failed. token %s has been mapped to an erc20 contract", symbol))}
+}
+
+// ErrTokenMappingNotExist returns an error when the token hasn't been mapped to an erc20 contract
+func ErrTokenMappingNotExist(symbolOrContract string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeTokenMappingNotExist,
+		fmt.Sprintf("failed. token mapping of %s does not exist", symbolOrContract))}
+}
+
+// ErrInvalidTokenOwner returns an error when the sender isn't the owner of the token
+func ErrInvalidTokenOwner(addr sdk.AccAddress, symbol string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidTokenOwner,
+		fmt.Sprintf("failed. %s is not the owner of token %s", addr, symbol))}
+}
+
+// ErrNilAddress returns an error when an empty address appears
+func ErrNilAddress() sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeNilAddress, "failed. address is nil")}
+}
+
+// ErrInvalidAmount returns an error when an amount is invalid
+func ErrInvalidAmount(amount string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidAmount,
+		fmt.Sprintf("failed. invalid amount: %s", amount))}
+}
+
+// ErrInsufficientBalance returns an error when the erc20 balance is less than the amount
+func ErrInsufficientBalance(balance, amount string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInsufficientBalance,
+		fmt.Sprintf("failed. insufficient erc20 balance: %s is less than %s", balance, amount))}
+}
+
+// ErrInsufficientAllowance returns an error when the erc20 allowance is less than the amount
+func ErrInsufficientAllowance(allowance, amount string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInsufficientAllowance,
+		fmt.Sprintf("failed. insufficient erc20 allowance: %s is less than %s", allowance, amount))}
+}
+
+// ErrUnknownErc20MsgType returns an error when the msg type is unknown
+func ErrUnknownErc20MsgType(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnknownErc20MsgType, msg)}
+}
+
+// ErrUnknownErc20QueryType returns an error when the query endpoint is unknown
+func ErrUnknownErc20QueryType(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnknownErc20QueryType, msg)}
+}
+
+// ErrUnexpectedProposalType returns an error when the proposal type is not supported in erc20 module
+func ErrUnexpectedProposalType(proposalType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeUnexpectedProposalType,
+		fmt.Sprintf("failed. unexpected proposal type: %s", proposalType))}
+}
+
+// ErrDeployContractFailed returns an error when the erc20 contract can't be deployed
+func ErrDeployContractFailed(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeDeployContractFailed,
+		fmt.Sprintf("failed. deploy erc20 contract: %s", msg))}
+}
+
+// ErrSendCoinsFailed returns an error when the native coins can't be locked or unlocked
+func ErrSendCoinsFailed(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeSendCoinsFailed,
+		fmt.Sprintf("failed. send coins: %s", msg))}
+}
+
+// ErrInvalidGenesisTokenMapping returns an error when the genesis data refers to a token mapping which doesn't exist
+func ErrInvalidGenesisTokenMapping(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.New(DefaultCodespace, CodeInvalidGenesisTokenMapping,
+		fmt.Sprintf("failed. invalid genesis token mapping: %s", msg))}
+}
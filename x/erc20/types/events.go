@@ -0,0 +1,15 @@
+package types
+
+// erc20 module event types
+const (
+	EventTypeCreateTokenMapping   = "create-token-mapping"
+	EventTypeConvertNativeToERC20 = "convert-native-to-erc20"
+	EventTypeConvertERC20ToNative = "convert-erc20-to-native"
+
+	AttributeKeySymbol   = "symbol"
+	AttributeKeyContract = "contract"
+	AttributeKeyAddress  = "address"
+	AttributeKeyAmount   = "amount"
+
+	AttributeValueCategory = ModuleName
+)
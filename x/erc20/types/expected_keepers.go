@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply/exported"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+
+	govtypes "github.com/okex/okexchain/x/gov/types"
+	tokentypes "github.com/okex/okexchain/x/token/types"
+)
+
+// SupplyKeeper defines the expected supply keeper
+type SupplyKeeper interface {
+	GetModuleAccount(ctx sdk.Context, moduleName string) exported.ModuleAccountI
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string,
+		amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress,
+		amt sdk.Coins) error
+}
+
+// TokenKeeper defines the expected token keeper
+type TokenKeeper interface {
+	TokenExist(ctx sdk.Context, symbol string) bool
+	GetTokenInfo(ctx sdk.Context, symbol string) tokentypes.Token
+}
+
+// EvmKeeper defines the expected evm keeper
+type EvmKeeper interface {
+	DeployNativeContract(ctx sdk.Context, addr ethcmn.Address) error
+}
+
+// GovKeeper defines the expected gov Keeper
+type GovKeeper interface {
+	GetDepositParams(ctx sdk.Context) govtypes.DepositParams
+	GetVotingParams(ctx sdk.Context) govtypes.VotingParams
+}
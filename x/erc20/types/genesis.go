@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Balance is the erc20 balance of an address, used for import / export via genesis json
+type Balance struct {
+	Symbol  string         `json:"symbol" yaml:"symbol"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Amount  sdk.Dec        `json:"amount" yaml:"amount"`
+}
+
+// Allowance is the amount which spender is allowed to transfer from owner, used for import / export via genesis json
+type Allowance struct {
+	Symbol  string         `json:"symbol" yaml:"symbol"`
+	Owner   sdk.AccAddress `json:"owner" yaml:"owner"`
+	Spender sdk.AccAddress `json:"spender" yaml:"spender"`
+	Amount  sdk.Dec        `json:"amount" yaml:"amount"`
+}
+
+// GenesisState - all erc20 state that must be provided at genesis
+type GenesisState struct {
+	TokenMappings TokenMappings `json:"token_mappings" yaml:"token_mappings"`
+	Balances      []Balance     `json:"balances" yaml:"balances"`
+	Allowances    []Allowance   `json:"allowances" yaml:"allowances"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(tokenMappings TokenMappings, balances []Balance, allowances []Allowance) GenesisState {
+	return GenesisState{
+		TokenMappings: tokenMappings,
+		Balances:      balances,
+		Allowances:    allowances,
+	}
+}
+
+// DefaultGenesisState - default GenesisState used by Cosmos Hub
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		TokenMappings: TokenMappings{},
+		Balances:      []Balance{},
+		Allowances:    []Allowance{},
+	}
+}
+
+// ValidateGenesis validates the erc20 genesis parameters
+func ValidateGenesis(data GenesisState) error {
+	symbols := make(map[string]bool, len(data.TokenMappings))
+	for _, tm := range data.TokenMappings {
+		if err := tm.ValidateBasic(); err != nil {
+			return err
+		}
+		if symbols[tm.Symbol] {
+			return ErrInvalidGenesisTokenMapping(fmt.Sprintf("duplicated token mapping of %s", tm.Symbol))
+		}
+		if tm.ContractAddress() != ContractAddress(tm.Symbol) {
+			return ErrInvalidGenesisTokenMapping(fmt.Sprintf("unexpected contract %s of %s", tm.Contract, tm.Symbol))
+		}
+		symbols[tm.Symbol] = true
+	}
+
+	for _, balance := range data.Balances {
+		if !symbols[balance.Symbol] {
+			return ErrInvalidGenesisTokenMapping(fmt.Sprintf("balance of %s", balance.Symbol))
+		}
+		if balance.Address.Empty() {
+			return ErrNilAddress()
+		}
+		if balance.Amount.IsNil() || !balance.Amount.IsPositive() {
+			return ErrInvalidAmount(balance.Amount.String())
+		}
+	}
+
+	for _, allowance := range data.Allowances {
+		if !symbols[allowance.Symbol] {
+			return ErrInvalidGenesisTokenMapping(fmt.Sprintf("allowance of %s", allowance.Symbol))
+		}
+		if allowance.Owner.Empty() || allowance.Spender.Empty() {
+			return ErrNilAddress()
+		}
+		if allowance.Amount.IsNil() || !allowance.Amount.IsPositive() {
+			return ErrInvalidAmount(allowance.Amount.String())
+		}
+	}
+	return nil
+}
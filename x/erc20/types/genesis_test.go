@@ -0,0 +1,41 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	tm := NewTokenMapping("xxb", ContractAddress("xxb"))
+	owner := sdk.AccAddress{0x1}
+	tests := []struct {
+		name    string
+		genesis GenesisState
+		expErr  bool
+	}{
+		{"default", DefaultGenesisState(), false},
+		{"valid", NewGenesisState(TokenMappings{tm}, []Balance{{"xxb", owner, sdk.OneDec()}},
+			[]Allowance{{"xxb", owner, sdk.AccAddress{0x2}, sdk.OneDec()}}), false},
+		{"duplicated token mapping", NewGenesisState(TokenMappings{tm, tm}, nil, nil), true},
+		{"unexpected contract", NewGenesisState(TokenMappings{NewTokenMapping("xxb",
+			ethcmn.BytesToAddress([]byte{0x1}))}, nil, nil), true},
+		{"balance without mapping", NewGenesisState(nil, []Balance{{"xxb", owner, sdk.OneDec()}}, nil), true},
+		{"zero balance", NewGenesisState(TokenMappings{tm}, []Balance{{"xxb", owner, sdk.ZeroDec()}}, nil), true},
+		{"allowance without spender", NewGenesisState(TokenMappings{tm}, nil,
+			[]Allowance{{"xxb", owner, nil, sdk.OneDec()}}), true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateGenesis(test.genesis)
+			if test.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "erc20"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for routing msgs
+	RouterKey = ModuleName
+
+	// QuerierRoute to be used for querier msgs
+	QuerierRoute = ModuleName
+)
+
+var (
+	TokenMappingPrefix = []byte{0x01}
+	ContractPrefix     = []byte{0x02}
+	TotalSupplyPrefix  = []byte{0x03}
+	BalancePrefix      = []byte{0x04}
+	AllowancePrefix    = []byte{0x05}
+)
+
+// GetTokenMappingKey returns the key of the token mapping of symbol
+func GetTokenMappingKey(symbol string) []byte {
+	return append(TokenMappingPrefix, []byte(symbol)...)
+}
+
+// GetContractKey returns the key of the symbol mapped to the erc20 contract
+func GetContractKey(contract ethcmn.Address) []byte {
+	return append(ContractPrefix, contract.Bytes()...)
+}
+
+// GetTotalSupplyKey returns the key of the total supply of the erc20 contract
+func GetTotalSupplyKey(contract ethcmn.Address) []byte {
+	return append(TotalSupplyPrefix, contract.Bytes()...)
+}
+
+// GetBalancesPrefix returns the prefix of the balances of the erc20 contract
+func GetBalancesPrefix(contract ethcmn.Address) []byte {
+	return append(BalancePrefix, contract.Bytes()...)
+}
+
+// GetBalanceKey returns the key of the balance of holder in the erc20 contract
+func GetBalanceKey(contract, holder ethcmn.Address) []byte {
+	return append(GetBalancesPrefix(contract), holder.Bytes()...)
+}
+
+// GetAllowancesPrefix returns the prefix of the allowances of the erc20 contract
+func GetAllowancesPrefix(contract ethcmn.Address) []byte {
+	return append(AllowancePrefix, contract.Bytes()...)
+}
+
+// GetAllowanceKey returns the key of the amount which spender is allowed to transfer from owner in the erc20 contract
+func GetAllowanceKey(contract, owner, spender ethcmn.Address) []byte {
+	return append(append(GetAllowancesPrefix(contract), owner.Bytes()...), spender.Bytes()...)
+}
+
+// SplitBalanceKey returns the holder of the balance key without prefix and contract
+func SplitBalanceKey(key []byte) ethcmn.Address {
+	return ethcmn.BytesToAddress(key[1+ethcmn.AddressLength:])
+}
+
+// SplitAllowanceKey returns the owner and spender of the allowance key without prefix and contract
+func SplitAllowanceKey(key []byte) (owner, spender ethcmn.Address) {
+	key = key[1+ethcmn.AddressLength:]
+	return ethcmn.BytesToAddress(key[:ethcmn.AddressLength]), ethcmn.BytesToAddress(key[ethcmn.AddressLength:])
+}
+
+// ContractAddress returns the address of the erc20 contract deployed for the token symbol
+func ContractAddress(symbol string) ethcmn.Address {
+	return ethcmn.BytesToAddress(ethcrypto.Keccak256([]byte(ModuleName), []byte(symbol)))
+}
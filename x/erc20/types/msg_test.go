@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateTokenMapping(t *testing.T) {
+	tests := []struct {
+		owner  sdk.AccAddress
+		symbol string
+		expErr bool
+	}{
+		{sdk.AccAddress{0x1}, "xxb", false},
+		{nil, "xxb", true},
+		{sdk.AccAddress{0x1}, "", true},
+		{sdk.AccAddress{0x1}, "X!", true},
+	}
+
+	for _, test := range tests {
+		msg := NewMsgCreateTokenMapping(test.owner, test.symbol)
+		require.Equal(t, createTokenMappingMsgType, msg.Type())
+		require.Equal(t, RouterKey, msg.Route())
+		require.Equal(t, []sdk.AccAddress{test.owner}, msg.GetSigners())
+		require.Equal(t, sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg)), msg.GetSignBytes())
+		if test.expErr {
+			require.Error(t, msg.ValidateBasic())
+		} else {
+			require.NoError(t, msg.ValidateBasic())
+		}
+	}
+}
+
+func TestMsgConvert(t *testing.T) {
+	negAmount := sdk.NewDecCoinFromDec("xxb", sdk.ZeroDec())
+	negAmount.Amount = sdk.NewDec(-1)
+	tests := []struct {
+		sender sdk.AccAddress
+		amount sdk.SysCoin
+		expErr bool
+	}{
+		{sdk.AccAddress{0x1}, sdk.NewDecCoinFromDec("xxb", sdk.OneDec()), false},
+		{nil, sdk.NewDecCoinFromDec("xxb", sdk.OneDec()), true},
+		{sdk.AccAddress{0x1}, sdk.NewDecCoinFromDec("xxb", sdk.ZeroDec()), true},
+		{sdk.AccAddress{0x1}, negAmount, true},
+	}
+
+	for _, test := range tests {
+		msgs := []sdk.Msg{
+			NewMsgConvertNativeToERC20(test.sender, test.amount),
+			NewMsgConvertERC20ToNative(test.sender, test.amount),
+		}
+		for _, msg := range msgs {
+			require.Equal(t, RouterKey, msg.Route())
+			require.Equal(t, []sdk.AccAddress{test.sender}, msg.GetSigners())
+			if test.expErr {
+				require.Error(t, msg.ValidateBasic())
+			} else {
+				require.NoError(t, msg.ValidateBasic())
+			}
+		}
+	}
+}
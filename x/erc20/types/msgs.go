@@ -0,0 +1,138 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	createTokenMappingMsgType   = "create_token_mapping"
+	convertNativeToERC20MsgType = "convert_native_to_erc20"
+	convertERC20ToNativeMsgType = "convert_erc20_to_native"
+)
+
+// MsgCreateTokenMapping is the msg for the owner of a token to map it to a new erc20 contract
+type MsgCreateTokenMapping struct {
+	Owner  sdk.AccAddress `json:"owner" yaml:"owner"`
+	Symbol string         `json:"symbol" yaml:"symbol"`
+}
+
+var _ sdk.Msg = MsgCreateTokenMapping{}
+
+// NewMsgCreateTokenMapping creates a new instance of MsgCreateTokenMapping
+func NewMsgCreateTokenMapping(owner sdk.AccAddress, symbol string) MsgCreateTokenMapping {
+	return MsgCreateTokenMapping{
+		Owner:  owner,
+		Symbol: symbol,
+	}
+}
+
+func (m MsgCreateTokenMapping) Route() string {
+	return RouterKey
+}
+
+func (m MsgCreateTokenMapping) Type() string {
+	return createTokenMappingMsgType
+}
+
+func (m MsgCreateTokenMapping) ValidateBasic() sdk.Error {
+	if m.Owner.Empty() {
+		return ErrNilAddress()
+	}
+	if err := sdk.ValidateDenom(m.Symbol); err != nil {
+		return ErrInvalidSymbol(m.Symbol)
+	}
+	return nil
+}
+
+func (m MsgCreateTokenMapping) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgCreateTokenMapping) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Owner}
+}
+
+// MsgConvertNativeToERC20 is the msg to convert the native coins of sender into the erc20 tokens of the same address
+type MsgConvertNativeToERC20 struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Amount sdk.SysCoin    `json:"amount" yaml:"amount"`
+}
+
+var _ sdk.Msg = MsgConvertNativeToERC20{}
+
+// NewMsgConvertNativeToERC20 creates a new instance of MsgConvertNativeToERC20
+func NewMsgConvertNativeToERC20(sender sdk.AccAddress, amount sdk.SysCoin) MsgConvertNativeToERC20 {
+	return MsgConvertNativeToERC20{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+func (m MsgConvertNativeToERC20) Route() string {
+	return RouterKey
+}
+
+func (m MsgConvertNativeToERC20) Type() string {
+	return convertNativeToERC20MsgType
+}
+
+func (m MsgConvertNativeToERC20) ValidateBasic() sdk.Error {
+	return validateConvertMsg(m.Sender, m.Amount)
+}
+
+func (m MsgConvertNativeToERC20) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgConvertNativeToERC20) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+// MsgConvertERC20ToNative is the msg to convert the erc20 tokens of sender back into the native coins
+type MsgConvertERC20ToNative struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Amount sdk.SysCoin    `json:"amount" yaml:"amount"`
+}
+
+var _ sdk.Msg = MsgConvertERC20ToNative{}
+
+// NewMsgConvertERC20ToNative creates a new instance of MsgConvertERC20ToNative
+func NewMsgConvertERC20ToNative(sender sdk.AccAddress, amount sdk.SysCoin) MsgConvertERC20ToNative {
+	return MsgConvertERC20ToNative{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+func (m MsgConvertERC20ToNative) Route() string {
+	return RouterKey
+}
+
+func (m MsgConvertERC20ToNative) Type() string {
+	return convertERC20ToNativeMsgType
+}
+
+func (m MsgConvertERC20ToNative) ValidateBasic() sdk.Error {
+	return validateConvertMsg(m.Sender, m.Amount)
+}
+
+func (m MsgConvertERC20ToNative) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgConvertERC20ToNative) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Sender}
+}
+
+func validateConvertMsg(sender sdk.AccAddress, amount sdk.SysCoin) sdk.Error {
+	if sender.Empty() {
+		return ErrNilAddress()
+	}
+	if !amount.IsValid() || !amount.IsPositive() {
+		return ErrInvalidAmount(amount.String())
+	}
+	return nil
+}
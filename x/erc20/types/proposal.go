@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/okex/okexchain/x/gov/types"
+)
+
+const (
+	// proposalTypeTokenMapping defines the type for a TokenMappingProposal
+	proposalTypeTokenMapping = "TokenMapping"
+)
+
+func init() {
+	govtypes.RegisterProposalType(proposalTypeTokenMapping)
+	govtypes.RegisterProposalTypeCodec(TokenMappingProposal{}, "okexchain/erc20/TokenMappingProposal")
+}
+
+var _ govtypes.Content = (*TokenMappingProposal)(nil)
+
+// TokenMappingProposal - structure for the proposal to map a native token to a new erc20 contract
+type TokenMappingProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Symbol      string `json:"symbol" yaml:"symbol"`
+}
+
+// NewTokenMappingProposal creates a new instance of TokenMappingProposal
+func NewTokenMappingProposal(title, description, symbol string) TokenMappingProposal {
+	return TokenMappingProposal{
+		Title:       title,
+		Description: description,
+		Symbol:      symbol,
+	}
+}
+
+// GetTitle returns title of a token mapping proposal object
+func (tp TokenMappingProposal) GetTitle() string {
+	return tp.Title
+}
+
+// GetDescription returns description of a token mapping proposal object
+func (tp TokenMappingProposal) GetDescription() string {
+	return tp.Description
+}
+
+// ProposalRoute returns route key of a token mapping proposal object
+func (tp TokenMappingProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a token mapping proposal object
+func (tp TokenMappingProposal) ProposalType() string {
+	return proposalTypeTokenMapping
+}
+
+// ValidateBasic validates a token mapping proposal
+func (tp TokenMappingProposal) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(tp.Title)) == 0 {
+		return govtypes.ErrInvalidProposalContent("title is required")
+	}
+	if len(tp.Title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent("title length is longer than the maximum title length")
+	}
+
+	if len(tp.Description) == 0 {
+		return govtypes.ErrInvalidProposalContent("description is required")
+	}
+
+	if len(tp.Description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent("description length is longer than the maximum description length")
+	}
+
+	if tp.ProposalType() != proposalTypeTokenMapping {
+		return govtypes.ErrInvalidProposalType(tp.ProposalType())
+	}
+
+	if err := sdk.ValidateDenom(tp.Symbol); err != nil {
+		return govtypes.ErrInvalidProposalContent(fmt.Sprintf("invalid token symbol: %s", tp.Symbol))
+	}
+
+	return nil
+}
+
+// String returns a human readable string representation of a TokenMappingProposal
+func (tp TokenMappingProposal) String() string {
+	return fmt.Sprintf(`TokenMappingProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ Symbol:				%s`,
+		tp.Title, tp.Description, tp.ProposalType(), tp.Symbol)
+}
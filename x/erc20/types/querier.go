@@ -0,0 +1,55 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const (
+	QueryTokenMapping           = "token-mapping"
+	QueryTokenMappingByContract = "token-mapping-by-contract"
+	QueryTokenMappings          = "token-mappings"
+	QueryBalance                = "balance"
+)
+
+// QueryTokenMappingParams defines the params for the following queries:
+// - 'custom/erc20/token-mapping'
+// - 'custom/erc20/token-mapping-by-contract'
+type QueryTokenMappingParams struct {
+	Symbol   string
+	Contract string
+}
+
+// NewQueryTokenMappingParams creates a new instance of QueryTokenMappingParams
+func NewQueryTokenMappingParams(symbol, contract string) QueryTokenMappingParams {
+	return QueryTokenMappingParams{
+		Symbol:   symbol,
+		Contract: contract,
+	}
+}
+
+// QueryBalanceParams defines the params for the following queries:
+// - 'custom/erc20/balance'
+type QueryBalanceParams struct {
+	Symbol  string
+	Address sdk.AccAddress
+}
+
+// NewQueryBalanceParams creates a new instance of QueryBalanceParams
+func NewQueryBalanceParams(symbol string, addr sdk.AccAddress) QueryBalanceParams {
+	return QueryBalanceParams{
+		Symbol:  symbol,
+		Address: addr,
+	}
+}
+
+// TokenMappingResponse is the response of the token mapping queries with the total supply of the erc20 contract
+type TokenMappingResponse struct {
+	TokenMapping
+	TotalSupply sdk.Dec `json:"total_supply" yaml:"total_supply"`
+}
+
+// NewTokenMappingResponse creates a new instance of TokenMappingResponse
+func NewTokenMappingResponse(tm TokenMapping, totalSupply sdk.Dec) TokenMappingResponse {
+	return TokenMappingResponse{
+		TokenMapping: tm,
+		TotalSupply:  totalSupply,
+	}
+}
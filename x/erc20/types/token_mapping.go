@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+)
+
+// Decimals is the decimals of the erc20 contracts, which is the same as the precision of the native coins
+const Decimals = sdk.Precision
+
+// TokenMapping pairs a native token with the erc20 contract representing it in evm
+type TokenMapping struct {
+	Symbol   string `json:"symbol" yaml:"symbol"`
+	Contract string `json:"contract" yaml:"contract"`
+}
+
+// NewTokenMapping creates a new instance of TokenMapping
+func NewTokenMapping(symbol string, contract ethcmn.Address) TokenMapping {
+	return TokenMapping{
+		Symbol:   symbol,
+		Contract: contract.Hex(),
+	}
+}
+
+// ContractAddress returns the address of the erc20 contract
+func (tm TokenMapping) ContractAddress() ethcmn.Address {
+	return ethcmn.HexToAddress(tm.Contract)
+}
+
+// ValidateBasic validates the symbol and the contract address of the token mapping
+func (tm TokenMapping) ValidateBasic() error {
+	if err := sdk.ValidateDenom(tm.Symbol); err != nil {
+		return ErrInvalidSymbol(tm.Symbol)
+	}
+	if !ethcmn.IsHexAddress(tm.Contract) {
+		return ErrInvalidContract(tm.Contract)
+	}
+	return nil
+}
+
+// String returns a human readable string representation of TokenMapping
+func (tm TokenMapping) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Symbol:   %s
+Contract: %s`, tm.Symbol, tm.Contract))
+}
+
+// TokenMappings is the type alias for []TokenMapping
+type TokenMappings []TokenMapping
+
+// String returns a human readable string representation of TokenMappings
+func (tms TokenMappings) String() string {
+	var b strings.Builder
+	b.WriteString("Token Mappings:")
+	for _, tm := range tms {
+		b.WriteString(fmt.Sprintf("\n\t%s: %s", tm.Symbol, tm.Contract))
+	}
+	return b.String()
+}
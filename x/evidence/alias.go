@@ -21,11 +21,17 @@ const (
 	AttributeValueCategory   = types.AttributeValueCategory
 	AttributeKeyEvidenceHash = types.AttributeKeyEvidenceHash
 	DefaultMaxEvidenceAge    = types.DefaultMaxEvidenceAge
+	RouteLightClientAttack   = types.RouteLightClientAttack
+	TypeLightClientAttack    = types.TypeLightClientAttack
+	RouteOracleEquivocation  = types.RouteOracleEquivocation
+	TypeOracleEquivocation   = types.TypeOracleEquivocation
 )
 
 var (
-	NewKeeper  = keeper.NewKeeper
-	NewQuerier = keeper.NewQuerier
+	NewKeeper                    = keeper.NewKeeper
+	NewQuerier                   = keeper.NewQuerier
+	NewLightClientAttackHandler  = keeper.NewLightClientAttackHandler
+	NewOracleEquivocationHandler = keeper.NewOracleEquivocationHandler
 
 	NewMsgSubmitEvidence         = types.NewMsgSubmitEvidence
 	NewRouter                    = types.NewRouter
@@ -40,14 +46,20 @@ var (
 	KeyMaxEvidenceAge            = types.KeyMaxEvidenceAge
 	DoubleSignJailEndTime        = types.DoubleSignJailEndTime
 	ParamKeyTable                = types.ParamKeyTable
+	NewLightClientAttack         = types.NewLightClientAttack
+	NewOracleEquivocation        = types.NewOracleEquivocation
+	NewPriceAttestation          = types.NewPriceAttestation
 )
 
 type (
 	Keeper = keeper.Keeper
 
-	GenesisState      = types.GenesisState
-	MsgSubmitEvidence = types.MsgSubmitEvidence
-	Handler           = types.Handler
-	Router            = types.Router
-	Equivocation      = types.Equivocation
+	GenesisState       = types.GenesisState
+	MsgSubmitEvidence  = types.MsgSubmitEvidence
+	Handler            = types.Handler
+	Router             = types.Router
+	Equivocation       = types.Equivocation
+	LightClientAttack  = types.LightClientAttack
+	OracleEquivocation = types.OracleEquivocation
+	PriceAttestation   = types.PriceAttestation
 )
@@ -1,9 +1,17 @@
 package cli
 
 import (
+	"bufio"
+	"io/ioutil"
+
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/okex/okexchain/x/evidence/exported"
 	"github.com/okex/okexchain/x/evidence/internal/types"
 
 	"github.com/spf13/cobra"
@@ -28,7 +36,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec, childCmds []*cobra.Command) *co
 		submitEvidenceCmd.AddCommand(flags.PostCommands(childCmd)[0])
 	}
 
-	// TODO: Add tx commands.
+	cmd.AddCommand(submitEvidenceCmd)
 
 	return cmd
 }
@@ -42,5 +50,93 @@ func SubmitEvidenceCmd(cdc *codec.Codec) *cobra.Command {
 		Short: "Submit arbitrary evidence of misbehavior",
 	}
 
+	cmd.AddCommand(flags.PostCommands(
+		GetCmdSubmitLightClientAttack(cdc),
+		GetCmdSubmitOracleEquivocation(cdc),
+	)...)
+
 	return cmd
 }
+
+// GetCmdSubmitLightClientAttack implements the command to submit the evidence of conflicting signed headers.
+func GetCmdSubmitLightClientAttack(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "light-client-attack [evidence-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit the conflicting signed headers of a validator",
+		Long: `Submit the evidence of a validator signing two conflicting headers at the same height and round. The
+submitter is set to the address of --from and is rewarded with a share of the slashed tokens:
+
+$ <appcli> tx evidence submit light-client-attack evidence.json --from mykey
+
+Where evidence.json contains:
+
+{
+  "consensus_address": "okexchainvalcons1...",
+  "conflicting_header_a": {"header": {...}, "commit": {...}},
+  "conflicting_header_b": {"header": {...}, "commit": {...}}
+}
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var evidence types.LightClientAttack
+			return submitEvidenceFromFile(cmd, cdc, args[0], &evidence, func(submitter sdk.AccAddress) exported.Evidence {
+				evidence.Submitter = submitter
+				return evidence
+			})
+		},
+	}
+}
+
+// GetCmdSubmitOracleEquivocation implements the command to submit the evidence of contradicting price attestations.
+func GetCmdSubmitOracleEquivocation(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "oracle-equivocation [evidence-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit the contradicting price attestations of a validator",
+		Long: `Submit the evidence of a validator attesting contradicting prices of an oracle feed in the same round. The
+submitter is set to the address of --from and is rewarded with a share of the slashed tokens:
+
+$ <appcli> tx evidence submit oracle-equivocation evidence.json --from mykey
+
+Where evidence.json contains:
+
+{
+  "consensus_address": "okexchainvalcons1...",
+  "attestation_a": {"feed": "okt_usdt", "round": "12", "price": "17.1", "timestamp": "...", "signature": "..."},
+  "attestation_b": {"feed": "okt_usdt", "round": "12", "price": "19.8", "timestamp": "...", "signature": "..."}
+}
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var evidence types.OracleEquivocation
+			return submitEvidenceFromFile(cmd, cdc, args[0], &evidence, func(submitter sdk.AccAddress) exported.Evidence {
+				evidence.Submitter = submitter
+				return evidence
+			})
+		},
+	}
+}
+
+// submitEvidenceFromFile decodes the evidence from a json file into ptr, and broadcasts it with the submitter set by
+// withSubmitter
+func submitEvidenceFromFile(cmd *cobra.Command, cdc *codec.Codec, path string, ptr interface{},
+	withSubmitter func(sdk.AccAddress) exported.Evidence) error {
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+	cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := cdc.UnmarshalJSON(bz, ptr); err != nil {
+		return err
+	}
+
+	submitter := cliCtx.GetFromAddress()
+	msg := types.NewMsgSubmitEvidence(withSubmitter(submitter), submitter)
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+}
@@ -27,3 +27,12 @@ type Evidence interface {
 	// The total validator set power at time of infraction
 	GetTotalPower() int64
 }
+
+// RewardableEvidence defines the contract of the evidence submitted by a user, who is rewarded with a share of the
+// tokens slashed from the malicious validator once the evidence is handled.
+type RewardableEvidence interface {
+	Evidence
+
+	// The address that submits the evidence and receives the reward
+	GetSubmitter() sdk.AccAddress
+}
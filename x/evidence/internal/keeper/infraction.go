@@ -2,8 +2,13 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/okex/okexchain/x/evidence/exported"
+	stakingexported "github.com/okex/okexchain/x/staking/exported"
+	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/okex/okexchain/x/evidence/internal/types"
 )
@@ -85,20 +90,19 @@ func (k Keeper) HandleDoubleSign(ctx sdk.Context, evidence types.Equivocation) {
 	// -ValidatorUpdateDelay, i.e. at the end of the
 	// pre-genesis block (none) = at the beginning of the genesis block.
 	// That's fine since this is just used to filter unbonding delegations & redelegations.
-	//distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
+	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
 
 	// Slash validator. The `power` is the int64 power of the validator as provided
 	// to/by Tendermint. This value is validator.Tokens as sent to Tendermint via
 	// ABCI, and now received as evidence. The fraction is passed in to separately
 	// to slash unbonding and rebonding delegations.
-	/*
+	slashFraction := k.slashingKeeper.SlashFractionDoubleSign(ctx)
 	k.slashingKeeper.Slash(
 		ctx,
 		consAddr,
-		k.slashingKeeper.SlashFractionDoubleSign(ctx),
+		slashFraction,
 		evidence.GetValidatorPower(), distributionHeight,
 	)
-	*/
 	k.stakingKeeper.AppendAbandonedValidatorAddrs(ctx, consAddr)
 	// Jail the validator if not already jailed. This will begin unbonding the
 	// validator if not already unbonding (tombstoned).
@@ -107,5 +111,150 @@ func (k Keeper) HandleDoubleSign(ctx sdk.Context, evidence types.Equivocation) {
 	}
 
 	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
+	k.slashingKeeper.RecordDoubleSign(ctx, consAddr, infractionHeight, evidence.GetTime(), slashFraction)
 	k.slashingKeeper.Tombstone(ctx, consAddr)
 }
+
+// NewLightClientAttackHandler returns the Evidence Handler of the LightClientAttack type
+func NewLightClientAttackHandler(k Keeper) types.Handler {
+	return func(ctx sdk.Context, e exported.Evidence) error {
+		evidence, ok := e.(types.LightClientAttack)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidEvidence, "unexpected evidence type: %T", e)
+		}
+		return k.HandleLightClientAttack(ctx, evidence)
+	}
+}
+
+// NewOracleEquivocationHandler returns the Evidence Handler of the OracleEquivocation type
+func NewOracleEquivocationHandler(k Keeper) types.Handler {
+	return func(ctx sdk.Context, e exported.Evidence) error {
+		evidence, ok := e.(types.OracleEquivocation)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidEvidence, "unexpected evidence type: %T", e)
+		}
+		return k.HandleOracleEquivocation(ctx, evidence)
+	}
+}
+
+// HandleLightClientAttack handles the evidence of a validator signing two conflicting headers. Once both of the
+// signatures are verified, the validator is slashed, jailed and tombstoned the same way as a double sign, and the
+// submitter is rewarded with a share of the slashed tokens.
+func (k Keeper) HandleLightClientAttack(ctx sdk.Context, evidence types.LightClientAttack) error {
+	consAddr := evidence.GetConsensusAddress()
+	chainID := ctx.ChainID()
+	if evidence.ConflictingHeaderA.ChainID != chainID {
+		return sdkerrors.Wrapf(types.ErrInvalidEvidence, "headers belong to another chain %s, not %s",
+			evidence.ConflictingHeaderA.ChainID, chainID)
+	}
+
+	validator, pubKey, err := k.getPunishableValidator(ctx, consAddr, evidence.GetTime())
+	if err != nil {
+		return err
+	}
+
+	if !types.VerifyCommitSignature(chainID, evidence.ConflictingHeaderA, pubKey) ||
+		!types.VerifyCommitSignature(chainID, evidence.ConflictingHeaderB, pubKey) {
+		return sdkerrors.Wrapf(types.ErrInvalidEvidenceSig, "conflicting headers are not both signed by %s", consAddr)
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("confirmed light client attack from %s at height %d", consAddr, evidence.GetHeight()))
+
+	slashFraction := k.slashingKeeper.SlashFractionDoubleSign(ctx)
+	distributionHeight := evidence.GetHeight() - sdk.ValidatorUpdateDelay
+	k.slashAndReward(ctx, validator, evidence.Submitter, distributionHeight, slashFraction)
+
+	k.stakingKeeper.AppendAbandonedValidatorAddrs(ctx, consAddr)
+	if !validator.IsJailed() {
+		k.slashingKeeper.Jail(ctx, consAddr)
+	}
+
+	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
+	k.slashingKeeper.RecordDoubleSign(ctx, consAddr, evidence.GetHeight(), evidence.GetTime(), slashFraction)
+	k.slashingKeeper.Tombstone(ctx, consAddr)
+	return nil
+}
+
+// HandleOracleEquivocation handles the evidence of a validator attesting contradicting prices of an oracle feed in
+// the same round. Once both of the signatures are verified, the validator is slashed and jailed for the oracle jail
+// duration, and the submitter is rewarded with a share of the slashed tokens. Every feed round of a validator is
+// punished once at most.
+func (k Keeper) HandleOracleEquivocation(ctx sdk.Context, evidence types.OracleEquivocation) error {
+	consAddr := evidence.GetConsensusAddress()
+	validator, pubKey, err := k.getPunishableValidator(ctx, consAddr, evidence.GetTime())
+	if err != nil {
+		return err
+	}
+
+	chainID := ctx.ChainID()
+	if !evidence.AttestationA.Verify(chainID, pubKey) || !evidence.AttestationB.Verify(chainID, pubKey) {
+		return sdkerrors.Wrapf(types.ErrInvalidEvidenceSig, "attestations are not both signed by %s", consAddr)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetOracleEquivocationKey(consAddr, evidence.AttestationA.Feed, evidence.AttestationA.Round)
+	if store.Has(key) {
+		return sdkerrors.Wrapf(types.ErrMisbehaviorPunished, "round %d of feed %s signed by %s",
+			evidence.AttestationA.Round, evidence.AttestationA.Feed, consAddr)
+	}
+	store.Set(key, evidence.Hash())
+
+	k.Logger(ctx).Info(fmt.Sprintf("confirmed oracle equivocation from %s in round %d of feed %s",
+		consAddr, evidence.AttestationA.Round, evidence.AttestationA.Feed))
+
+	slashFraction := k.OracleSlashFraction(ctx)
+	k.slashAndReward(ctx, validator, evidence.Submitter, ctx.BlockHeight(), slashFraction)
+
+	if !validator.IsJailed() {
+		k.stakingKeeper.AppendAbandonedValidatorAddrs(ctx, consAddr)
+		k.slashingKeeper.Jail(ctx, consAddr)
+	}
+
+	k.slashingKeeper.ExtendJail(ctx, consAddr, ctx.BlockHeader().Time.Add(k.OracleJailDuration(ctx)))
+	k.slashingKeeper.RecordOffence(ctx, consAddr, types.OffenceTypeOracleEquivocation, ctx.BlockHeight(),
+		evidence.GetTime(), slashFraction)
+	return nil
+}
+
+// getPunishableValidator returns the validator and its consensus public key if the validator can be punished for
+// the misbehavior committed at the infraction time
+func (k Keeper) getPunishableValidator(ctx sdk.Context, consAddr sdk.ConsAddress, infractionTime time.Time) (
+	stakingexported.ValidatorI, crypto.PubKey, error) {
+	if age := ctx.BlockHeader().Time.Sub(infractionTime); age > k.MaxSubmittedEvidenceAge(ctx) {
+		return nil, nil, sdkerrors.Wrapf(types.ErrEvidenceTooOld, "age of %s past max age of %s",
+			age, k.MaxSubmittedEvidenceAge(ctx))
+	}
+
+	pubKey, err := k.slashingKeeper.GetPubkey(ctx, consAddr.Bytes())
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(types.ErrNoValidatorForEvidence, consAddr.String())
+	}
+
+	validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil || validator.IsUnbonded() || !k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr) {
+		return nil, nil, sdkerrors.Wrap(types.ErrNoValidatorForEvidence, consAddr.String())
+	}
+
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return nil, nil, sdkerrors.Wrapf(types.ErrMisbehaviorPunished, "validator %s is tombstoned", consAddr)
+	}
+
+	return validator, pubKey, nil
+}
+
+// slashAndReward slashes the validator and pays the submitter of the evidence with a share of the slashed tokens
+func (k Keeper) slashAndReward(ctx sdk.Context, validator stakingexported.ValidatorI, submitter sdk.AccAddress,
+	distributionHeight int64, slashFraction sdk.Dec) {
+	consAddr := validator.GetConsAddr()
+	reward := k.stakingKeeper.SlashWithReward(ctx, consAddr, distributionHeight, validator.GetConsensusPower(),
+		slashFraction, submitter, k.SubmitterRewardFraction(ctx))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardSubmitter,
+			sdk.NewAttribute(types.AttributeKeyValidator, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySubmitter, submitter.String()),
+			sdk.NewAttribute(types.AttributeKeyReward, reward.String()),
+		),
+	)
+}
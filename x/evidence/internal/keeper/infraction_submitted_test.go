@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/evidence/internal/types"
+	"github.com/okex/okexchain/x/staking"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+const testChainID = "okexchain-65"
+
+// setupPunishableValidator creates a bonded validator with a known consensus private key
+func (suite *KeeperTestSuite) setupPunishableValidator() (sdk.Context, sdk.ValAddress, crypto.PrivKey) {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(EPOCH).WithBlockTime(time.Now().UTC()).
+		WithChainID(testChainID)
+	suite.populateValidators(ctx)
+
+	operatorAddr, consPrivKey := valAddresses[0], ed25519.GenPrivKey()
+	msg := newTestMsgCreateValidator(operatorAddr, consPrivKey.PubKey(), sdk.NewInt(10000))
+	res, err := staking.NewHandler(suite.app.StakingKeeper)(ctx, msg)
+	suite.NoError(err)
+	suite.NotNil(res)
+
+	staking.EndBlocker(ctx, suite.app.StakingKeeper)
+	suite.app.SlashingKeeper.HandleValidatorSignature(ctx, consPrivKey.PubKey().Address(), 10000, true)
+	return ctx, operatorAddr, consPrivKey
+}
+
+func (suite *KeeperTestSuite) TestHandleLightClientAttack() {
+	ctx, operatorAddr, consPrivKey := suite.setupPunishableValidator()
+	consAddr := sdk.ConsAddress(consPrivKey.PubKey().Address())
+	submitter := sdk.AccAddress(valAddresses[1])
+	balance := suite.app.BankKeeper.GetCoins(ctx, submitter).AmountOf(sdk.DefaultBondDenom)
+	msd := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetMinSelfDelegation()
+
+	headerA := types.TestSignedHeader(consPrivKey, testChainID, EPOCH-1, 0, ctx.BlockTime(), []byte("a"))
+	headerB := types.TestSignedHeader(consPrivKey, testChainID, EPOCH-1, 0, ctx.BlockTime(), []byte("b"))
+
+	// headers signed by another key
+	otherKey := ed25519.GenPrivKey()
+	forged := types.NewLightClientAttack(consAddr, headerA,
+		types.TestSignedHeader(otherKey, testChainID, EPOCH-1, 0, ctx.BlockTime(), []byte("b")), submitter)
+	suite.Error(suite.keeper.HandleLightClientAttack(ctx, forged))
+
+	// headers of another chain
+	otherChain := types.NewLightClientAttack(consAddr,
+		types.TestSignedHeader(consPrivKey, "other", EPOCH-1, 0, ctx.BlockTime(), []byte("a")),
+		types.TestSignedHeader(consPrivKey, "other", EPOCH-1, 0, ctx.BlockTime(), []byte("b")), submitter)
+	suite.Error(suite.keeper.HandleLightClientAttack(ctx, otherChain))
+
+	// submit through the router of the app
+	evidence := types.NewLightClientAttack(consAddr, headerA, headerB, submitter)
+	suite.NoError(suite.app.EvidenceKeeper.SubmitEvidence(ctx, evidence))
+
+	// should be slashed, jailed and tombstoned
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+	slashed := msd.Mul(suite.app.SlashingKeeper.SlashFractionDoubleSign(ctx))
+	suite.Equal(msd.Sub(slashed), suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetMinSelfDelegation())
+
+	// the submitter should be rewarded
+	reward := slashed.Mul(suite.keeper.SubmitterRewardFraction(ctx))
+	suite.True(reward.IsPositive())
+	suite.Equal(balance.Add(reward), suite.app.BankKeeper.GetCoins(ctx, submitter).AmountOf(sdk.DefaultBondDenom))
+
+	signingInfo, found := suite.app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	suite.True(found)
+	suite.Len(signingInfo.Offences, 1)
+
+	// the swapped headers should not be punished again
+	swapped := types.NewLightClientAttack(consAddr, headerB, headerA, submitter)
+	suite.Error(suite.app.EvidenceKeeper.SubmitEvidence(ctx, swapped))
+}
+
+func (suite *KeeperTestSuite) TestHandleOracleEquivocation() {
+	ctx, operatorAddr, consPrivKey := suite.setupPunishableValidator()
+	consAddr := sdk.ConsAddress(consPrivKey.PubKey().Address())
+	submitter := sdk.AccAddress(valAddresses[1])
+	balance := suite.app.BankKeeper.GetCoins(ctx, submitter).AmountOf(sdk.DefaultBondDenom)
+	msd := suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetMinSelfDelegation()
+
+	attestationA := types.TestSignedPriceAttestation(consPrivKey, testChainID, "okt_usdt", 12, sdk.NewDec(17),
+		ctx.BlockTime())
+	attestationB := types.TestSignedPriceAttestation(consPrivKey, testChainID, "okt_usdt", 12, sdk.NewDec(19),
+		ctx.BlockTime())
+
+	// attestation signed by another key
+	forged := types.NewOracleEquivocation(consAddr, attestationA, types.TestSignedPriceAttestation(
+		ed25519.GenPrivKey(), testChainID, "okt_usdt", 12, sdk.NewDec(19), ctx.BlockTime()), submitter)
+	suite.Error(suite.keeper.HandleOracleEquivocation(ctx, forged))
+
+	// too old attestations
+	old := types.NewOracleEquivocation(consAddr,
+		types.TestSignedPriceAttestation(consPrivKey, testChainID, "okt_usdt", 1, sdk.NewDec(17),
+			ctx.BlockTime().Add(-suite.keeper.MaxSubmittedEvidenceAge(ctx)-time.Second)),
+		types.TestSignedPriceAttestation(consPrivKey, testChainID, "okt_usdt", 1, sdk.NewDec(19), ctx.BlockTime()),
+		submitter)
+	suite.Error(suite.keeper.HandleOracleEquivocation(ctx, old))
+
+	// submit through the router of the app
+	evidence := types.NewOracleEquivocation(consAddr, attestationA, attestationB, submitter)
+	suite.NoError(suite.app.EvidenceKeeper.SubmitEvidence(ctx, evidence))
+
+	// should be slashed and jailed for the oracle jail duration, but not tombstoned
+	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.False(suite.app.SlashingKeeper.IsTombstoned(ctx, consAddr))
+	slashed := msd.Mul(suite.keeper.OracleSlashFraction(ctx))
+	suite.Equal(msd.Sub(slashed), suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetMinSelfDelegation())
+
+	signingInfo, found := suite.app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	suite.True(found)
+	suite.True(ctx.BlockTime().Add(suite.keeper.OracleJailDuration(ctx)).Equal(signingInfo.JailedUntil))
+	suite.Len(signingInfo.Offences, 1)
+	suite.Equal(types.OffenceTypeOracleEquivocation, signingInfo.Offences[0].Type)
+
+	// the submitter should be rewarded
+	reward := slashed.Mul(suite.keeper.SubmitterRewardFraction(ctx))
+	suite.True(reward.IsPositive())
+	suite.Equal(balance.Add(reward), suite.app.BankKeeper.GetCoins(ctx, submitter).AmountOf(sdk.DefaultBondDenom))
+
+	// the same feed round should not be punished again
+	swapped := types.NewOracleEquivocation(consAddr, attestationB, attestationA, submitter)
+	suite.Error(suite.app.EvidenceKeeper.SubmitEvidence(ctx, swapped))
+
+	// cannot be unjailed before the end of the oracle jail
+	suite.Error(suite.app.SlashingKeeper.Unjail(ctx, operatorAddr))
+	ctx = ctx.WithBlockTime(signingInfo.JailedUntil)
+	suite.NoError(suite.app.SlashingKeeper.Unjail(ctx, operatorAddr))
+}
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/okex/okexchain/x/evidence/internal/types"
+	"github.com/okex/okexchain/x/slashing"
 	"github.com/okex/okexchain/x/staking"
 	stakingtypes "github.com/okex/okexchain/x/staking/types"
 
@@ -14,10 +15,10 @@ import (
 const EPOCH = 252
 
 func newTestMsgCreateValidator(address sdk.ValAddress, pubKey crypto.PubKey, amt sdk.Int) stakingtypes.MsgCreateValidator {
-	//	commission := staking.NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	commission := staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
 	msd := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(10000))
 	return staking.NewMsgCreateValidator(address, pubKey,
-		staking.NewDescription("my moniker", "my identity", "my website", "my details"), msd,
+		staking.NewDescription("my moniker", "my identity", "my website", "my details"), commission, msd,
 	)
 }
 
@@ -58,6 +59,17 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign() {
 	suite.True(suite.app.StakingKeeper.Validator(ctx, operatorAddr).IsJailed())
 	suite.True(suite.app.SlashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
 
+	// the msd should be slashed and the offence should be recorded
+	slashFraction := suite.app.SlashingKeeper.SlashFractionDoubleSign(ctx)
+	msd := stakingParams.MinSelfDelegation
+	suite.Equal(msd.Sub(msd.Mul(slashFraction)), suite.app.StakingKeeper.Validator(ctx, operatorAddr).GetMinSelfDelegation())
+	signingInfo, found := suite.app.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(val.Address()))
+	suite.True(found)
+	suite.Len(signingInfo.Offences, 1)
+	suite.Equal(slashing.OffenceTypeDoubleSign, signingInfo.Offences[0].Type)
+	suite.Equal(slashFraction, signingInfo.Offences[0].SlashFraction)
+	suite.True(types.DoubleSignJailEndTime.Equal(signingInfo.Offences[0].JailedUntil))
+
 	// submit duplicate evidence
 	suite.keeper.HandleDoubleSign(ctx, evidence)
 
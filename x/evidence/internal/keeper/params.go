@@ -13,6 +13,30 @@ func (k Keeper) MaxEvidenceAge(ctx sdk.Context) (res time.Duration) {
 	return
 }
 
+// MaxSubmittedEvidenceAge returns the maximum age for the evidence submitted by users.
+func (k Keeper) MaxSubmittedEvidenceAge(ctx sdk.Context) (res time.Duration) {
+	k.paramSpace.Get(ctx, types.KeyMaxSubmittedEvidenceAge, &res)
+	return
+}
+
+// OracleSlashFraction returns the fraction slashed for signing contradicting price attestations.
+func (k Keeper) OracleSlashFraction(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyOracleSlashFraction, &res)
+	return
+}
+
+// OracleJailDuration returns the jail duration for signing contradicting price attestations.
+func (k Keeper) OracleJailDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramSpace.Get(ctx, types.KeyOracleJailDuration, &res)
+	return
+}
+
+// SubmitterRewardFraction returns the fraction of the slashed tokens paid to the submitter of the evidence.
+func (k Keeper) SubmitterRewardFraction(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeySubmitterRewardFraction, &res)
+	return
+}
+
 // GetParams returns the total set of evidence parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
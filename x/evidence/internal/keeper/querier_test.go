@@ -91,5 +91,7 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	bz, err := suite.querier(ctx, []string{types.QueryParameters}, abci.RequestQuery{})
 	suite.Nil(err)
 	suite.NotNil(bz)
-	suite.Equal("{\n  \"max_evidence_age\": \"120000000000\"\n}", string(bz))
+	suite.Equal("{\n  \"max_evidence_age\": \"120000000000\",\n  \"max_submitted_evidence_age\": \"604800000000000\",\n"+
+		"  \"oracle_slash_fraction\": \"0.010000000000000000\",\n  \"oracle_jail_duration\": \"86400000000000\",\n"+
+		"  \"submitter_reward_fraction\": \"0.100000000000000000\"\n}", string(bz))
 }
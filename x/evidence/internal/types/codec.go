@@ -15,6 +15,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*exported.Evidence)(nil), nil)
 	cdc.RegisterConcrete(MsgSubmitEvidence{}, "cosmos-sdk/MsgSubmitEvidence", nil)
 	cdc.RegisterConcrete(Equivocation{}, "cosmos-sdk/Equivocation", nil)
+	cdc.RegisterConcrete(LightClientAttack{}, "okexchain/evidence/LightClientAttack", nil)
+	cdc.RegisterConcrete(OracleEquivocation{}, "okexchain/evidence/OracleEquivocation", nil)
 }
 
 // RegisterEvidenceTypeCodec registers an external concrete Evidence type defined
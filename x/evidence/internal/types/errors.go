@@ -14,4 +14,9 @@ var (
 	ErrInvalidEvidence         = sdkerrors.Register(ModuleName, BaseEvidenceError + 2, "invalid evidence")
 	ErrNoEvidenceExists        = sdkerrors.Register(ModuleName, BaseEvidenceError + 3, "evidence does not exist")
 	ErrEvidenceExists          = sdkerrors.Register(ModuleName, BaseEvidenceError + 4, "evidence already exists")
+	ErrEvidenceTooOld          = sdkerrors.Register(ModuleName, BaseEvidenceError + 5, "evidence is too old")
+	ErrNoValidatorForEvidence  = sdkerrors.Register(ModuleName, BaseEvidenceError + 6, "no bonded validator for evidence")
+	ErrInvalidEvidenceSig      = sdkerrors.Register(ModuleName, BaseEvidenceError + 7, "invalid signature in evidence")
+	ErrMisbehaviorPunished     = sdkerrors.Register(ModuleName, BaseEvidenceError + 8, "misbehavior has already been punished")
+	ErrSubmitterMismatch       = sdkerrors.Register(ModuleName, BaseEvidenceError + 9, "submitter of the msg and the evidence mismatch")
 )
@@ -2,8 +2,12 @@ package types
 
 // evidence module events
 const (
-	EventTypeSubmitEvidence = "submit_evidence"
+	EventTypeSubmitEvidence  = "submit_evidence"
+	EventTypeRewardSubmitter = "reward_submitter"
 
 	AttributeValueCategory   = "evidence"
 	AttributeKeyEvidenceHash = "evidence_hash"
+	AttributeKeySubmitter    = "submitter"
+	AttributeKeyValidator    = "validator"
+	AttributeKeyReward       = "reward"
 )
@@ -0,0 +1,137 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/evidence/exported"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"gopkg.in/yaml.v2"
+)
+
+// Evidence type constants
+const (
+	RouteLightClientAttack = "lightclientattack"
+	TypeLightClientAttack  = "light_client_attack"
+)
+
+var _ exported.RewardableEvidence = (*LightClientAttack)(nil)
+
+// LightClientAttack implements the RewardableEvidence interface and defines evidence of a validator signing two
+// conflicting headers at the same height and round, which is presented to a light client to fork it away from the
+// canonical chain.
+type LightClientAttack struct {
+	ConsensusAddress   sdk.ConsAddress      `json:"consensus_address" yaml:"consensus_address"`
+	ConflictingHeaderA tmtypes.SignedHeader `json:"conflicting_header_a" yaml:"conflicting_header_a"`
+	ConflictingHeaderB tmtypes.SignedHeader `json:"conflicting_header_b" yaml:"conflicting_header_b"`
+	Submitter          sdk.AccAddress       `json:"submitter" yaml:"submitter"`
+}
+
+// NewLightClientAttack creates a new LightClientAttack instance
+func NewLightClientAttack(consAddr sdk.ConsAddress, headerA, headerB tmtypes.SignedHeader,
+	submitter sdk.AccAddress) LightClientAttack {
+	return LightClientAttack{
+		ConsensusAddress:   consAddr,
+		ConflictingHeaderA: headerA,
+		ConflictingHeaderB: headerB,
+		Submitter:          submitter,
+	}
+}
+
+// Route returns the Evidence Handler route for a LightClientAttack type.
+func (e LightClientAttack) Route() string { return RouteLightClientAttack }
+
+// Type returns the Evidence Handler type for a LightClientAttack type.
+func (e LightClientAttack) Type() string { return TypeLightClientAttack }
+
+func (e LightClientAttack) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of a LightClientAttack object.
+func (e LightClientAttack) Hash() tmbytes.HexBytes {
+	return tmhash.Sum(ModuleCdc.MustMarshalBinaryBare(e))
+}
+
+// ValidateBasic performs basic stateless validation checks on a LightClientAttack object.
+func (e LightClientAttack) ValidateBasic() error {
+	if len(e.ConsensusAddress) != crypto.AddressSize {
+		return fmt.Errorf("invalid light client attack validator consensus address: %s", e.ConsensusAddress)
+	}
+	if e.Submitter.Empty() {
+		return fmt.Errorf("invalid light client attack submitter: %s", e.Submitter)
+	}
+	if e.ConflictingHeaderA.Header == nil || e.ConflictingHeaderB.Header == nil {
+		return fmt.Errorf("missing conflicting header")
+	}
+
+	chainID := e.ConflictingHeaderA.ChainID
+	if err := e.ConflictingHeaderA.ValidateBasic(chainID); err != nil {
+		return fmt.Errorf("invalid conflicting header a: %w", err)
+	}
+	if err := e.ConflictingHeaderB.ValidateBasic(chainID); err != nil {
+		return fmt.Errorf("invalid conflicting header b: %w", err)
+	}
+
+	if e.ConflictingHeaderA.Height != e.ConflictingHeaderB.Height {
+		return fmt.Errorf("conflicting headers are at different heights: %d vs %d",
+			e.ConflictingHeaderA.Height, e.ConflictingHeaderB.Height)
+	}
+	if e.ConflictingHeaderA.Commit.Round != e.ConflictingHeaderB.Commit.Round {
+		return fmt.Errorf("conflicting headers are committed in different rounds: %d vs %d",
+			e.ConflictingHeaderA.Commit.Round, e.ConflictingHeaderB.Commit.Round)
+	}
+	if bytes.Equal(e.ConflictingHeaderA.Hash(), e.ConflictingHeaderB.Hash()) {
+		return fmt.Errorf("headers do not conflict: %s", e.ConflictingHeaderA.Hash())
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the consensus address of the validator signing both of the conflicting headers.
+func (e LightClientAttack) GetConsensusAddress() sdk.ConsAddress {
+	return e.ConsensusAddress
+}
+
+// GetHeight returns the height of the conflicting headers.
+func (e LightClientAttack) GetHeight() int64 {
+	return e.ConflictingHeaderA.Height
+}
+
+// GetTime returns the time of the earlier conflicting header.
+func (e LightClientAttack) GetTime() time.Time {
+	if e.ConflictingHeaderB.Time.Before(e.ConflictingHeaderA.Time) {
+		return e.ConflictingHeaderB.Time
+	}
+	return e.ConflictingHeaderA.Time
+}
+
+// GetValidatorPower is a no-op for the LightClientAttack type. The power is resolved from the state.
+func (e LightClientAttack) GetValidatorPower() int64 { return 0 }
+
+// GetTotalPower is a no-op for the LightClientAttack type.
+func (e LightClientAttack) GetTotalPower() int64 { return 0 }
+
+// GetSubmitter returns the address submitting the LightClientAttack.
+func (e LightClientAttack) GetSubmitter() sdk.AccAddress {
+	return e.Submitter
+}
+
+// VerifyCommitSignature checks whether the commit of a signed header contains the signature of the validator with
+// the given public key
+func VerifyCommitSignature(chainID string, signedHeader tmtypes.SignedHeader, pubKey crypto.PubKey) bool {
+	commit := signedHeader.Commit
+	for idx, commitSig := range commit.Signatures {
+		if commitSig.ForBlock() && bytes.Equal(commitSig.ValidatorAddress, pubKey.Address()) {
+			return pubKey.VerifyBytes(commit.VoteSignBytes(chainID, idx), commitSig.Signature)
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/evidence/exported"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"gopkg.in/yaml.v2"
+)
+
+// Evidence type constants
+const (
+	RouteOracleEquivocation = "oracleequivocation"
+	TypeOracleEquivocation  = "oracle_equivocation"
+
+	// OffenceTypeOracleEquivocation is the type of the offence recorded in the signing info of the validator
+	OffenceTypeOracleEquivocation = "oracle_equivocation"
+)
+
+var _ exported.RewardableEvidence = (*OracleEquivocation)(nil)
+
+// PriceAttestation is an off-chain price of an oracle feed in a round, signed by the consensus key of the validator
+// running the feed
+type PriceAttestation struct {
+	Feed      string    `json:"feed" yaml:"feed"`
+	Round     uint64    `json:"round" yaml:"round"`
+	Price     sdk.Dec   `json:"price" yaml:"price"`
+	Timestamp time.Time `json:"timestamp" yaml:"timestamp"`
+	Signature []byte    `json:"signature" yaml:"signature"`
+}
+
+// NewPriceAttestation creates a new PriceAttestation instance without signature
+func NewPriceAttestation(feed string, round uint64, price sdk.Dec, timestamp time.Time) PriceAttestation {
+	return PriceAttestation{
+		Feed:      feed,
+		Round:     round,
+		Price:     price,
+		Timestamp: timestamp,
+	}
+}
+
+// SignBytes returns the bytes of the attestation which the validator signs
+func (a PriceAttestation) SignBytes(chainID string) []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(struct {
+		ChainID   string    `json:"chain_id"`
+		Feed      string    `json:"feed"`
+		Round     uint64    `json:"round"`
+		Price     sdk.Dec   `json:"price"`
+		Timestamp time.Time `json:"timestamp"`
+	}{chainID, a.Feed, a.Round, a.Price, a.Timestamp}))
+}
+
+// Verify checks the signature of the attestation against the public key
+func (a PriceAttestation) Verify(chainID string, pubKey crypto.PubKey) bool {
+	return pubKey.VerifyBytes(a.SignBytes(chainID), a.Signature)
+}
+
+// ValidateBasic performs basic stateless validation checks on a PriceAttestation object
+func (a PriceAttestation) ValidateBasic() error {
+	if len(a.Feed) == 0 {
+		return fmt.Errorf("empty price attestation feed")
+	}
+	if a.Price.IsNil() || !a.Price.IsPositive() {
+		return fmt.Errorf("invalid price attestation price: %s", a.Price)
+	}
+	if a.Timestamp.IsZero() {
+		return fmt.Errorf("invalid price attestation timestamp: %s", a.Timestamp)
+	}
+	if len(a.Signature) == 0 {
+		return fmt.Errorf("missing price attestation signature")
+	}
+
+	return nil
+}
+
+// OracleEquivocation implements the RewardableEvidence interface and defines evidence of a validator attesting
+// contradicting prices of an oracle feed in the same round.
+type OracleEquivocation struct {
+	ConsensusAddress sdk.ConsAddress  `json:"consensus_address" yaml:"consensus_address"`
+	AttestationA     PriceAttestation `json:"attestation_a" yaml:"attestation_a"`
+	AttestationB     PriceAttestation `json:"attestation_b" yaml:"attestation_b"`
+	Submitter        sdk.AccAddress   `json:"submitter" yaml:"submitter"`
+}
+
+// NewOracleEquivocation creates a new OracleEquivocation instance
+func NewOracleEquivocation(consAddr sdk.ConsAddress, attestationA, attestationB PriceAttestation,
+	submitter sdk.AccAddress) OracleEquivocation {
+	return OracleEquivocation{
+		ConsensusAddress: consAddr,
+		AttestationA:     attestationA,
+		AttestationB:     attestationB,
+		Submitter:        submitter,
+	}
+}
+
+// Route returns the Evidence Handler route for an OracleEquivocation type.
+func (e OracleEquivocation) Route() string { return RouteOracleEquivocation }
+
+// Type returns the Evidence Handler type for an OracleEquivocation type.
+func (e OracleEquivocation) Type() string { return TypeOracleEquivocation }
+
+func (e OracleEquivocation) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of an OracleEquivocation object.
+func (e OracleEquivocation) Hash() tmbytes.HexBytes {
+	return tmhash.Sum(ModuleCdc.MustMarshalBinaryBare(e))
+}
+
+// ValidateBasic performs basic stateless validation checks on an OracleEquivocation object.
+func (e OracleEquivocation) ValidateBasic() error {
+	if len(e.ConsensusAddress) != crypto.AddressSize {
+		return fmt.Errorf("invalid oracle equivocation validator consensus address: %s", e.ConsensusAddress)
+	}
+	if e.Submitter.Empty() {
+		return fmt.Errorf("invalid oracle equivocation submitter: %s", e.Submitter)
+	}
+	if err := e.AttestationA.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid attestation a: %w", err)
+	}
+	if err := e.AttestationB.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid attestation b: %w", err)
+	}
+
+	if e.AttestationA.Feed != e.AttestationB.Feed || e.AttestationA.Round != e.AttestationB.Round {
+		return fmt.Errorf("attestations are for different feed rounds: %s/%d vs %s/%d",
+			e.AttestationA.Feed, e.AttestationA.Round, e.AttestationB.Feed, e.AttestationB.Round)
+	}
+	if e.AttestationA.Price.Equal(e.AttestationB.Price) {
+		return fmt.Errorf("attestations do not contradict: %s", e.AttestationA.Price)
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the consensus address of the validator signing both of the attestations.
+func (e OracleEquivocation) GetConsensusAddress() sdk.ConsAddress {
+	return e.ConsensusAddress
+}
+
+// GetHeight is a no-op for the OracleEquivocation type. The attestations are not bound to a block height.
+func (e OracleEquivocation) GetHeight() int64 { return 0 }
+
+// GetTime returns the time of the earlier attestation.
+func (e OracleEquivocation) GetTime() time.Time {
+	if e.AttestationB.Timestamp.Before(e.AttestationA.Timestamp) {
+		return e.AttestationB.Timestamp
+	}
+	return e.AttestationA.Timestamp
+}
+
+// GetValidatorPower is a no-op for the OracleEquivocation type. The power is resolved from the state.
+func (e OracleEquivocation) GetValidatorPower() int64 { return 0 }
+
+// GetTotalPower is a no-op for the OracleEquivocation type.
+func (e OracleEquivocation) GetTotalPower() int64 { return 0 }
+
+// GetSubmitter returns the address submitting the OracleEquivocation.
+func (e OracleEquivocation) GetSubmitter() sdk.AccAddress {
+	return e.Submitter
+}
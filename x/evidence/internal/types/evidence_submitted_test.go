@@ -0,0 +1,111 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/evidence/internal/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const testChainID = "okexchain-65"
+
+func TestLightClientAttackValidateBasic(t *testing.T) {
+	pk := ed25519.GenPrivKey()
+	consAddr := sdk.ConsAddress(pk.PubKey().Address())
+	submitter := sdk.AccAddress("submitter")
+	n := time.Now().UTC()
+
+	headerA := types.TestSignedHeader(pk, testChainID, 10, 0, n, []byte("a"))
+	headerB := types.TestSignedHeader(pk, testChainID, 10, 0, n, []byte("b"))
+	otherHeight := types.TestSignedHeader(pk, testChainID, 11, 0, n, []byte("b"))
+	otherRound := types.TestSignedHeader(pk, testChainID, 10, 1, n, []byte("b"))
+	otherChain := types.TestSignedHeader(pk, "other", 10, 0, n, []byte("b"))
+	tampered := types.TestSignedHeader(pk, testChainID, 10, 0, n, []byte("b"))
+	tampered.Commit = headerA.Commit
+
+	testCases := []struct {
+		name      string
+		e         types.LightClientAttack
+		expectErr bool
+	}{
+		{"valid", types.NewLightClientAttack(consAddr, headerA, headerB, submitter), false},
+		{"invalid address", types.NewLightClientAttack(nil, headerA, headerB, submitter), true},
+		{"invalid submitter", types.NewLightClientAttack(consAddr, headerA, headerB, nil), true},
+		{"missing header", types.NewLightClientAttack(consAddr, headerA, tmtypes.SignedHeader{}, submitter), true},
+		{"same headers", types.NewLightClientAttack(consAddr, headerA, headerA, submitter), true},
+		{"different heights", types.NewLightClientAttack(consAddr, headerA, otherHeight, submitter), true},
+		{"different rounds", types.NewLightClientAttack(consAddr, headerA, otherRound, submitter), true},
+		{"different chains", types.NewLightClientAttack(consAddr, headerA, otherChain, submitter), true},
+		{"commit of another header", types.NewLightClientAttack(consAddr, headerA, tampered, submitter), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+		})
+	}
+
+	e := types.NewLightClientAttack(consAddr, headerA, headerB, submitter)
+	require.Equal(t, int64(10), e.GetHeight())
+	require.Equal(t, submitter, e.GetSubmitter())
+	require.True(t, types.VerifyCommitSignature(testChainID, headerA, pk.PubKey()))
+	require.False(t, types.VerifyCommitSignature("other", headerA, pk.PubKey()))
+	require.False(t, types.VerifyCommitSignature(testChainID, headerA, ed25519.GenPrivKey().PubKey()))
+}
+
+func TestOracleEquivocationValidateBasic(t *testing.T) {
+	pk := ed25519.GenPrivKey()
+	consAddr := sdk.ConsAddress(pk.PubKey().Address())
+	submitter := sdk.AccAddress("submitter")
+	n := time.Now().UTC()
+
+	attestationA := types.TestSignedPriceAttestation(pk, testChainID, "okt_usdt", 12, sdk.NewDec(17), n)
+	attestationB := types.TestSignedPriceAttestation(pk, testChainID, "okt_usdt", 12, sdk.NewDec(19), n.Add(time.Second))
+	otherRound := types.TestSignedPriceAttestation(pk, testChainID, "okt_usdt", 13, sdk.NewDec(19), n)
+	otherFeed := types.TestSignedPriceAttestation(pk, testChainID, "btc_usdt", 12, sdk.NewDec(19), n)
+	unsigned := types.NewPriceAttestation("okt_usdt", 12, sdk.NewDec(19), n)
+
+	testCases := []struct {
+		name      string
+		e         types.OracleEquivocation
+		expectErr bool
+	}{
+		{"valid", types.NewOracleEquivocation(consAddr, attestationA, attestationB, submitter), false},
+		{"invalid address", types.NewOracleEquivocation(nil, attestationA, attestationB, submitter), true},
+		{"invalid submitter", types.NewOracleEquivocation(consAddr, attestationA, attestationB, nil), true},
+		{"same prices", types.NewOracleEquivocation(consAddr, attestationA, attestationA, submitter), true},
+		{"different rounds", types.NewOracleEquivocation(consAddr, attestationA, otherRound, submitter), true},
+		{"different feeds", types.NewOracleEquivocation(consAddr, attestationA, otherFeed, submitter), true},
+		{"unsigned", types.NewOracleEquivocation(consAddr, attestationA, unsigned, submitter), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expectErr, tc.e.ValidateBasic() != nil)
+		})
+	}
+
+	e := types.NewOracleEquivocation(consAddr, attestationA, attestationB, submitter)
+	require.Equal(t, n, e.GetTime())
+	require.True(t, attestationA.Verify(testChainID, pk.PubKey()))
+	require.False(t, attestationA.Verify("other", pk.PubKey()))
+}
+
+func TestMsgSubmitEvidenceSubmitterMismatch(t *testing.T) {
+	pk := ed25519.GenPrivKey()
+	n := time.Now().UTC()
+	attestationA := types.TestSignedPriceAttestation(pk, testChainID, "okt_usdt", 12, sdk.NewDec(17), n)
+	attestationB := types.TestSignedPriceAttestation(pk, testChainID, "okt_usdt", 12, sdk.NewDec(19), n)
+	e := types.NewOracleEquivocation(sdk.ConsAddress(pk.PubKey().Address()), attestationA, attestationB,
+		sdk.AccAddress("submitter"))
+
+	require.NoError(t, types.NewMsgSubmitEvidence(e, sdk.AccAddress("submitter")).ValidateBasic())
+	require.Error(t, types.NewMsgSubmitEvidence(e, sdk.AccAddress("front-runner")).ValidateBasic())
+}
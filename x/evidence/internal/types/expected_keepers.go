@@ -14,6 +14,8 @@ type (
 	// evidence module.
 	StakingKeeper interface {
 		ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingexported.ValidatorI
+		SlashWithReward(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64,
+			slashFactor sdk.Dec, rewardee sdk.AccAddress, rewardFraction sdk.Dec) sdk.Dec
 
 		//Append abandoned validator to staking keeper
 		AppendAbandonedValidatorAddrs(ctx sdk.Context, ConsAddr sdk.ConsAddress)
@@ -30,5 +32,8 @@ type (
 		SlashFractionDoubleSign(sdk.Context) sdk.Dec
 		Jail(sdk.Context, sdk.ConsAddress)
 		JailUntil(sdk.Context, sdk.ConsAddress, time.Time)
+		RecordDoubleSign(sdk.Context, sdk.ConsAddress, int64, time.Time, sdk.Dec)
+		RecordOffence(sdk.Context, sdk.ConsAddress, string, int64, time.Time, sdk.Dec)
+		ExtendJail(sdk.Context, sdk.ConsAddress, time.Time)
 	}
 )
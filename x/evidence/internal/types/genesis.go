@@ -44,5 +44,18 @@ func (gs GenesisState) Validate() error {
 		return fmt.Errorf("max evidence age must be at least 1 minute, is %s", maxEvidence.String())
 	}
 
+	if err := validateMaxEvidenceAge(gs.Params.MaxSubmittedEvidenceAge); err != nil {
+		return err
+	}
+	if err := validateFraction(gs.Params.OracleSlashFraction); err != nil {
+		return err
+	}
+	if err := validateOracleJailDuration(gs.Params.OracleJailDuration); err != nil {
+		return err
+	}
+	if err := validateFraction(gs.Params.SubmitterRewardFraction); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "evidence"
@@ -16,5 +22,14 @@ const (
 
 // KVStore key prefixes
 var (
-	KeyPrefixEvidence = []byte{0x00}
+	KeyPrefixEvidence           = []byte{0x00}
+	KeyPrefixOracleEquivocation = []byte{0x01}
 )
+
+// GetOracleEquivocationKey returns the key of an oracle round signed by a validator with contradicting prices
+func GetOracleEquivocationKey(consAddr sdk.ConsAddress, feed string, round uint64) []byte {
+	roundBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(roundBytes, round)
+	key := append(append(KeyPrefixOracleEquivocation, consAddr.Bytes()...), []byte(feed)...)
+	return append(key, roundBytes...)
+}
@@ -43,6 +43,9 @@ func (m MsgSubmitEvidence) ValidateBasic() error {
 	if m.Submitter.Empty() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, m.Submitter.String())
 	}
+	if e, ok := m.Evidence.(exported.RewardableEvidence); ok && !e.GetSubmitter().Equals(m.Submitter) {
+		return sdkerrors.Wrapf(ErrSubmitterMismatch, "%s vs %s", m.Submitter, e.GetSubmitter())
+	}
 
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/okex/okexchain/x/params"
 
 	"gopkg.in/yaml.v2"
@@ -13,13 +14,25 @@ import (
 
 // Default parameter values
 const (
-	DefaultParamspace     = ModuleName
-	DefaultMaxEvidenceAge = 60 * 2 * time.Second
+	DefaultParamspace              = ModuleName
+	DefaultMaxEvidenceAge          = 60 * 2 * time.Second
+	DefaultMaxSubmittedEvidenceAge = 60 * 60 * 24 * 7 * time.Second
+	DefaultOracleJailDuration      = 60 * 60 * 24 * time.Second
+)
+
+// Default parameter values of the user submitted evidence
+var (
+	DefaultOracleSlashFraction     = sdk.NewDecWithPrec(1, 2)
+	DefaultSubmitterRewardFraction = sdk.NewDecWithPrec(1, 1)
 )
 
 // Parameter store keys
 var (
-	KeyMaxEvidenceAge = []byte("MaxEvidenceAge")
+	KeyMaxEvidenceAge          = []byte("MaxEvidenceAge")
+	KeyMaxSubmittedEvidenceAge = []byte("MaxSubmittedEvidenceAge")
+	KeyOracleSlashFraction     = []byte("OracleSlashFraction")
+	KeyOracleJailDuration      = []byte("OracleJailDuration")
+	KeySubmitterRewardFraction = []byte("SubmitterRewardFraction")
 
 	// The Double Sign Jail period ends at Max Time supported by Amino
 	// (Dec 31, 9999 - 23:59:59 GMT).
@@ -29,6 +42,12 @@ var (
 // Params defines the total set of parameters for the evidence module
 type Params struct {
 	MaxEvidenceAge time.Duration `json:"max_evidence_age" yaml:"max_evidence_age"`
+	// the evidence submitted by users is allowed to be older than the one reported by Tendermint
+	MaxSubmittedEvidenceAge time.Duration `json:"max_submitted_evidence_age" yaml:"max_submitted_evidence_age"`
+	OracleSlashFraction     sdk.Dec       `json:"oracle_slash_fraction" yaml:"oracle_slash_fraction"`
+	OracleJailDuration      time.Duration `json:"oracle_jail_duration" yaml:"oracle_jail_duration"`
+	// fraction of the slashed tokens paid to the submitter of the evidence
+	SubmitterRewardFraction sdk.Dec `json:"submitter_reward_fraction" yaml:"submitter_reward_fraction"`
 }
 
 // ParamKeyTable returns the parameter key table.
@@ -45,13 +64,21 @@ func (p Params) String() string {
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyMaxEvidenceAge, &p.MaxEvidenceAge, validateMaxEvidenceAge),
+		params.NewParamSetPair(KeyMaxSubmittedEvidenceAge, &p.MaxSubmittedEvidenceAge, validateMaxEvidenceAge),
+		params.NewParamSetPair(KeyOracleSlashFraction, &p.OracleSlashFraction, validateFraction),
+		params.NewParamSetPair(KeyOracleJailDuration, &p.OracleJailDuration, validateOracleJailDuration),
+		params.NewParamSetPair(KeySubmitterRewardFraction, &p.SubmitterRewardFraction, validateFraction),
 	}
 }
 
 // DefaultParams returns the default parameters for the evidence module.
 func DefaultParams() Params {
 	return Params{
-		MaxEvidenceAge: DefaultMaxEvidenceAge,
+		MaxEvidenceAge:          DefaultMaxEvidenceAge,
+		MaxSubmittedEvidenceAge: DefaultMaxSubmittedEvidenceAge,
+		OracleSlashFraction:     DefaultOracleSlashFraction,
+		OracleJailDuration:      DefaultOracleJailDuration,
+		SubmitterRewardFraction: DefaultSubmitterRewardFraction,
 	}
 }
 
@@ -67,3 +94,29 @@ func validateMaxEvidenceAge(i interface{}) error {
 
 	return nil
 }
+
+func validateFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fraction must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validateOracleJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("oracle jail duration must be positive: %s", v)
+	}
+
+	return nil
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 var (
@@ -133,3 +134,41 @@ func TestEquivocationHandler(k interface{}) Handler {
 		return nil
 	}
 }
+
+// TestSignedHeader returns a header at the height committed in the round with the signature of privKey only. The
+// appHash differentiates the headers at the same height
+func TestSignedHeader(privKey crypto.PrivKey, chainID string, height int64, round int, headerTime time.Time,
+	appHash []byte) tmtypes.SignedHeader {
+	header := &tmtypes.Header{
+		ChainID:         chainID,
+		Height:          height,
+		Time:            headerTime,
+		ValidatorsHash:  tmhash.Sum([]byte("validators")),
+		AppHash:         appHash,
+		ProposerAddress: privKey.PubKey().Address(),
+	}
+
+	blockID := tmtypes.BlockID{Hash: header.Hash()}
+	commitSig := tmtypes.NewCommitSigForBlock(nil, privKey.PubKey().Address(), headerTime)
+	commit := tmtypes.NewCommit(height, round, blockID, []tmtypes.CommitSig{commitSig})
+	sig, err := privKey.Sign(commit.VoteSignBytes(chainID, 0))
+	if err != nil {
+		panic(err)
+	}
+	commit.Signatures[0].Signature = sig
+
+	return tmtypes.SignedHeader{Header: header, Commit: commit}
+}
+
+// TestSignedPriceAttestation returns a price attestation signed by privKey
+func TestSignedPriceAttestation(privKey crypto.PrivKey, chainID, feed string, round uint64, price sdk.Dec,
+	timestamp time.Time) PriceAttestation {
+	attestation := NewPriceAttestation(feed, round, price, timestamp)
+	sig, err := privKey.Sign(attestation.SignBytes(chainID))
+	if err != nil {
+		panic(err)
+	}
+	attestation.Signature = sig
+
+	return attestation
+}
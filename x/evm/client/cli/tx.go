@@ -17,12 +17,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	emint "github.com/okex/okexchain/app/types"
+	evmutils "github.com/okex/okexchain/x/evm/client/utils"
 	"github.com/okex/okexchain/x/evm/types"
+	"github.com/okex/okexchain/x/gov"
 )
 
 // GetTxCmd defines the CLI commands regarding evm module transactions
@@ -177,3 +180,121 @@ func GetCmdGenCreateTx(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdManageContractDeploymentWhitelistProposal implements a command handler for submitting a manage contract
+// deployment whitelist proposal transaction
+func GetCmdManageContractDeploymentWhitelistProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-contract-deployment-whitelist [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an update contract deployment whitelist proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an update contract deployment whitelist proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal update-contract-deployment-whitelist <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "update contract proposal whitelist with a distributor address list",
+  "description": "add a distributor address list into the whitelist",
+  "distributor_addresses": [
+    "okexchain1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02",
+    "okexchain1k0wwsg7xf9tjt3rvxdewz42e74sp286agrf9qc"
+  ],
+  "is_added": true,
+  "deposit": [
+    {
+      "denom": "%s",
+      "amount": "100.000000000000000000"
+    }
+  ]
+}
+`, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := evmutils.ParseManageContractDeploymentWhitelistProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewManageContractDeploymentWhitelistProposal(
+				proposal.Title,
+				proposal.Description,
+				proposal.DistributorAddrs,
+				proposal.IsAdded,
+			)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdManageContractBlockedListProposal implements a command handler for submitting a manage contract blocked list
+// proposal transaction
+func GetCmdManageContractBlockedListProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "update-contract-blocked-list [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an update contract blocked list proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an update contract blocked list proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal update-contract-blocked-list <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "update contract blocked list proposal with a contract address list",
+  "description": "add a contract address list into the blocked list",
+  "contract_addresses": [
+    "okexchain1cftp8q8g4aa65nw9s5trwexe77d9t6cr8ndu02",
+    "okexchain1k0wwsg7xf9tjt3rvxdewz42e74sp286agrf9qc"
+  ],
+  "is_added": true,
+  "deposit": [
+    {
+      "denom": "%s",
+      "amount": "100.000000000000000000"
+    }
+  ]
+}
+`, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := evmutils.ParseManageContractBlockedListProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewManageContractBlockedListProposal(
+				proposal.Title,
+				proposal.Description,
+				proposal.ContractAddrs,
+				proposal.IsAdded,
+			)
+			if err := content.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return authclient.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"strings"
 	"time"
 	"github.com/okex/okexchain/x/common"
+	govRest "github.com/okex/okexchain/x/gov/client/rest"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
@@ -191,3 +192,13 @@ func QuerySectionFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponseBare(w, cliCtx, res)
 	}
 }
+
+// ManageContractDeploymentWhitelistProposalRESTHandler defines evm proposal handler
+func ManageContractDeploymentWhitelistProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
+
+// ManageContractBlockedListProposalRESTHandler defines evm proposal handler
+func ManageContractBlockedListProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
@@ -0,0 +1,245 @@
+package evm
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"hash"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	dbm "github.com/tendermint/tm-db"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	checkpointDBName = "evm_checkpoint"
+	progressFileName = "evm_progress.json"
+	// progressInterval is the number of accounts processed between two saves of the progress file
+	progressInterval = 1000
+
+	exportOperation = "export"
+	importOperation = "import"
+	verifyOperation = "verify"
+)
+
+var (
+	checkpoint *exportCheckpoint
+
+	checkpointHeightKey = []byte("height")
+	checkpointModeKey   = []byte("mode")
+	exportedHashPrefix  = []byte("e") // exportedHashPrefix + address -> integrity hash of the exported account
+)
+
+// ExportProgress is the progress of the evm state export, import or verification, which is saved into the data path
+type ExportProgress struct {
+	Operation string `json:"operation"`
+	Mode      string `json:"mode"`
+	Height    int64  `json:"height"`
+	Total     uint64 `json:"total"`
+	Done      uint64 `json:"done"`
+	// Skipped is the number of accounts done by the interrupted exports before, which are included in Done
+	Skipped    uint64   `json:"skipped"`
+	Mismatched []string `json:"mismatched,omitempty"`
+	Finished   bool     `json:"finished"`
+}
+
+// LoadExportProgress loads the progress saved in the data path
+func LoadExportProgress(dataPath string) (progress ExportProgress, err error) {
+	bz, err := ioutil.ReadFile(filepath.Join(dataPath, progressFileName))
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal(bz, &progress)
+	return
+}
+
+// exportCheckpoint records the integrity hashes of the exported accounts keyed by address, so that an interrupted export
+// resumes from the accounts not recorded instead of starting over, and the import checks the accounts with them. An
+// interrupted import always starts over, since the state imported by InitGenesis isn't committed before the chain is
+// initialized, and nothing imported survives the restart
+type exportCheckpoint struct {
+	db       dbm.DB
+	dataPath string
+
+	mtx      sync.Mutex
+	progress ExportProgress
+}
+
+// openCheckpoint opens the checkpoint in the data path
+func openCheckpoint(dataPath string) *exportCheckpoint {
+	db, err := sdk.NewLevelDB(checkpointDBName, dataPath)
+	if err != nil {
+		panic(err)
+	}
+	return &exportCheckpoint{db: db, dataPath: dataPath}
+}
+
+// checkpointExist returns whether there is a checkpoint in the data path
+func checkpointExist(dataPath string) bool {
+	return pathExist(filepath.Join(dataPath, checkpointDBName+".db"))
+}
+
+// exportMode returns the mode of the export recorded, which is empty if nothing has been exported
+func (c *exportCheckpoint) exportMode() string {
+	bz, err := c.db.Get(checkpointModeKey)
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
+
+// exportHeight returns the height of the export recorded
+func (c *exportCheckpoint) exportHeight() int64 {
+	bz, err := c.db.Get(checkpointHeightKey)
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) != 8 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+// prepareExport resets the checkpoint when it was recorded by an export of another height or mode, and returns whether
+// it's reset
+func (c *exportCheckpoint) prepareExport(mode string, height int64) bool {
+	if c.exportMode() == mode && c.exportHeight() == height {
+		return false
+	}
+
+	resetDB(c.db)
+	if err := c.db.SetSync(checkpointModeKey, []byte(mode)); err != nil {
+		panic(err)
+	}
+	if err := c.db.SetSync(checkpointHeightKey, sdk.Uint64ToBigEndian(uint64(height))); err != nil {
+		panic(err)
+	}
+	return true
+}
+
+func (c *exportCheckpoint) exportedHash(addr ethcmn.Address) []byte {
+	return c.get(exportedHashPrefix, addr)
+}
+
+func (c *exportCheckpoint) setExported(addr ethcmn.Address, hash []byte) {
+	c.set(exportedHashPrefix, addr, hash)
+	c.advance(false)
+}
+
+func (c *exportCheckpoint) get(keyPrefix []byte, addr ethcmn.Address) []byte {
+	bz, err := c.db.Get(append(append([]byte{}, keyPrefix...), addr.Bytes()...))
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func (c *exportCheckpoint) set(keyPrefix []byte, addr ethcmn.Address, hash []byte) {
+	if err := c.db.Set(append(append([]byte{}, keyPrefix...), addr.Bytes()...), hash); err != nil {
+		panic(err)
+	}
+}
+
+// start resets the progress for a new operation
+func (c *exportCheckpoint) start(operation, mode string, height int64, total uint64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.progress = ExportProgress{Operation: operation, Mode: mode, Height: height, Total: total}
+	c.saveProgress()
+}
+
+// advance counts an account done, which is skipped if it was done by the interrupted exports before
+func (c *exportCheckpoint) advance(skipped bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.progress.Done++
+	if skipped {
+		c.progress.Skipped++
+	}
+	if c.progress.Done%progressInterval == 0 {
+		c.saveProgress()
+	}
+}
+
+// mismatch counts an account done whose exported state is different from the one expected
+func (c *exportCheckpoint) mismatch(addr ethcmn.Address) {
+	c.mtx.Lock()
+	c.progress.Mismatched = append(c.progress.Mismatched, addr.String())
+	c.mtx.Unlock()
+	c.advance(false)
+}
+
+// finish saves the final progress and closes the checkpoint
+func (c *exportCheckpoint) finish() ExportProgress {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.progress.Finished = true
+	c.saveProgress()
+	c.db.Close()
+	return c.progress
+}
+
+func (c *exportCheckpoint) saveProgress() {
+	bz, err := json.MarshalIndent(c.progress, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	// write to a temporary file first, so that the progress file is never read half written
+	tmpFile := filepath.Join(c.dataPath, progressFileName+".tmp")
+	if err := ioutil.WriteFile(tmpFile, bz, 0644); err != nil {
+		panic(err)
+	}
+	if err := os.Rename(tmpFile, filepath.Join(c.dataPath, progressFileName)); err != nil {
+		panic(err)
+	}
+}
+
+// resetDB deletes all the records in the db
+func resetDB(db dbm.DB) {
+	iterator, err := db.Iterator(nil, nil)
+	if err != nil {
+		panic(err)
+	}
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	batch := db.NewBatch()
+	defer batch.Close()
+	for _, key := range keys {
+		batch.Delete(key)
+	}
+	if err := batch.WriteSync(); err != nil {
+		panic(err)
+	}
+}
+
+// accountHasher computes the integrity hash of the code and storage of an account, whose storage must be written in
+// the order of the keys
+type accountHasher struct {
+	hash.Hash
+}
+
+func newAccountHasher() accountHasher {
+	return accountHasher{sha3.NewLegacyKeccak256()}
+}
+
+func (h accountHasher) writeCode(code []byte) {
+	h.Write(ethcrypto.Keccak256(code))
+}
+
+func (h accountHasher) writeState(key, value ethcmn.Hash) {
+	h.Write(key.Bytes())
+	h.Write(value.Bytes())
+}
+
+func (h accountHasher) sum() []byte {
+	return h.Sum(nil)
+}
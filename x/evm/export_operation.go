@@ -2,6 +2,7 @@ package evm
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -28,6 +29,7 @@ const (
 	defaultMode       = "default"
 	filesMode         = "files"
 	dbMode            = "db"
+	verifyMode        = "verify"
 )
 
 var (
@@ -42,10 +44,15 @@ var (
 	storageCount uint64
 
 	evmByteCodeDB, evmStateDB dbm.DB
+
+	// exportFormat is the mode of the export to be verified
+	exportFormat string
+	// checkIntegrity is whether to check the accounts imported with the integrity hashes of the export
+	checkIntegrity bool
 )
 
-// initExportEnv only initializes the paths and goroutine pool
-func initExportEnv(dataPath, mode string, goroutineNum uint64) {
+// initExportEnv only initializes the paths, goroutine pool and checkpoint
+func initExportEnv(dataPath, mode string, goroutineNum uint64, height int64) {
 	if dataPath == "" {
 		dataPath = defaultPath
 	}
@@ -65,17 +72,31 @@ func initExportEnv(dataPath, mode string, goroutineNum uint64) {
 		if err != nil {
 			panic(err)
 		}
-
-		initGoroutinePool(goroutineNum)
 	case "db":
 		initEVMDB(dataPath)
-		initGoroutinePool(goroutineNum)
+	case "verify":
+		if !checkpointExist(dataPath) {
+			panic(fmt.Sprintf("no evm export is found in %s", dataPath))
+		}
+		checkpoint = openCheckpoint(dataPath)
+		// read the export in the mode it's exported
+		exportFormat = checkpoint.exportMode()
+		initImportEnv(dataPath, exportFormat, goroutineNum)
+		return
 	default:
 		panic("unsupported export mode")
 	}
+
+	initGoroutinePool(goroutineNum)
+	checkpoint = openCheckpoint(dataPath)
+	if checkpoint.prepareExport(mode, height) && mode == dbMode {
+		// the records of the export of another height are stale
+		resetDB(evmByteCodeDB)
+		resetDB(evmStateDB)
+	}
 }
 
-// initImportEnv only initializes the paths and goroutine pool
+// initImportEnv only initializes the paths, goroutine pool and checkpoint
 func initImportEnv(dataPath, mode string, goroutineNum uint64) {
 	if dataPath == "" {
 		dataPath = defaultPath
@@ -86,82 +107,191 @@ func initImportEnv(dataPath, mode string, goroutineNum uint64) {
 	case "files":
 		codePath = filepath.Join(dataPath, codeSubPath)
 		storagePath = filepath.Join(dataPath, storageSubPath)
-
-		initGoroutinePool(goroutineNum)
 	case "db":
 		initEVMDB(dataPath)
+		if isEmptyState(evmByteCodeDB) || isEmptyState(evmStateDB) {
+			panic("failed to open evm db")
+		}
 	default:
 		panic("unsupported import mode")
 	}
+
+	initGoroutinePool(goroutineNum)
+	if checkpoint == nil {
+		checkpoint = openCheckpoint(dataPath)
+	}
+	// the exports without the checkpoint are imported without the integrity check
+	checkIntegrity = checkpoint.exportMode() != ""
 }
 
 // exportToFile export EVM code and storage to files
 func exportToFile(ctx sdk.Context, k Keeper, address ethcmn.Address) {
-	// write Code
-	addGoroutine()
-	go syncWriteAccountCode(ctx, k, address)
-	// write Storage
-	addGoroutine()
-	go syncWriteAccountStorage(ctx, k, address)
-}
+	if skipExported(address) {
+		return
+	}
 
-// importFromFile import EVM code and storage from files
-func importFromFile(ctx sdk.Context, logger log.Logger, k Keeper, address ethcmn.Address, codeHash []byte) {
-	// read Code from file
 	addGoroutine()
-	go syncReadCodeFromFile(ctx, logger, k, address, codeHash)
+	go func() {
+		defer finishGoroutine()
 
-	// read Storage From file
-	addGoroutine()
-	go syncReadStorageFromFile(ctx, logger, k, address)
+		hasher := newAccountHasher()
+		writeAccountCode(ctx, k, address, hasher)
+		writeAccountStorage(ctx, k, address, hasher)
+		checkpoint.setExported(address, hasher.sum())
+	}()
 }
 
 // exportToDB export EVM code and storage to leveldb
 func exportToDB(ctx sdk.Context, k Keeper, address ethcmn.Address, codeHash []byte) {
-	if code := k.GetCode(ctx, address); len(code) > 0 {
-		// TODO repeat code
-		if err := evmByteCodeDB.Set(append(types.KeyPrefixCode, codeHash...), code); err != nil {
-			panic(err)
-		}
-		codeCount++
+	if skipExported(address) {
+		return
 	}
 
 	addGoroutine()
-	go exportStorage(ctx, k, address, evmStateDB)
+	go func() {
+		defer finishGoroutine()
+
+		hasher := newAccountHasher()
+		code := k.GetCode(ctx, address)
+		hasher.writeCode(code)
+		if len(code) > 0 {
+			// TODO repeat code
+			if err := evmByteCodeDB.Set(append(types.KeyPrefixCode, codeHash...), code); err != nil {
+				panic(err)
+			}
+			atomic.AddUint64(&codeCount, 1)
+		}
+		exportStorage(ctx, k, address, evmStateDB, hasher)
+		checkpoint.setExported(address, hasher.sum())
+	}()
 }
 
-// importFromDB import EVM code and storage to leveldb
-func importFromDB(ctx sdk.Context, k Keeper, address ethcmn.Address, codeHash []byte) {
-	if isEmptyState(evmByteCodeDB) || isEmptyState(evmStateDB) {
-		panic("failed to open evm db")
+// skipExported returns whether the account has been exported by the interrupted export before
+func skipExported(address ethcmn.Address) bool {
+	if checkpoint.exportedHash(address) == nil {
+		return false
 	}
+	checkpoint.advance(true)
+	return true
+}
 
-	code, err := evmByteCodeDB.Get(append(types.KeyPrefixCode, codeHash...))
-	if err != nil {
-		panic(err)
-	}
-	if len(code) != 0 {
-		k.SetCodeDirectly(ctx, codeHash, code)
-		codeCount++
-	}
+// importFromFile import EVM code and storage from files
+func importFromFile(ctx sdk.Context, logger log.Logger, k Keeper, address ethcmn.Address, codeHash []byte) {
+	importAccount(ctx, logger, k, filesMode, address, codeHash)
+}
+
+// importFromDB import EVM code and storage to leveldb
+func importFromDB(ctx sdk.Context, logger log.Logger, k Keeper, address ethcmn.Address, codeHash []byte) {
+	importAccount(ctx, logger, k, dbMode, address, codeHash)
+}
+
+// importAccount imports the code and storage of the account exported in the mode, and checks them with the integrity
+// hash of the export
+func importAccount(ctx sdk.Context, logger log.Logger, k Keeper, mode string, address ethcmn.Address, codeHash []byte) {
+	addGoroutine()
+	go func() {
+		defer finishGoroutine()
+
+		hasher := newAccountHasher()
+		code := readAccountCode(logger, mode, address, codeHash)
+		hasher.writeCode(code)
+		if len(code) != 0 {
+			// Set contract code into db, ignoring setting in cache
+			k.SetCodeDirectly(ctx, codeHash, code)
+			atomic.AddUint64(&codeCount, 1)
+		}
+		readAccountStorage(logger, mode, address, func(key, value ethcmn.Hash) {
+			hasher.writeState(key, value)
+			// Set the state of key&value into db, ignoring setting in cache
+			k.SetStateDirectly(ctx, address, key, value)
+			atomic.AddUint64(&storageCount, 1)
+		})
+
+		if checkIntegrity && !bytes.Equal(hasher.sum(), checkpoint.exportedHash(address)) {
+			// the export is incomplete or corrupted
+			logger.Error("the integrity check of the account imported failed", "address", address.String())
+			checkpoint.mismatch(address)
+			return
+		}
+		checkpoint.advance(false)
+	}()
+}
+
+// verifyAccount compares the code and storage of the account in the export with the live CommitStateDB
+func verifyAccount(ctx sdk.Context, logger log.Logger, k Keeper, address ethcmn.Address, codeHash []byte) {
+	addGoroutine()
+	go func() {
+		defer finishGoroutine()
+
+		hasher := newAccountHasher()
+		hasher.writeCode(readAccountCode(logger, exportFormat, address, codeHash))
+		readAccountStorage(logger, exportFormat, address, hasher.writeState)
+
+		hash := hasher.sum()
+		if !bytes.Equal(hash, checkpoint.exportedHash(address)) || !bytes.Equal(hash, liveHash(ctx, k, address)) {
+			// no log here, which is written into genesis.json, the mismatched accounts are saved in the progress
+			checkpoint.mismatch(address)
+			return
+		}
+		checkpoint.advance(false)
+	}()
+}
 
-	prefix := types.AddressStoragePrefix(address)
-	iterator, err := evmStateDB.Iterator(prefix, sdk.PrefixEndBytes(prefix))
+// liveHash computes the integrity hash of the account with the live CommitStateDB
+func liveHash(ctx sdk.Context, k Keeper, address ethcmn.Address) []byte {
+	csdb := types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx)
+	hasher := newAccountHasher()
+	hasher.writeCode(csdb.GetCode(address))
+	err := csdb.ForEachStorage(address, func(key, value ethcmn.Hash) bool {
+		hasher.writeState(key, value)
+		return false
+	})
 	if err != nil {
 		panic(err)
 	}
-	for ; iterator.Valid(); iterator.Next() {
-		k.SetStateDirectly(ctx, address, ethcmn.BytesToHash(iterator.Key()[len(prefix):]), ethcmn.BytesToHash(iterator.Value()))
-		storageCount++
+	return hasher.sum()
+}
+
+// readAccountCode reads the code of the account exported in the mode
+func readAccountCode(logger log.Logger, mode string, address ethcmn.Address, codeHash []byte) []byte {
+	switch mode {
+	case filesMode:
+		return readCodeFromFile(logger, address)
+	case dbMode:
+		code, err := evmByteCodeDB.Get(append(types.KeyPrefixCode, codeHash...))
+		if err != nil {
+			panic(err)
+		}
+		return code
+	default:
+		panic("unsupported import mode")
 	}
-	iterator.Close()
 }
 
-func exportStorage(ctx sdk.Context, k Keeper, addr ethcmn.Address, db dbm.DB) {
-	defer finishGoroutine()
+// readAccountStorage reads the storage of the account exported in the mode in the order of the keys
+func readAccountStorage(logger log.Logger, mode string, address ethcmn.Address, cb func(key, value ethcmn.Hash)) {
+	switch mode {
+	case filesMode:
+		readStorageFromFile(logger, address, cb)
+	case dbMode:
+		prefix := types.AddressStoragePrefix(address)
+		iterator, err := evmStateDB.Iterator(prefix, sdk.PrefixEndBytes(prefix))
+		if err != nil {
+			panic(err)
+		}
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			cb(ethcmn.BytesToHash(iterator.Key()[len(prefix):]), ethcmn.BytesToHash(iterator.Value()))
+		}
+	default:
+		panic("unsupported import mode")
+	}
+}
 
+func exportStorage(ctx sdk.Context, k Keeper, addr ethcmn.Address, db dbm.DB, hasher accountHasher) {
 	prefix := types.AddressStoragePrefix(addr)
 	err := k.ForEachStorage(ctx, addr, func(key, value ethcmn.Hash) bool {
+		hasher.writeState(key, value)
 		db.Set(append(prefix, key.Bytes()...), value.Bytes())
 		atomic.AddUint64(&storageCount, 1)
 		return false
@@ -187,6 +317,10 @@ func initEVMDB(path string) {
 func initGoroutinePool(goroutineNum uint64) {
 	if goroutineNum == 0 {
 		goroutineNum = uint64(runtime.NumCPU()-1) * 16
+		// there is no spare cpu on the single-core machine
+		if goroutineNum == 0 {
+			goroutineNum = 16
+		}
 	}
 	goroutinePool = make(chan struct{}, goroutineNum)
 }
@@ -238,12 +372,11 @@ func writeOneLine(writer *bufio.Writer, data string) {
 //    Second, format data, then write them into file
 //    note: there is no way of adding log when ExportGenesis, because it will generate many logs in genesis.json
 // ************************************************************************************************************
-// syncWriteAccountCode synchronize the process of writing types.Code into individual file.
+// writeAccountCode writes types.Code into individual file.
 // It doesn't create file when there is no code linked to an account
-func syncWriteAccountCode(ctx sdk.Context, k Keeper, address ethcmn.Address) {
-	defer finishGoroutine()
-
+func writeAccountCode(ctx sdk.Context, k Keeper, address ethcmn.Address, hasher accountHasher) {
 	code := k.GetCode(ctx, address)
+	hasher.writeCode(code)
 	if len(code) != 0 {
 		file := createFile(filepath.Join(codePath, address.String()+codeFileSuffix))
 		writer := bufio.NewWriter(file)
@@ -253,11 +386,9 @@ func syncWriteAccountCode(ctx sdk.Context, k Keeper, address ethcmn.Address) {
 	}
 }
 
-// syncWriteAccountStorage synchronize the process of writing types.Storage into individual file
+// writeAccountStorage writes types.Storage into individual file
 // It will delete the file when there is no storage linked to a contract
-func syncWriteAccountStorage(ctx sdk.Context, k Keeper, address ethcmn.Address) {
-	defer finishGoroutine()
-
+func writeAccountStorage(ctx sdk.Context, k Keeper, address ethcmn.Address, hasher accountHasher) {
 	filename := filepath.Join(storagePath, address.String()+storageFileSuffix)
 	index := 0
 	defer func() {
@@ -276,6 +407,7 @@ func syncWriteAccountStorage(ctx sdk.Context, k Keeper, address ethcmn.Address)
 
 	// call this function, used for iterating all the key&value based on an address
 	err := k.ForEachStorage(ctx, address, func(key, value ethcmn.Hash) bool {
+		hasher.writeState(key, value)
 		writeOneLine(writer, fmt.Sprintf("%s:%s\n", key.Hex(), value.Hex()))
 		index++
 		return false
@@ -290,54 +422,47 @@ func syncWriteAccountStorage(ctx sdk.Context, k Keeper, address ethcmn.Address)
 //    First, get data from local file
 //    Second, format data, then set them into db
 // ************************************************************************************************************
-// syncReadCodeFromFile synchronize the process of setting types.Code into evm db when InitGenesis
-func syncReadCodeFromFile(ctx sdk.Context, logger log.Logger, k Keeper, address ethcmn.Address, codeHash []byte) {
-	defer finishGoroutine()
-
+// readCodeFromFile reads types.Code of the account from its file
+func readCodeFromFile(logger log.Logger, address ethcmn.Address) []byte {
 	codeFilePath := filepath.Join(codePath, address.String()+codeFileSuffix)
-	if pathExist(codeFilePath) {
-		logger.Debug("start loading code", "filename", address.String()+codeFileSuffix)
-		bin, err := ioutil.ReadFile(codeFilePath)
-		if err != nil {
-			panic(err)
-		}
-
-		// make "0x608002412.....80" string into a slice of byte
-		code := hexutil.MustDecode(string(bin))
+	if !pathExist(codeFilePath) {
+		return nil
+	}
 
-		// Set contract code into db, ignoring setting in cache
-		k.SetCodeDirectly(ctx, codeHash, code)
-		atomic.AddUint64(&codeCount, 1)
+	logger.Debug("start loading code", "filename", address.String()+codeFileSuffix)
+	bin, err := ioutil.ReadFile(codeFilePath)
+	if err != nil {
+		panic(err)
 	}
-}
 
-// syncReadStorageFromFile synchronize the process of setting types.Storage into evm db when InitGenesis
-func syncReadStorageFromFile(ctx sdk.Context, logger log.Logger, k Keeper, address ethcmn.Address) {
-	defer finishGoroutine()
+	// make "0x608002412.....80" string into a slice of byte
+	return hexutil.MustDecode(string(bin))
+}
 
+// readStorageFromFile reads types.Storage of the account from its file
+func readStorageFromFile(logger log.Logger, address ethcmn.Address, cb func(key, value ethcmn.Hash)) {
 	storageFilePath := filepath.Join(storagePath, address.String()+storageFileSuffix)
-	if pathExist(storageFilePath) {
-		logger.Debug("start loading storage", "filename", address.String()+storageFileSuffix)
-		f, err := os.Open(storageFilePath)
-		if err != nil {
-			panic(err)
-		}
-		defer f.Close()
-		rd := bufio.NewReader(f)
-		for {
-			// eg. kvStr = "0xc543bf77d2a7bddbeb14b8d8bfa3405a8410be06d8c3e68d5bd5e7b9abd43d39:0x4e584d0000000000000000000000000000000000000000000000000000000006\n"
-			kvStr, err := rd.ReadString('\n')
-			if err != nil || io.EOF == err {
-				break
-			}
-			// remove '\n' in the end of string, then split kvStr based on ':'
-			kvPair := strings.Split(strings.ReplaceAll(kvStr, "\n", ""), ":")
-			//convert hexStr into common.Hash struct
-			key, value := ethcmn.HexToHash(kvPair[0]), ethcmn.HexToHash(kvPair[1])
-			// Set the state of key&value into db, ignoring setting in cache
-			k.SetStateDirectly(ctx, address, key, value)
-			atomic.AddUint64(&storageCount, 1)
+	if !pathExist(storageFilePath) {
+		return
+	}
+
+	logger.Debug("start loading storage", "filename", address.String()+storageFileSuffix)
+	f, err := os.Open(storageFilePath)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+	rd := bufio.NewReader(f)
+	for {
+		// eg. kvStr = "0xc543bf77d2a7bddbeb14b8d8bfa3405a8410be06d8c3e68d5bd5e7b9abd43d39:0x4e584d0000000000000000000000000000000000000000000000000000000006\n"
+		kvStr, err := rd.ReadString('\n')
+		if err != nil || io.EOF == err {
+			break
 		}
+		// remove '\n' in the end of string, then split kvStr based on ':'
+		kvPair := strings.Split(strings.ReplaceAll(kvStr, "\n", ""), ":")
+		//convert hexStr into common.Hash struct
+		cb(ethcmn.HexToHash(kvPair[0]), ethcmn.HexToHash(kvPair[1]))
 	}
 }
 
@@ -361,3 +486,11 @@ func CloseDB() {
 	evmByteCodeDB.Close()
 	evmStateDB.Close()
 }
+
+// finishCheckpoint waits for all the accounts to be processed, and closes the checkpoint
+func finishCheckpoint() ExportProgress {
+	wg.Wait()
+	progress := checkpoint.finish()
+	checkpoint = nil
+	return progress
+}
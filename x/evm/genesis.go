@@ -2,6 +2,7 @@ package evm
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -27,6 +28,9 @@ func InitGenesis(ctx sdk.Context, k Keeper, accountKeeper types.AccountKeeper, d
 		mode = defaultMode
 	}
 	initImportEnv(viper.GetString(server.FlagEvmImportPath), mode, viper.GetUint64(server.FlagGoroutineNum))
+	if mode != defaultMode {
+		checkpoint.start(importOperation, mode, ctx.BlockHeight(), uint64(len(data.Accounts)))
+	}
 
 	for _, account := range data.Accounts {
 		address := ethcmn.HexToAddress(account.Address)
@@ -64,15 +68,18 @@ func InitGenesis(ctx sdk.Context, k Keeper, accountKeeper types.AccountKeeper, d
 		case filesMode:
 			importFromFile(ctx, logger, k, address, ethAcc.CodeHash)
 		case dbMode:
-			importFromDB(ctx, k, address, ethAcc.CodeHash)
+			importFromDB(ctx, logger, k, address, ethAcc.CodeHash)
 		default:
 			panic("unsupported import mode")
 		}
 	}
 
-	// wait for all data to be imported from files
-	if mode == filesMode {
-		wg.Wait()
+	// wait for all data to be imported from files or db
+	if mode == filesMode || mode == dbMode {
+		if progress := finishCheckpoint(); len(progress.Mismatched) != 0 {
+			panic(fmt.Sprintf("the integrity check of %d accounts in the evm export failed: %s",
+				len(progress.Mismatched), strings.Join(progress.Mismatched, ", ")))
+		}
 	}
 	logger.Debug("Import finished", "code", codeCount, "storage", storageCount)
 
@@ -89,7 +96,15 @@ func InitGenesis(ctx sdk.Context, k Keeper, accountKeeper types.AccountKeeper, d
 		panic(err)
 	}
 
+	// deploy the native contracts once, they are imported with the accounts if the genesis is exported from a chain
+	// which has deployed them
+	if err := k.DeployNativeContracts(ctx); err != nil {
+		panic(err)
+	}
+
 	k.SetChainConfig(ctx, data.ChainConfig)
+	k.SetContractDeploymentWhitelist(ctx, data.ContractDeploymentWhitelist)
+	k.SetContractBlockedList(ctx, data.ContractBlockedList)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -102,7 +117,14 @@ func ExportGenesis(ctx sdk.Context, k Keeper, ak types.AccountKeeper) GenesisSta
 		// for some UT
 		mode = defaultMode
 	}
-	initExportEnv(viper.GetString(server.FlagEvmExportPath), mode, viper.GetUint64(server.FlagGoroutineNum))
+	initExportEnv(viper.GetString(server.FlagEvmExportPath), mode, viper.GetUint64(server.FlagGoroutineNum),
+		ctx.BlockHeight())
+	switch mode {
+	case filesMode, dbMode:
+		checkpoint.start(exportOperation, mode, ctx.BlockHeight(), countEthAccounts(ctx, ak))
+	case verifyMode:
+		checkpoint.start(verifyOperation, exportFormat, ctx.BlockHeight(), countEthAccounts(ctx, ak))
+	}
 
 	// nolint: prealloc
 	var ethGenAccounts []types.GenesisAccount
@@ -133,7 +155,8 @@ func ExportGenesis(ctx sdk.Context, k Keeper, ak types.AccountKeeper) GenesisSta
 			exportToFile(ctx, k, addr)
 		case dbMode:
 			exportToDB(ctx, k, addr, ethAccount.CodeHash)
-
+		case verifyMode:
+			verifyAccount(ctx, logger, k, addr, ethAccount.CodeHash)
 		default:
 			panic("unsupported export mode")
 		}
@@ -148,15 +171,31 @@ func ExportGenesis(ctx sdk.Context, k Keeper, ak types.AccountKeeper) GenesisSta
 		return false
 	})
 	// wait for all data to be written into files or db
-	if mode == filesMode || mode == dbMode {
-		wg.Wait()
+	if mode != defaultMode {
+		if progress := finishCheckpoint(); len(progress.Mismatched) != 0 {
+			panic(fmt.Sprintf("%d accounts in the evm export are different from the live state: %s",
+				len(progress.Mismatched), strings.Join(progress.Mismatched, ", ")))
+		}
 	}
 	logger.Debug("Export finished", "code", codeCount, "storage", storageCount)
 
 	config, _ := k.GetChainConfig(ctx)
 	return GenesisState{
-		Accounts:    ethGenAccounts,
-		ChainConfig: config,
-		Params:      k.GetParams(ctx),
+		Accounts:                    ethGenAccounts,
+		ChainConfig:                 config,
+		Params:                      k.GetParams(ctx),
+		ContractDeploymentWhitelist: k.GetContractDeploymentWhitelist(ctx),
+		ContractBlockedList:         k.GetContractBlockedList(ctx),
 	}
 }
+
+// countEthAccounts returns the number of the EthAccounts, whose evm state is exported
+func countEthAccounts(ctx sdk.Context, ak types.AccountKeeper) (count uint64) {
+	ak.IterateAccounts(ctx, func(account authexported.Account) bool {
+		if _, ok := account.(*ethermint.EthAccount); ok {
+			count++
+		}
+		return false
+	})
+	return
+}
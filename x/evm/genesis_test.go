@@ -314,9 +314,10 @@ func (suite *EvmTestSuite) TestExport_db() {
 	var exportState types.GenesisState
 	suite.Require().NotPanics(func() {
 		exportState = evm.ExportGenesis(suite.ctx, *suite.app.EvmKeeper, suite.app.AccountKeeper)
-		suite.Require().Equal(exportState.Accounts[0].Address, evmAcc.Address)
-		suite.Require().Equal(exportState.Accounts[0].Code, hexutil.Bytes(nil))
-		suite.Require().Equal(exportState.Accounts[0].Storage, types.Storage(nil))
+		exportAcc := findGenesisAccount(exportState.Accounts, evmAcc.Address)
+		suite.Require().NotNil(exportAcc)
+		suite.Require().Equal(exportAcc.Code, hexutil.Bytes(nil))
+		suite.Require().Equal(exportAcc.Storage, types.Storage(nil))
 	})
 	suite.Require().DirExists(filepath.Join(tmpPath, "evm_bytecode.db"))
 	suite.Require().DirExists(filepath.Join(tmpPath, "evm_state.db"))
@@ -407,9 +408,10 @@ func (suite *EvmTestSuite) TestExport_files() {
 	var exportState types.GenesisState
 	suite.Require().NotPanics(func() {
 		exportState = evm.ExportGenesis(suite.ctx, *suite.app.EvmKeeper, suite.app.AccountKeeper)
-		suite.Require().Equal(exportState.Accounts[0].Address, evmAcc.Address)
-		suite.Require().Equal(exportState.Accounts[0].Code, hexutil.Bytes(nil))
-		suite.Require().Equal(exportState.Accounts[0].Storage, types.Storage(nil))
+		exportAcc := findGenesisAccount(exportState.Accounts, evmAcc.Address)
+		suite.Require().NotNil(exportAcc)
+		suite.Require().Equal(exportAcc.Code, hexutil.Bytes(nil))
+		suite.Require().Equal(exportAcc.Storage, types.Storage(nil))
 	})
 	suite.Require().DirExists(filepath.Join(tmpPath, "code"))
 	suite.Require().DirExists(filepath.Join(tmpPath, "storage"))
@@ -442,3 +444,139 @@ func testImport_files(suite *EvmTestSuite,
 		})
 	})
 }
+
+func (suite *EvmTestSuite) TestExport_checkpoint() {
+	viper.SetEnvPrefix("OKEXCHAIN")
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_"))
+	viper.AutomaticEnv()
+
+	privkey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	address := ethcmn.HexToAddress(privkey.PubKey().Address().String())
+
+	code := []byte{1, 2, 3}
+	ethAccount := ethermint.EthAccount{
+		BaseAccount: &auth.BaseAccount{
+			Address: address.Bytes(),
+		},
+		CodeHash: ethcrypto.Keccak256(code),
+	}
+	suite.app.AccountKeeper.SetAccount(suite.ctx, ethAccount)
+
+	storage := types.Storage{
+		{Key: common.BytesToHash([]byte("key1")), Value: common.BytesToHash([]byte("value1"))},
+		{Key: common.BytesToHash([]byte("key2")), Value: common.BytesToHash([]byte("value2"))},
+	}
+	os.Setenv("OKEXCHAIN_EVM_IMPORT_MODE", "default")
+	evm.InitGenesis(suite.ctx, *suite.app.EvmKeeper, suite.app.AccountKeeper, types.GenesisState{
+		Params:   types.DefaultParams(),
+		Accounts: []types.GenesisAccount{{Address: address.String(), Code: code, Storage: storage}},
+	})
+
+	tmpPath := "./test_tmp_db"
+	os.Setenv("OKEXCHAIN_EVM_EXPORT_MODE", "db")
+	os.Setenv("OKEXCHAIN_EVM_EXPORT_PATH", tmpPath)
+	defer func() {
+		os.Setenv("OKEXCHAIN_EVM_IMPORT_MODE", "default")
+		os.Setenv("OKEXCHAIN_EVM_EXPORT_MODE", "default")
+		os.RemoveAll(tmpPath)
+	}()
+
+	exportState := evm.ExportGenesis(suite.ctx, *suite.app.EvmKeeper, suite.app.AccountKeeper)
+	evm.CloseDB()
+	progress, err := evm.LoadExportProgress(tmpPath)
+	suite.Require().NoError(err)
+	suite.Require().True(progress.Finished)
+	suite.Require().Equal(progress.Total, progress.Done)
+	suite.Require().Zero(progress.Skipped)
+
+	// the accounts exported are skipped by the export of the same height
+	evm.ExportGenesis(suite.ctx, *suite.app.EvmKeeper, suite.app.AccountKeeper)
+	evm.CloseDB()
+	progress, err = evm.LoadExportProgress(tmpPath)
+	suite.Require().NoError(err)
+	suite.Require().Equal(progress.Total, progress.Skipped)
+
+	// the export is the same as the live state
+	os.Setenv("OKEXCHAIN_EVM_EXPORT_MODE", "verify")
+	suite.Require().NotPanics(func() {
+		evm.ExportGenesis(suite.ctx, *suite.app.EvmKeeper, suite.app.AccountKeeper)
+	})
+	evm.CloseDB()
+	progress, err = evm.LoadExportProgress(tmpPath)
+	suite.Require().NoError(err)
+	suite.Require().Equal("verify", progress.Operation)
+	suite.Require().Equal(progress.Total, progress.Done)
+	suite.Require().Empty(progress.Mismatched)
+
+	// the live state is changed after the export
+	suite.app.EvmKeeper.SetStateDirectly(suite.ctx, address, storage[0].Key, common.BytesToHash([]byte("changed")))
+	suite.Require().Panics(func() {
+		evm.ExportGenesis(suite.ctx, *suite.app.EvmKeeper, suite.app.AccountKeeper)
+	})
+	evm.CloseDB()
+	progress, err = evm.LoadExportProgress(tmpPath)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{address.String()}, progress.Mismatched)
+
+	// the corrupted export fails the integrity check of the import
+	stateDB, err := sdk.NewLevelDB("evm_state", tmpPath)
+	suite.Require().NoError(err)
+	suite.Require().NoError(stateDB.Set(append(types.AddressStoragePrefix(address), storage[0].Key.Bytes()...),
+		common.BytesToHash([]byte("corrupted")).Bytes()))
+	stateDB.Close()
+
+	suite.SetupTest() // reset
+	suite.app.AccountKeeper.SetAccount(suite.ctx, ethAccount)
+	os.Setenv("OKEXCHAIN_EVM_IMPORT_MODE", "db")
+	os.Setenv("OKEXCHAIN_EVM_IMPORT_PATH", tmpPath)
+	suite.Require().Panics(func() {
+		evm.InitGenesis(suite.ctx, *suite.app.EvmKeeper, suite.app.AccountKeeper, exportState)
+	})
+	evm.CloseDB()
+	progress, err = evm.LoadExportProgress(tmpPath)
+	suite.Require().NoError(err)
+	suite.Require().Equal("import", progress.Operation)
+	suite.Require().Equal([]string{address.String()}, progress.Mismatched)
+
+	// the interrupted import starts over after the restart with the fixed export
+	stateDB, err = sdk.NewLevelDB("evm_state", tmpPath)
+	suite.Require().NoError(err)
+	suite.Require().NoError(stateDB.Set(append(types.AddressStoragePrefix(address), storage[0].Key.Bytes()...),
+		storage[0].Value.Bytes()))
+	stateDB.Close()
+
+	os.Setenv("OKEXCHAIN_EVM_IMPORT_MODE", "default")
+	suite.SetupTest() // restart with the empty state
+	suite.app.AccountKeeper.SetAccount(suite.ctx, ethAccount)
+	os.Setenv("OKEXCHAIN_EVM_IMPORT_MODE", "db")
+	suite.Require().NotPanics(func() {
+		evm.InitGenesis(suite.ctx, *suite.app.EvmKeeper, suite.app.AccountKeeper, exportState)
+	})
+	evm.CloseDB()
+	progress, err = evm.LoadExportProgress(tmpPath)
+	suite.Require().NoError(err)
+	suite.Require().Equal("import", progress.Operation)
+	suite.Require().True(progress.Finished)
+	suite.Require().Equal(progress.Total, progress.Done)
+	suite.Require().Zero(progress.Skipped)
+	suite.Require().Empty(progress.Mismatched)
+	suite.Require().Equal(code, suite.app.EvmKeeper.GetCode(suite.ctx, address))
+	var imported types.Storage
+	suite.app.EvmKeeper.ForEachStorage(suite.ctx, address, func(key, value ethcmn.Hash) bool {
+		imported = append(imported, types.State{Key: key, Value: value})
+		return false
+	})
+	suite.Require().ElementsMatch(storage, imported)
+}
+
+// findGenesisAccount returns the account of address in accounts, which include the native contracts deployed by
+// InitGenesis
+func findGenesisAccount(accounts []types.GenesisAccount, address string) *types.GenesisAccount {
+	for i := range accounts {
+		if accounts[i].Address == address {
+			return &accounts[i]
+		}
+	}
+	return nil
+}
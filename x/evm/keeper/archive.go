@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+
+	ethermint "github.com/okex/okexchain/app/types"
+	"github.com/okex/okexchain/x/evm/types"
+)
+
+var emptyCodeHash = ethcrypto.Keccak256(nil)
+
+// CommitArchive records the state written in the committed block into the archive index. It's called after the block
+// is committed with the context of the committed state, so it's out of the state machine and never changes the state
+// of the block. A full snapshot of the state is recorded at the first block or when some blocks are missed, e.g. the
+// archive index was disabled for a while
+func (k Keeper) CommitArchive(ctx sdk.Context) {
+	index := types.GetArchiveIndex()
+	if index == nil || k.archiveTracker == nil {
+		return
+	}
+	accounts, storage := k.archiveTracker.Flush()
+	height := ctx.BlockHeight()
+
+	latest := index.LatestHeight()
+	if height <= latest {
+		// the block has been recorded before the node restarted
+		return
+	}
+
+	diff := types.NewArchiveDiff()
+	evmDenom := k.GetParams(ctx).EvmDenom
+	if latest == 0 || height > latest+1 {
+		k.Logger(ctx).Info("record the snapshot of the evm state into the archive index", "height", height,
+			"latest", latest)
+		k.accountKeeper.IterateAccounts(ctx, func(account authexported.Account) bool {
+			k.archiveAccount(ctx, evmDenom, diff, ethcmn.BytesToAddress(account.GetAddress()))
+			return false
+		})
+		iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixStorage)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			key := iterator.Key()[len(types.KeyPrefixStorage):]
+			addr := ethcmn.BytesToAddress(key[:ethcmn.AddressLength])
+			diff.SetStorage(addr, ethcmn.BytesToHash(key[ethcmn.AddressLength:]), ethcmn.BytesToHash(iterator.Value()))
+		}
+	} else {
+		for addr := range accounts {
+			k.archiveAccount(ctx, evmDenom, diff, addr)
+		}
+		for addr, keys := range storage {
+			store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AddressStoragePrefix(addr))
+			for key := range keys {
+				diff.SetStorage(addr, key, ethcmn.BytesToHash(store.Get(key.Bytes())))
+			}
+		}
+	}
+
+	if err := index.Commit(height, diff); err != nil {
+		k.Logger(ctx).Error("failed to commit the archive index", "height", height, "error", err)
+	}
+}
+
+// archiveAccount sets the current state of the account and its code into the diff. The removed account is recorded
+// with the empty state
+func (k Keeper) archiveAccount(ctx sdk.Context, evmDenom string, diff types.ArchiveDiff, addr ethcmn.Address) {
+	acc := k.accountKeeper.GetAccount(ctx, addr.Bytes())
+	if acc == nil {
+		diff.Accounts[addr] = types.ArchiveAccount{Balance: ethcmn.Big0}
+		return
+	}
+
+	archiveAcc := types.ArchiveAccount{
+		Balance: acc.GetCoins().AmountOf(evmDenom).BigInt(),
+		Nonce:   acc.GetSequence(),
+	}
+	// only the EthAccounts have the code
+	if ethAcc, ok := acc.(*ethermint.EthAccount); ok {
+		archiveAcc.CodeHash = ethAcc.CodeHash
+		if len(ethAcc.CodeHash) != 0 && !bytes.Equal(ethAcc.CodeHash, emptyCodeHash) &&
+			!types.GetArchiveIndex().HasCode(ethAcc.CodeHash) {
+			store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCode)
+			diff.Codes[ethcmn.BytesToHash(ethAcc.CodeHash)] = store.Get(ethAcc.CodeHash)
+		}
+	}
+	diff.Accounts[addr] = archiveAcc
+}
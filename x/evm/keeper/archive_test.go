@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/okex/okexchain/app"
+	"github.com/okex/okexchain/app/utils"
+	"github.com/okex/okexchain/x/evm/keeper"
+	"github.com/okex/okexchain/x/evm/types"
+)
+
+type ArchiveTestSuite struct {
+	suite.Suite
+
+	home    string
+	ctx     sdk.Context
+	app     *app.OKExChainApp
+	querier sdk.Querier
+	address ethcmn.Address
+	key     ethcmn.Hash
+}
+
+func TestArchiveTestSuite(t *testing.T) {
+	suite.Run(t, new(ArchiveTestSuite))
+}
+
+func (suite *ArchiveTestSuite) SetupTest() {
+	var err error
+	suite.home, err = ioutil.TempDir("", "evm_archive")
+	suite.Require().NoError(err)
+	viper.Set("home", suite.home)
+	viper.Set(types.FlagEnableArchiveIndex, true)
+
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 1, ChainID: "ethermint-3"})
+	suite.querier = keeper.NewQuerier(*suite.app.EvmKeeper)
+	suite.address = ethcmn.HexToAddress(addrHex)
+	suite.key = ethcmn.BytesToHash([]byte("key"))
+}
+
+func (suite *ArchiveTestSuite) TearDownTest() {
+	viper.Set(types.FlagEnableArchiveIndex, false)
+	viper.Set("home", "")
+	os.RemoveAll(suite.home)
+}
+
+// commitBlock changes the balance and the storage of the address in the block and records the block into the archive
+// index
+func (suite *ArchiveTestSuite) commitBlock(height int64, balance int64, value ethcmn.Hash) {
+	suite.ctx = suite.ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+	if balance >= 0 {
+		csdb := types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+		csdb.SetBalance(suite.address, big.NewInt(balance))
+		csdb.SetState(suite.address, suite.key, value)
+		suite.Require().NoError(csdb.Finalise(false))
+	}
+	suite.app.EvmKeeper.CommitArchive(suite.ctx)
+}
+
+func (suite *ArchiveTestSuite) queryAccount(height int64) (types.QueryResAccount, error) {
+	return suite.queryAccountOf(suite.address, height)
+}
+
+func (suite *ArchiveTestSuite) queryAccountOf(address ethcmn.Address, height int64) (types.QueryResAccount, error) {
+	var res types.QueryResAccount
+	bz, err := suite.querier(suite.ctx, []string{types.QueryArchiveAccount, address.Hex(),
+		fmt.Sprint(height)}, abci.RequestQuery{})
+	if err == nil {
+		suite.app.Codec().MustUnmarshalJSON(bz, &res)
+	}
+	return res, err
+}
+
+func (suite *ArchiveTestSuite) queryStorage(height int64) (ethcmn.Hash, error) {
+	var res types.QueryResStorage
+	bz, err := suite.querier(suite.ctx, []string{types.QueryArchiveStorage, suite.address.Hex(), suite.key.Hex(),
+		fmt.Sprint(height)}, abci.RequestQuery{})
+	if err != nil {
+		return ethcmn.Hash{}, err
+	}
+	suite.app.Codec().MustUnmarshalJSON(bz, &res)
+	return ethcmn.BytesToHash(res.Value), nil
+}
+
+func (suite *ArchiveTestSuite) TestArchive() {
+	suite.commitBlock(1, 100, ethcmn.Hash{0x1})
+	suite.commitBlock(2, 50, ethcmn.Hash{0x2})
+	suite.commitBlock(3, -1, ethcmn.Hash{})
+
+	// the accounts written by the other modules are tracked by the account keeper
+	suite.ctx = suite.ctx.WithBlockHeight(4).WithEventManager(sdk.NewEventManager())
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewDecFromBigIntWithPrec(big.NewInt(20), sdk.Precision)))
+	suite.Require().NoError(suite.app.SupplyKeeper.SendCoinsFromAccountToModule(suite.ctx, suite.address.Bytes(),
+		auth.FeeCollectorName, coins))
+	suite.app.EvmKeeper.CommitArchive(suite.ctx)
+
+	for _, tc := range []struct {
+		height     int64
+		expBalance int64
+		expValue   ethcmn.Hash
+	}{
+		{1, 100, ethcmn.Hash{0x1}},
+		{2, 50, ethcmn.Hash{0x2}},
+		{3, 50, ethcmn.Hash{0x2}},
+		{4, 30, ethcmn.Hash{0x2}},
+	} {
+		acc, err := suite.queryAccount(tc.height)
+		suite.Require().NoError(err, tc.height)
+		suite.Require().Equal(big.NewInt(tc.expBalance), utils.MustUnmarshalBigInt(acc.Balance), tc.height)
+
+		value, err := suite.queryStorage(tc.height)
+		suite.Require().NoError(err, tc.height)
+		suite.Require().Equal(tc.expValue, value, tc.height)
+	}
+
+	// the balance of the module account is recorded too
+	feeCollector := ethcmn.BytesToAddress(suite.app.SupplyKeeper.GetModuleAddress(auth.FeeCollectorName))
+	acc, err := suite.queryAccountOf(feeCollector, 4)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(20), utils.MustUnmarshalBigInt(acc.Balance))
+
+	_, err = suite.queryAccount(5)
+	suite.Require().True(types.ErrStatePruned.Is(err))
+
+	// a new snapshot is recorded after some blocks are missed, and the history before them is kept
+	suite.commitBlock(10, 10, ethcmn.Hash{0x3})
+	_, err = suite.queryAccount(5)
+	suite.Require().True(types.ErrStatePruned.Is(err))
+	acc, err = suite.queryAccount(4)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(30), utils.MustUnmarshalBigInt(acc.Balance))
+	acc, err = suite.queryAccount(10)
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(10), utils.MustUnmarshalBigInt(acc.Balance))
+	value, err := suite.queryStorage(10)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ethcmn.Hash{0x3}, value)
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/evm/types"
+)
+
+// SetContractDeploymentWhitelist sets the target address list into whitelist store
+func (k Keeper) SetContractDeploymentWhitelist(ctx sdk.Context, addrList types.AddressList) {
+	types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx).SetContractDeploymentWhitelist(addrList)
+}
+
+// DeleteContractDeploymentWhitelist deletes the target address list from whitelist store
+func (k Keeper) DeleteContractDeploymentWhitelist(ctx sdk.Context, addrList types.AddressList) {
+	types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx).DeleteContractDeploymentWhitelist(addrList)
+}
+
+// GetContractDeploymentWhitelist gets the whole contract deployment whitelist currently
+func (k Keeper) GetContractDeploymentWhitelist(ctx sdk.Context) types.AddressList {
+	return types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx).GetContractDeploymentWhitelist()
+}
+
+// IsDeployerInWhitelist checks whether the deployer is in the whitelist as a distributor
+func (k Keeper) IsDeployerInWhitelist(ctx sdk.Context, deployerAddr sdk.AccAddress) bool {
+	return types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx).IsDeployerInWhitelist(deployerAddr)
+}
+
+// SetContractBlockedList sets the target address list into blocked list store
+func (k Keeper) SetContractBlockedList(ctx sdk.Context, addrList types.AddressList) {
+	types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx).SetContractBlockedList(addrList)
+}
+
+// DeleteContractBlockedList deletes the target address list from blocked list store
+func (k Keeper) DeleteContractBlockedList(ctx sdk.Context, addrList types.AddressList) {
+	types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx).DeleteContractBlockedList(addrList)
+}
+
+// GetContractBlockedList gets the whole contract blocked list currently
+func (k Keeper) GetContractBlockedList(ctx sdk.Context) types.AddressList {
+	return types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx).GetContractBlockedList()
+}
+
+// IsContractInBlockedList checks whether the contract address is in the blocked list
+func (k Keeper) IsContractInBlockedList(ctx sdk.Context, contractAddr sdk.AccAddress) bool {
+	return types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx).IsContractInBlockedList(contractAddr)
+}
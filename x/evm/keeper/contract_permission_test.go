@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/okex/okexchain/x/evm/types"
+)
+
+var (
+	addr1 = sdk.AccAddress([]byte("address_1___________"))
+	addr2 = sdk.AccAddress([]byte("address_2___________"))
+)
+
+func (suite *KeeperTestSuite) TestContractDeploymentWhitelist() {
+	suite.Require().Empty(suite.app.EvmKeeper.GetContractDeploymentWhitelist(suite.ctx))
+
+	suite.app.EvmKeeper.SetContractDeploymentWhitelist(suite.ctx, types.AddressList{addr1, addr2})
+	suite.Require().Len(suite.app.EvmKeeper.GetContractDeploymentWhitelist(suite.ctx), 2)
+	suite.Require().True(suite.app.EvmKeeper.IsDeployerInWhitelist(suite.ctx, addr1))
+	suite.Require().True(suite.app.EvmKeeper.IsDeployerInWhitelist(suite.ctx, addr2))
+
+	suite.app.EvmKeeper.DeleteContractDeploymentWhitelist(suite.ctx, types.AddressList{addr1})
+	suite.Require().Equal(types.AddressList{addr2}, suite.app.EvmKeeper.GetContractDeploymentWhitelist(suite.ctx))
+	suite.Require().False(suite.app.EvmKeeper.IsDeployerInWhitelist(suite.ctx, addr1))
+	suite.Require().True(suite.app.EvmKeeper.IsDeployerInWhitelist(suite.ctx, addr2))
+
+	// query
+	res, err := suite.querier(suite.ctx, []string{types.QueryContractDeploymentWhitelist}, abci.RequestQuery{})
+	suite.Require().NoError(err)
+	var whitelist types.AddressList
+	suite.Require().NoError(suite.app.Codec().UnmarshalJSON(res, &whitelist))
+	suite.Require().Equal(types.AddressList{addr2}, whitelist)
+}
+
+func (suite *KeeperTestSuite) TestContractBlockedList() {
+	suite.Require().Empty(suite.app.EvmKeeper.GetContractBlockedList(suite.ctx))
+
+	suite.app.EvmKeeper.SetContractBlockedList(suite.ctx, types.AddressList{addr1, addr2})
+	suite.Require().Len(suite.app.EvmKeeper.GetContractBlockedList(suite.ctx), 2)
+	suite.Require().True(suite.app.EvmKeeper.IsContractInBlockedList(suite.ctx, addr1))
+	suite.Require().True(suite.app.EvmKeeper.IsContractInBlockedList(suite.ctx, addr2))
+
+	suite.app.EvmKeeper.DeleteContractBlockedList(suite.ctx, types.AddressList{addr2})
+	suite.Require().Equal(types.AddressList{addr1}, suite.app.EvmKeeper.GetContractBlockedList(suite.ctx))
+	suite.Require().True(suite.app.EvmKeeper.IsContractInBlockedList(suite.ctx, addr1))
+	suite.Require().False(suite.app.EvmKeeper.IsContractInBlockedList(suite.ctx, addr2))
+
+	// query
+	res, err := suite.querier(suite.ctx, []string{types.QueryContractBlockedList}, abci.RequestQuery{})
+	suite.Require().NoError(err)
+	var blockedList types.AddressList
+	suite.Require().NoError(suite.app.Codec().UnmarshalJSON(res, &blockedList))
+	suite.Require().Equal(types.AddressList{addr1}, blockedList)
+}
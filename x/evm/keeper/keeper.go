@@ -39,6 +39,14 @@ type Keeper struct {
 	paramSpace    params.Subspace
 	supplyKeeper  types.SupplyKeeper
 	bankKeeper    bank.Keeper
+	govKeeper     types.GovKeeper
+
+	// stateful precompiled contracts backed by the native modules
+	nativeContracts        types.NativeContracts
+	nativeContractResolver types.NativeContractResolver
+
+	// tracker of the accounts and storage written in the block, which is nil if the archive index is disabled
+	archiveTracker *types.ArchiveTracker
 
 	// Transaction counter in a block. Used on StateSB's Prepare function.
 	// It is reset to 0 every block on BeginBlock so there's no point in storing the counter
@@ -64,6 +72,10 @@ func NewKeeper(
 		types.InitIndexer(db)
 	}
 
+	if viper.GetBool(types.FlagEnableLogIndex) {
+		types.InitLogIndex(types.LogIndexDb())
+	}
+
 	// NOTE: we pass in the parameter space to the CommitStateDB in order to use custom denominations for the EVM operations
 	return &Keeper{
 		cdc:           cdc,
@@ -75,17 +87,38 @@ func NewKeeper(
 		TxCount:       0,
 		Bloom:         big.NewInt(0),
 		LogSize:       0,
+
+		archiveTracker: types.GetArchiveTracker(),
 	}
 }
 
+// SetGovKeeper sets keeper of gov
+func (k *Keeper) SetGovKeeper(gk types.GovKeeper) {
+	k.govKeeper = gk
+}
+
+// SetNativeContracts sets the native contracts which can be called by the evm
+func (k *Keeper) SetNativeContracts(contracts types.NativeContracts) {
+	k.nativeContracts = contracts
+}
+
+// SetNativeContractResolver sets the resolver of the native contracts created by the native modules at runtime
+func (k *Keeper) SetNativeContractResolver(resolver types.NativeContractResolver) {
+	k.nativeContractResolver = resolver
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) GenerateCSDBParams() types.CommitStateDBParams {
 	return types.CommitStateDBParams{
-		StoreKey:      k.storeKey,
-		ParamSpace:    k.paramSpace,
-		AccountKeeper: k.accountKeeper,
-		SupplyKeeper:  k.supplyKeeper,
-		BankKeeper:    k.bankKeeper,
+		StoreKey:        k.storeKey,
+		ParamSpace:      k.paramSpace,
+		AccountKeeper:   k.accountKeeper,
+		SupplyKeeper:    k.supplyKeeper,
+		BankKeeper:      k.bankKeeper,
+		NativeContracts: k.nativeContracts,
+
+		NativeContractResolver: k.nativeContractResolver,
+		ArchiveTracker:         k.archiveTracker,
 	}
 }
 
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/evm/types"
+)
+
+// AddTxLogs adds the logs in the result data of the evm tx delivered successfully into the log index, so that only the
+// logs of the txs committed with the block are indexed. It's a no-op if the log index is disabled
+func (k Keeper) AddTxLogs(tx sdk.Tx, data []byte) {
+	index := types.GetLogIndex()
+	if index == nil || tx == nil {
+		return
+	}
+
+	// the evm txs have only one msg
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return
+	}
+	switch msgs[0].(type) {
+	case types.MsgEthereumTx, types.MsgEthermint:
+	default:
+		return
+	}
+
+	resultData, err := types.DecodeResultData(data)
+	if err != nil {
+		return
+	}
+	index.AddLogs(resultData.Logs)
+}
+
+// CommitLogIndex indexes the logs of the committed block into the log index. It's called after the block is committed,
+// so it's out of the state machine. It's a no-op if the log index is disabled
+func (k Keeper) CommitLogIndex(ctx sdk.Context) {
+	index := types.GetLogIndex()
+	if index == nil {
+		return
+	}
+	if err := index.Commit(ctx.BlockHeight()); err != nil {
+		k.Logger(ctx).Error("failed to commit the log index", "height", ctx.BlockHeight(), "error", err)
+	}
+}
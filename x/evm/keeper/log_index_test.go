@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/okex/okexchain/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestLogIndex() {
+	types.InitLogIndex(dbm.NewMemDB())
+	index := types.GetLogIndex()
+
+	contract := ethcmn.BytesToAddress([]byte("contract"))
+	newResultData := func(index uint) []byte {
+		data, err := types.EncodeResultData(types.ResultData{
+			Logs: []*ethtypes.Log{{Address: contract, BlockNumber: 10, Index: index}},
+		})
+		suite.Require().NoError(err)
+		return data
+	}
+	ethTx := types.NewMsgEthereumTx(0, &contract, big.NewInt(0), 100000, big.NewInt(1), nil)
+	cosmosTx := auth.NewStdTx([]sdk.Msg{bank.NewMsgSend(suite.address.Bytes(), contract.Bytes(), nil)},
+		auth.StdFee{}, nil, "")
+
+	// only the logs of the evm txs are added
+	suite.app.EvmKeeper.AddTxLogs(ethTx, newResultData(0))
+	suite.app.EvmKeeper.AddTxLogs(cosmosTx, newResultData(1))
+	suite.app.EvmKeeper.AddTxLogs(ethTx, []byte("invalid"))
+	suite.app.EvmKeeper.AddTxLogs(nil, newResultData(2))
+
+	// the logs are indexed when the block is committed
+	_, err := index.GetLogs(types.LogFilter{FromBlock: 10, ToBlock: 10})
+	suite.Require().Error(err)
+	suite.app.EvmKeeper.CommitLogIndex(suite.ctx.WithBlockHeight(10))
+	logs, err := index.GetLogs(types.LogFilter{FromBlock: 10, ToBlock: 10})
+	suite.Require().NoError(err)
+	suite.Require().Len(logs, 1)
+	suite.Require().Equal(uint(0), logs[0].Index)
+}
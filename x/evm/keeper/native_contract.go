@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+
+	"github.com/okex/okexchain/x/evm/types"
+)
+
+// DeployNativeContracts sets the code of native contracts at their addresses if it hasn't been set, so that they can
+// be called like the other contracts. It's called once by InitGenesis
+func (k Keeper) DeployNativeContracts(ctx sdk.Context) error {
+	addrs := make([]ethcmn.Address, 0, len(k.nativeContracts))
+	for addr := range k.nativeContracts {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i].Bytes(), addrs[j].Bytes()) < 0 })
+
+	return k.deployNativeContracts(ctx, addrs...)
+}
+
+// DeployNativeContract sets the code of native contracts at addr, which is used by the native modules to create
+// contracts at runtime. It fails if there is another contract at addr
+func (k Keeper) DeployNativeContract(ctx sdk.Context, addr ethcmn.Address) error {
+	return k.deployNativeContracts(ctx, addr)
+}
+
+func (k Keeper) deployNativeContracts(ctx sdk.Context, addrs ...ethcmn.Address) error {
+	csdb := types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), ctx)
+	deployed := false
+	for _, addr := range addrs {
+		code := csdb.GetCode(addr)
+		if bytes.Equal(code, types.NativeContractCode) {
+			continue
+		}
+		if len(code) != 0 {
+			return sdkerrors.Wrapf(types.ErrContractAddressCollision, "address %s", addr.Hex())
+		}
+		csdb.SetCode(addr, types.NativeContractCode)
+		deployed = true
+	}
+	if !deployed {
+		return nil
+	}
+
+	_, err := csdb.Commit(false)
+	return err
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -13,12 +14,13 @@ import (
 	"github.com/okex/okexchain/x/evm/types"
 
 	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/viper"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 // NewQuerier is the module level router for state queries
 func NewQuerier(keeper Keeper) sdk.Querier {
-	return func(ctx sdk.Context, path []string, _ abci.RequestQuery) ([]byte, error) {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
 		if len(path) < 1 {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
 				"Insufficient parameters, at least 1 parameter is required")
@@ -47,6 +49,20 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryHeightToHash(ctx, path, keeper)
 		case types.QuerySection:
 			return querySection(ctx, path, keeper)
+		case types.QueryTrace:
+			return queryTrace(ctx, req, keeper)
+		case types.QuerySimulateCall:
+			return querySimulateCall(ctx, req, keeper)
+		case types.QueryArchiveAccount:
+			return queryArchiveAccount(path, keeper)
+		case types.QueryArchiveStorage:
+			return queryArchiveStorage(path, keeper)
+		case types.QueryArchiveCode:
+			return queryArchiveCode(path, keeper)
+		case types.QueryContractDeploymentWhitelist:
+			return queryContractDeploymentWhitelist(ctx, keeper)
+		case types.QueryContractBlockedList:
+			return queryContractBlockedList(ctx, keeper)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown query endpoint")
 		}
@@ -264,3 +280,158 @@ func querySection(ctx sdk.Context, path []string, keeper Keeper) ([]byte, error)
 
 	return res, nil
 }
+
+func queryTrace(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	if !viper.GetBool(types.FlagDebugAPI) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "trace is disabled, enable it by --"+types.FlagDebugAPI)
+	}
+
+	var params types.QueryTraceParams
+	if err := json.Unmarshal(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if err := checkTraceParams(params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	results, err := keeper.TraceBlock(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(results)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func querySimulateCall(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QuerySimulateCallParams
+	if err := json.Unmarshal(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	res, err := keeper.SimulateCall(ctx, params)
+	if err != nil {
+		return nil, sdkerrors.New(types.ModuleName, types.CodeSpaceEvmCallFailed, err.Error())
+	}
+
+	// encoded in the same way as the response of app/simulate
+	bz, err := keeper.cdc.MarshalBinaryBare(res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryContractDeploymentWhitelist(ctx sdk.Context, keeper Keeper) ([]byte, error) {
+	whitelist := keeper.GetContractDeploymentWhitelist(ctx)
+	res, err := codec.MarshalJSONIndent(keeper.cdc, whitelist)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryContractBlockedList(ctx sdk.Context, keeper Keeper) ([]byte, error) {
+	blockedList := keeper.GetContractBlockedList(ctx)
+	res, err := codec.MarshalJSONIndent(keeper.cdc, blockedList)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// getArchiveAccount returns the account of path[1] at the height of path[len(path)-1] from the archive index
+func getArchiveAccount(path []string, minLen int) (types.ArchiveAccount, int64, error) {
+	if len(path) < minLen {
+		return types.ArchiveAccount{}, 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("Insufficient parameters, at least %d parameters is required", minLen))
+	}
+
+	index := types.GetArchiveIndex()
+	if index == nil {
+		return types.ArchiveAccount{}, 0, sdkerrors.Wrap(types.ErrArchiveIndexDisabled,
+			"enable it by --"+types.FlagEnableArchiveIndex)
+	}
+
+	height, err := strconv.ParseInt(path[minLen-1], 10, 64)
+	if err != nil {
+		return types.ArchiveAccount{}, 0, sdkerrors.Wrap(types.ErrStrConvertFailed, err.Error())
+	}
+
+	acc, err := index.GetAccount(ethcmn.HexToAddress(path[1]), height)
+	return acc, height, err
+}
+
+func queryArchiveAccount(path []string, keeper Keeper) ([]byte, error) {
+	acc, _, err := getArchiveAccount(path, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := utils.MarshalBigInt(acc.Balance)
+	if err != nil {
+		return nil, err
+	}
+
+	codeHash := acc.CodeHash
+	if len(codeHash) == 0 {
+		codeHash = emptyCodeHash
+	}
+	res := types.QueryResAccount{
+		Balance:  balance,
+		CodeHash: codeHash,
+		Nonce:    acc.Nonce,
+	}
+	// encoded in the same way as the response of queryAccount
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryArchiveStorage(path []string, keeper Keeper) ([]byte, error) {
+	_, height, err := getArchiveAccount(path, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := types.GetArchiveIndex().GetState(ethcmn.HexToAddress(path[1]), ethcmn.HexToHash(path[2]), height)
+	if err != nil {
+		return nil, err
+	}
+
+	res := types.QueryResStorage{Value: val.Bytes()}
+	// encoded in the same way as the response of queryStorage
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryArchiveCode(path []string, keeper Keeper) ([]byte, error) {
+	acc, _, err := getArchiveAccount(path, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	var code []byte
+	if len(acc.CodeHash) != 0 && !bytes.Equal(acc.CodeHash, emptyCodeHash) {
+		if code, err = types.GetArchiveIndex().GetCode(acc.CodeHash); err != nil {
+			return nil, err
+		}
+	}
+
+	res := types.QueryResCode{Code: code}
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
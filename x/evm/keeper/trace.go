@@ -0,0 +1,229 @@
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	ethermint "github.com/okex/okexchain/app/types"
+	"github.com/okex/okexchain/x/evm/types"
+)
+
+// TraceBlock re-executes the ethereum transactions of a block on the state of the previous block, and traces the
+// ones with index >= params.TraceFrom. If params.Call is not nil, it's traced on the state after the transactions.
+// NOTE: the messages other than ethereum transactions in the block are not replayed
+func (k Keeper) TraceBlock(ctx sdk.Context, params types.QueryTraceParams) ([]types.TxTraceResult, error) {
+	chainIDEpoch, err := ethermint.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return nil, err
+	}
+	config, found := k.GetChainConfig(ctx)
+	if !found {
+		return nil, types.ErrChainConfigNotFound
+	}
+
+	header := ctx.BlockHeader()
+	header.Height = params.BlockHeight
+	header.Time = params.BlockTime
+	header.ProposerAddress = params.ProposerAddress
+	ctx = ctx.WithBlockHeader(header)
+	// the hash of previous block is set at BeginBlock
+	k.SetHeightHash(ctx, uint64(params.BlockHeight-1), params.ParentHash)
+
+	var results []types.TxTraceResult
+	txDecoder := types.TxDecoder(k.cdc)
+	txIndex := 0
+	for i, txBytes := range params.Txs {
+		tx, err := txDecoder(txBytes)
+		if err != nil {
+			return nil, err
+		}
+		msg, ok := tx.(types.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+		sender, err := msg.VerifySig(chainIDEpoch)
+		if err != nil {
+			return nil, err
+		}
+
+		txHash := ethcmn.BytesToHash(tmtypes.Tx(txBytes).Hash())
+		st := types.StateTransition{
+			AccountNonce: msg.Data.AccountNonce,
+			Price:        msg.Data.Price,
+			GasLimit:     msg.Data.GasLimit,
+			Recipient:    msg.Data.Recipient,
+			Amount:       msg.Data.Amount,
+			Payload:      msg.Data.Payload,
+			ChainID:      chainIDEpoch,
+			TxHash:       &txHash,
+			Sender:       sender,
+			CoinDenom:    k.GetParams(ctx).EvmDenom,
+		}
+
+		if i < params.TraceFrom {
+			// the failed transactions are replayed as well, since they are charged for gas
+			_, _, _ = k.applyTraceTx(ctx, st, params.BlockHash, txIndex, config)
+			txIndex++
+			continue
+		}
+
+		tracer, stop, err := types.NewTracer(params.Config)
+		if err != nil {
+			return nil, err
+		}
+		st.Tracer = tracer
+		gasUsed, failed, err := k.applyTraceTx(ctx, st, params.BlockHash, txIndex, config)
+		stop()
+		txIndex++
+
+		result := types.TxTraceResult{TxHash: txHash}
+		if err != nil {
+			result.Error = err.Error()
+		} else if result.Result, err = types.GetTraceResult(tracer, gasUsed, failed); err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	if params.Call != nil {
+		result, err := k.traceCall(ctx, *params.Call, params.BlockHash, chainIDEpoch, config, params.Config)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// applyTraceTx applies an ethereum transaction in the same way as the ante handler and the handler of
+// MsgEthereumTx in DeliverTx, returns the gas used and whether the execution is failed.
+// An error is returned if the transaction can't be executed, i.e. the sender can't pay for the gas
+func (k Keeper) applyTraceTx(ctx sdk.Context, st types.StateTransition, blockHash ethcmn.Hash, txIndex int,
+	config types.ChainConfig) (gasUsed uint64, failed bool, err error) {
+	senderAddr := sdk.AccAddress(st.Sender.Bytes())
+
+	// charge sender for gas up to limit and increment the nonce, which are done by ante handler
+	if st.GasLimit != 0 {
+		cost := new(big.Int).Mul(st.Price, new(big.Int).SetUint64(st.GasLimit))
+		fees := sdk.NewCoins(sdk.NewCoin(st.CoinDenom, sdk.NewDecFromBigIntWithPrec(cost, sdk.Precision)))
+		if err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, authtypes.FeeCollectorName, fees); err != nil {
+			return 0, false, err
+		}
+	}
+	if acc := k.accountKeeper.GetAccount(ctx, senderAddr); acc != nil {
+		if err = acc.SetSequence(acc.GetSequence() + 1); err != nil {
+			return 0, false, err
+		}
+		k.accountKeeper.SetAccount(ctx, acc)
+	}
+
+	// the state changes are discarded except the ones of ante handler if the execution fails
+	cacheCtx, write := ctx.CacheContext()
+	gasMeter := sdk.NewGasMeter(st.GasLimit)
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	st.Csdb = types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), cacheCtx)
+	st.Csdb.Prepare(*st.TxHash, blockHash, txIndex)
+	if _, err = st.TransitionDb(cacheCtx, config); err != nil {
+		return gasMeter.GasConsumed(), true, nil
+	}
+	if err = st.RefundGas(cacheCtx); err != nil {
+		return gasMeter.GasConsumed(), true, nil
+	}
+	write()
+	return gasMeter.GasConsumed(), false, nil
+}
+
+// traceCall traces a message in the same way as eth_call, no fee is charged and the state is not committed
+func (k Keeper) traceCall(ctx sdk.Context, args types.CallArgs, blockHash ethcmn.Hash, chainIDEpoch *big.Int,
+	config types.ChainConfig, traceConfig *types.TraceConfig) (types.TxTraceResult, error) {
+	tracer, stop, err := types.NewTracer(traceConfig)
+	if err != nil {
+		return types.TxTraceResult{}, err
+	}
+	defer stop()
+
+	gasUsed, _, execErr := k.simulateCall(ctx, args, nil, blockHash, chainIDEpoch, config, tracer)
+
+	result := types.TxTraceResult{}
+	if result.Result, err = types.GetTraceResult(tracer, gasUsed, execErr != nil); err != nil {
+		result.Error = err.Error()
+	}
+	return result, nil
+}
+
+// simulateCall executes a message in the same way as eth_call on the state with overrides applied.
+// No fee is charged and the state is not committed
+func (k Keeper) simulateCall(ctx sdk.Context, args types.CallArgs, overrides types.StateOverride, blockHash ethcmn.Hash,
+	chainIDEpoch *big.Int, config types.ChainConfig, tracer vm.Tracer) (gasUsed uint64, res *sdk.Result, err error) {
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	csdb := types.CreateEmptyCommitStateDB(k.GenerateCSDBParams(), cacheCtx)
+	if err = overrides.Apply(csdb); err != nil {
+		return 0, nil, err
+	}
+
+	txHash := ethcmn.Hash{}
+	st := types.StateTransition{
+		AccountNonce: csdb.GetNonce(args.From),
+		Price:        args.GetGasPrice(),
+		GasLimit:     uint64(args.Gas),
+		Recipient:    args.To,
+		Amount:       args.GetValue(),
+		Payload:      args.Data,
+		Csdb:         csdb,
+		ChainID:      chainIDEpoch,
+		TxHash:       &txHash,
+		Sender:       args.From,
+		Simulate:     true,
+		CoinDenom:    k.GetParams(ctx).EvmDenom,
+		Tracer:       tracer,
+	}
+	csdb.Prepare(txHash, blockHash, 0)
+
+	executionResult, err := st.TransitionDb(cacheCtx, config)
+	if err != nil {
+		return cacheCtx.GasMeter().GasConsumed(), nil, err
+	}
+	return cacheCtx.GasMeter().GasConsumed(), executionResult.Result, nil
+}
+
+// SimulateCall executes a message with state overrides in the same way as eth_call,
+// it returns the simulation response which is the same as the one of baseapp
+func (k Keeper) SimulateCall(ctx sdk.Context, params types.QuerySimulateCallParams) (sdk.SimulationResponse, error) {
+	chainIDEpoch, err := ethermint.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return sdk.SimulationResponse{}, err
+	}
+	config, found := k.GetChainConfig(ctx)
+	if !found {
+		return sdk.SimulationResponse{}, types.ErrChainConfigNotFound
+	}
+
+	blockHash := k.GetHeightHash(ctx, uint64(ctx.BlockHeight()))
+	gasUsed, res, err := k.simulateCall(ctx, params.Call, params.Overrides, blockHash, chainIDEpoch, config, nil)
+	if err != nil {
+		return sdk.SimulationResponse{}, err
+	}
+	return sdk.SimulationResponse{
+		GasInfo: sdk.GasInfo{GasWanted: uint64(params.Call.Gas), GasUsed: gasUsed},
+		Result:  res,
+	}, nil
+}
+
+// checkTraceParams validates the params of trace query
+func checkTraceParams(params types.QueryTraceParams) error {
+	if params.BlockHeight <= 0 {
+		return fmt.Errorf("invalid block height %d", params.BlockHeight)
+	}
+	if params.TraceFrom < 0 || (params.Call == nil && params.TraceFrom >= len(params.Txs)) {
+		return fmt.Errorf("invalid index %d of %d transactions to trace", params.TraceFrom, len(params.Txs))
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/okex/okexchain/app/crypto/ethsecp256k1"
+	"github.com/okex/okexchain/x/evm/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryTrace() {
+	viper.Set(types.FlagDebugAPI, true)
+	defer viper.Set(types.FlagDebugAPI, false)
+
+	evmParams := types.DefaultParams()
+	evmParams.EnableCreate = true
+	evmParams.EnableCall = true
+	suite.app.EvmKeeper.SetParams(suite.ctx, evmParams)
+
+	privkey, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	sender := ethcmn.BytesToAddress(privkey.PubKey().Address().Bytes())
+	suite.app.EvmKeeper.SetBalance(suite.ctx, sender, big.NewInt(1000000000))
+
+	// PUSH1 1 PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	code := ethcmn.FromHex("0x600160005260206000f3")
+	var txs [][]byte
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		tx := types.NewMsgEthereumTxContract(nonce, big.NewInt(0), 100000, big.NewInt(1), code)
+		suite.Require().NoError(tx.Sign(big.NewInt(3), privkey.ToECDSA()))
+		txs = append(txs, suite.app.Codec().MustMarshalBinaryLengthPrefixed(tx))
+	}
+
+	params := types.QueryTraceParams{
+		BlockHeight: 2,
+		BlockTime:   time.Now().UTC(),
+		Txs:         txs,
+		TraceFrom:   1,
+	}
+	bz, err := json.Marshal(params)
+	suite.Require().NoError(err)
+	res, err := suite.querier(suite.ctx, []string{types.QueryTrace}, abci.RequestQuery{Data: bz})
+	suite.Require().NoError(err)
+
+	var results []types.TxTraceResult
+	suite.Require().NoError(json.Unmarshal(res, &results))
+	suite.Require().Len(results, 1)
+	suite.Require().Empty(results[0].Error)
+
+	var structLogResult types.StructLogExecutionResult
+	suite.Require().NoError(json.Unmarshal(results[0].Result, &structLogResult))
+	suite.Require().False(structLogResult.Failed)
+	suite.Require().Len(structLogResult.StructLogs, 6)
+	suite.Require().Equal("MSTORE", structLogResult.StructLogs[2].Op)
+	suite.Require().Equal(ethcmn.LeftPadBytes([]byte{1}, 32), ethcmn.FromHex(structLogResult.ReturnValue))
+
+	// trace a call with call tracer
+	tracer := "callTracer"
+	params = types.QueryTraceParams{
+		BlockHeight: 2,
+		Call:        &types.CallArgs{From: sender, Gas: hexutil.Uint64(100000), Data: code},
+		Config:      &types.TraceConfig{Tracer: &tracer},
+	}
+	bz, err = json.Marshal(params)
+	suite.Require().NoError(err)
+	res, err = suite.querier(suite.ctx, []string{types.QueryTrace}, abci.RequestQuery{Data: bz})
+	suite.Require().NoError(err)
+	suite.Require().NoError(json.Unmarshal(res, &results))
+	suite.Require().Len(results, 1)
+	var callResult map[string]interface{}
+	suite.Require().NoError(json.Unmarshal(results[0].Result, &callResult))
+	suite.Require().Equal("CREATE", callResult["type"])
+
+	// out of range
+	params = types.QueryTraceParams{BlockHeight: 2, Txs: txs, TraceFrom: 2}
+	bz, err = json.Marshal(params)
+	suite.Require().NoError(err)
+	_, err = suite.querier(suite.ctx, []string{types.QueryTrace}, abci.RequestQuery{Data: bz})
+	suite.Require().Error(err)
+
+	// disabled
+	viper.Set(types.FlagDebugAPI, false)
+	_, err = suite.querier(suite.ctx, []string{types.QueryTrace}, abci.RequestQuery{Data: bz})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQuerySimulateCall() {
+	evmParams := types.DefaultParams()
+	evmParams.EnableCall = true
+	suite.app.EvmKeeper.SetParams(suite.ctx, evmParams)
+
+	// PUSH1 1 SLOAD PUSH1 0 SLOAD ADD PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	code := hexutil.Bytes(ethcmn.FromHex("0x6001546000540160005260206000f3"))
+	contract := ethcmn.HexToAddress("0x1000000000000000000000000000000000000001")
+	slot0, slot1 := ethcmn.BigToHash(big.NewInt(0)), ethcmn.BigToHash(big.NewInt(1))
+
+	csdb := suite.stateDB.WithContext(suite.ctx)
+	csdb.SetCode(contract, code)
+	csdb.SetState(contract, slot0, ethcmn.BigToHash(big.NewInt(1)))
+	csdb.SetState(contract, slot1, ethcmn.BigToHash(big.NewInt(2)))
+	suite.Require().NoError(csdb.Finalise(false))
+	_, err := csdb.Commit(false)
+	suite.Require().NoError(err)
+
+	call := types.CallArgs{From: suite.address, To: &contract, Gas: hexutil.Uint64(100000)}
+	stateDiff := map[ethcmn.Hash]ethcmn.Hash{slot0: ethcmn.BigToHash(big.NewInt(10))}
+	balance := (*hexutil.Big)(big.NewInt(100))
+	nonce := hexutil.Uint64(5)
+	testCases := []struct {
+		msg       string
+		overrides types.StateOverride
+		expRet    int64
+		expPass   bool
+	}{
+		{"no overrides", nil, 3, true},
+		{"state diff", types.StateOverride{contract: {StateDiff: &stateDiff}}, 12, true},
+		{"state", types.StateOverride{contract: {State: &stateDiff}}, 10, true},
+		{"balance and nonce", types.StateOverride{suite.address: {Balance: &balance, Nonce: &nonce}}, 3, true},
+		{"both state and state diff", types.StateOverride{contract: {State: &stateDiff, StateDiff: &stateDiff}}, 0, false},
+	}
+
+	for _, tc := range testCases {
+		bz, err := json.Marshal(types.QuerySimulateCallParams{Call: call, Overrides: tc.overrides})
+		suite.Require().NoError(err)
+		res, err := suite.querier(suite.ctx, []string{types.QuerySimulateCall}, abci.RequestQuery{Data: bz})
+		if !tc.expPass {
+			suite.Require().Error(err, tc.msg)
+			continue
+		}
+		suite.Require().NoError(err, tc.msg)
+
+		var simRes sdk.SimulationResponse
+		suite.Require().NoError(suite.app.Codec().UnmarshalBinaryBare(res, &simRes), tc.msg)
+		resultData, err := types.DecodeResultData(simRes.Result.Data)
+		suite.Require().NoError(err, tc.msg)
+		suite.Require().Equal(ethcmn.BigToHash(big.NewInt(tc.expRet)).Bytes(), resultData.Ret, tc.msg)
+		suite.Require().NotZero(simRes.GasUsed, tc.msg)
+	}
+
+	// the overrides are not committed
+	suite.Require().Equal(ethcmn.BigToHash(big.NewInt(1)), suite.app.EvmKeeper.GetState(suite.ctx, contract, slot0))
+}
@@ -0,0 +1,27 @@
+package precompile
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ammswap "github.com/okex/okexchain/x/ammswap/types"
+	staking "github.com/okex/okexchain/x/staking/types"
+)
+
+// SwapKeeper defines the expected ammswap keeper
+type SwapKeeper interface {
+	GetSwapTokenPair(ctx sdk.Context, tokenPairName string) (ammswap.SwapTokenPair, error)
+	GetPoolTokenAmount(ctx sdk.Context, poolTokenName string) sdk.Dec
+	GetTokenKeeper() ammswap.TokenKeeper
+}
+
+// TokenKeeper defines the expected token keeper
+type TokenKeeper interface {
+	GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.SysCoins
+	SendCoinsFromAccountToAccount(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.SysCoins) error
+}
+
+// StakingKeeper defines the expected staking keeper
+type StakingKeeper interface {
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator staking.Validator, found bool)
+	GetShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (staking.Shares, bool)
+}
@@ -0,0 +1,146 @@
+package precompile
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/okex/okexchain/x/ammswap"
+	"github.com/okex/okexchain/x/evm/types"
+)
+
+// reserved addresses of the native contracts
+var (
+	SwapContractAddress    = ethcmn.HexToAddress("0x0000000000000000000000000000000000001001")
+	TokenContractAddress   = ethcmn.HexToAddress("0x0000000000000000000000000000000000001002")
+	StakingContractAddress = ethcmn.HexToAddress("0x0000000000000000000000000000000000001003")
+)
+
+var (
+	// revertSelector is the selector of Error(string), which is used by solidity to encode the revert reason
+	revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	revertArgs     = abi.Arguments{{Type: mustNewType("string")}}
+
+	errInvalidInput = errors.New("invalid input")
+)
+
+// NewNativeContracts creates all the native contracts with the keepers of native modules
+func NewNativeContracts(swapKeeper ammswap.Keeper, tokenKeeper TokenKeeper,
+	stakingKeeper StakingKeeper) types.NativeContracts {
+	return types.NativeContracts{
+		SwapContractAddress:    NewSwapContract(swapKeeper, ammswap.NewHandler(swapKeeper)),
+		TokenContractAddress:   NewTokenContract(tokenKeeper),
+		StakingContractAddress: NewStakingContract(stakingKeeper),
+	}
+}
+
+// Method is the implementation of an abi method of a native contract
+type Method struct {
+	// Gas is the base gas cost of a call
+	Gas uint64
+	// Write is true if the method changes the state, which is rejected in a static call
+	Write bool
+	// Run executes the call with the unpacked arguments and returns the outputs to be packed
+	Run func(ctx sdk.Context, caller ethcmn.Address, args []interface{}) ([]interface{}, error)
+}
+
+// nativeContract dispatches the abi encoded calls to the methods
+type nativeContract struct {
+	abi     abi.ABI
+	methods map[string]Method
+}
+
+// MustParseABI parses the abi json of a native contract
+func MustParseABI(abiJSON string) abi.ABI {
+	contractABI, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		panic(err)
+	}
+	return contractABI
+}
+
+// NewContract creates a native contract which dispatches the abi encoded calls to the methods by name. The error of
+// a method is returned as the revert reason
+func NewContract(contractABI abi.ABI, methods map[string]Method) types.NativeContract {
+	for name := range contractABI.Methods {
+		if _, found := methods[name]; !found {
+			panic(fmt.Sprintf("method %s of native contract isn't implemented", name))
+		}
+	}
+	return nativeContract{abi: contractABI, methods: methods}
+}
+
+// RequiredGas implements types.NativeContract
+func (nc nativeContract) RequiredGas(input []byte) uint64 {
+	abiMethod, err := nc.method(input)
+	if err != nil {
+		return 0
+	}
+	return nc.methods[abiMethod.Name].Gas
+}
+
+// Run implements types.NativeContract
+func (nc nativeContract) Run(ctx sdk.Context, caller ethcmn.Address, input []byte, readOnly bool) ([]byte, error) {
+	abiMethod, err := nc.method(input)
+	if err != nil {
+		return revert(err)
+	}
+	m := nc.methods[abiMethod.Name]
+	if m.Write && readOnly {
+		return nil, vm.ErrWriteProtection
+	}
+
+	args, err := abiMethod.Inputs.Unpack(input[4:])
+	if err != nil {
+		return revert(errInvalidInput)
+	}
+	outputs, err := m.Run(ctx, caller, args)
+	if err != nil {
+		return revert(err)
+	}
+	return abiMethod.Outputs.Pack(outputs...)
+}
+
+func (nc nativeContract) method(input []byte) (*abi.Method, error) {
+	if len(input) < 4 {
+		return nil, errInvalidInput
+	}
+	return nc.abi.MethodById(input[:4])
+}
+
+// revert returns the error as the revert reason, which can be caught by the calling contract
+func revert(err error) ([]byte, error) {
+	reason, packErr := revertArgs.Pack(err.Error())
+	if packErr != nil {
+		return nil, vm.ErrExecutionReverted
+	}
+	return append(append([]byte{}, revertSelector...), reason...), vm.ErrExecutionReverted
+}
+
+func mustNewType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+// DecFromBigInt converts the uint256 amount with 18 decimals into sdk.Dec
+func DecFromBigInt(amount *big.Int) sdk.Dec {
+	return sdk.NewDecFromBigIntWithPrec(amount, sdk.Precision)
+}
+
+// newCoin creates a coin with the uint256 amount after the denom is validated
+func newCoin(denom string, amount *big.Int) (sdk.SysCoin, error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdk.SysCoin{}, err
+	}
+	return sdk.NewDecCoinFromDec(denom, DecFromBigInt(amount)), nil
+}
@@ -0,0 +1,411 @@
+package precompile_test
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/okex/okexchain/app"
+	ethermint "github.com/okex/okexchain/app/types"
+	"github.com/okex/okexchain/x/ammswap"
+	ammswaptypes "github.com/okex/okexchain/x/ammswap/types"
+	"github.com/okex/okexchain/x/evm/precompile"
+	"github.com/okex/okexchain/x/evm/types"
+	stakingtypes "github.com/okex/okexchain/x/staking/types"
+	"github.com/okex/okexchain/x/token"
+)
+
+const tokenABI = `[
+	{"type":"function","name":"balanceOf","stateMutability":"view",
+		"inputs":[{"name":"account","type":"address"},{"name":"denom","type":"string"}],
+		"outputs":[{"name":"balance","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable",
+		"inputs":[{"name":"recipient","type":"address"},{"name":"denom","type":"string"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"success","type":"bool"}]}
+]`
+
+// callerCode returns the runtime code which forwards the calldata to the token contract with the call opcode, and
+// returns the 32 bytes of output followed by the success flag. The call is reverted after the call if revert is true
+func callerCode(callOp byte, revert bool) []byte {
+	code := []byte{
+		0x36, 0x60, 0x00, 0x60, 0x00, 0x37, // CALLDATACOPY(0, 0, CALLDATASIZE)
+		0x60, 0x20, 0x60, 0x00, 0x36, 0x60, 0x00, // retSize, retOffset, argsSize, argsOffset
+	}
+	if callOp == 0xf1 || callOp == 0xf2 {
+		code = append(code, 0x60, 0x00) // value
+	}
+	code = append(code, 0x61, 0x10, 0x02, 0x5a, callOp) // address, GAS, call
+	if revert {
+		return append(code, 0x60, 0x00, 0x60, 0x00, 0xfd) // REVERT(0, 0)
+	}
+	return append(code, 0x60, 0x20, 0x52, 0x60, 0x40, 0x60, 0x00, 0xf3) // MSTORE(0x20, success), RETURN(0, 0x40)
+}
+
+type PrecompileTestSuite struct {
+	suite.Suite
+
+	ctx       sdk.Context
+	app       *app.OKExChainApp
+	abi       abi.ABI
+	sender    ethcmn.Address
+	contract  ethcmn.Address
+	recipient ethcmn.Address
+}
+
+func TestPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(PrecompileTestSuite))
+}
+
+func (suite *PrecompileTestSuite) SetupTest() {
+	suite.app = app.Setup(false)
+	suite.ctx = suite.app.BaseApp.NewContext(false, abci.Header{Height: 1, ChainID: "ethermint-1"})
+
+	var err error
+	suite.abi, err = abi.JSON(strings.NewReader(tokenABI))
+	suite.Require().NoError(err)
+
+	suite.sender = ethcmn.BytesToAddress([]byte("sender"))
+	suite.contract = ethcmn.BytesToAddress([]byte("caller contract"))
+	suite.recipient = ethcmn.BytesToAddress([]byte("recipient"))
+	for _, addr := range []ethcmn.Address{suite.sender, suite.contract} {
+		acc := &ethermint.EthAccount{
+			BaseAccount: auth.NewBaseAccount(addr.Bytes(), sdk.NewCoins(sdk.NewDecCoin(sdk.DefaultBondDenom,
+				sdk.NewInt(100))), nil, 0, 0),
+			CodeHash: ethcrypto.Keccak256(nil),
+		}
+		suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	}
+
+	params := types.DefaultParams()
+	params.EnableCreate = true
+	params.EnableCall = true
+	suite.app.EvmKeeper.SetParams(suite.ctx, params)
+	suite.Require().NoError(suite.app.EvmKeeper.DeployNativeContracts(suite.ctx))
+}
+
+func (suite *PrecompileTestSuite) setCallerCode(code []byte) {
+	csdb := types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	csdb.SetCode(suite.contract, code)
+	_, err := csdb.Commit(false)
+	suite.Require().NoError(err)
+}
+
+func (suite *PrecompileTestSuite) transition(recipient ethcmn.Address, payload []byte) ([]byte, error) {
+	csdb := types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	st := types.StateTransition{
+		AccountNonce: 0,
+		Price:        big.NewInt(1),
+		GasLimit:     1000000,
+		Recipient:    &recipient,
+		Amount:       big.NewInt(0),
+		Payload:      payload,
+		ChainID:      big.NewInt(1),
+		Csdb:         csdb,
+		TxHash:       &ethcmn.Hash{},
+		Sender:       suite.sender,
+	}
+	res, err := st.TransitionDb(suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), types.DefaultChainConfig())
+	if err != nil {
+		return nil, err
+	}
+	data, err := types.DecodeResultData(res.Result.Data)
+	suite.Require().NoError(err)
+	return data.Ret, nil
+}
+
+func (suite *PrecompileTestSuite) balanceOf(addr ethcmn.Address) sdk.Dec {
+	return suite.app.TokenKeeper.GetCoins(suite.ctx, addr.Bytes()).AmountOf(sdk.DefaultBondDenom)
+}
+
+func (suite *PrecompileTestSuite) packTransfer(amount int64) []byte {
+	input, err := suite.abi.Pack("transfer", suite.recipient, sdk.DefaultBondDenom, sdk.NewDec(amount).BigInt())
+	suite.Require().NoError(err)
+	return input
+}
+
+func (suite *PrecompileTestSuite) TestNativeCodeDeployed() {
+	csdb := types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	for _, addr := range []ethcmn.Address{
+		precompile.SwapContractAddress, precompile.TokenContractAddress, precompile.StakingContractAddress,
+	} {
+		suite.Require().Equal(types.NativeContractCode, csdb.GetCode(addr))
+	}
+}
+
+func (suite *PrecompileTestSuite) TestTransfer() {
+	testCases := []struct {
+		name        string
+		code        []byte
+		amount      int64
+		expErr      bool
+		expSuccess  bool
+		expTransfer bool
+	}{
+		{"transfer by call", callerCode(0xf1, false), 10, false, true, true},
+		{"insufficient balance", callerCode(0xf1, false), 1000, false, false, false},
+		{"reverted after call", callerCode(0xf1, true), 10, true, false, false},
+		{"write protection of static call", callerCode(0xfa, false), 10, false, false, false},
+		{"delegate call rejected", callerCode(0xf4, false), 10, false, false, false},
+		{"call code rejected", callerCode(0xf2, false), 10, false, false, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.setCallerCode(tc.code)
+
+			ret, err := suite.transition(suite.contract, suite.packTransfer(tc.amount))
+			if tc.expErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Len(ret, 64)
+				suite.Require().Equal(tc.expSuccess, new(big.Int).SetBytes(ret[32:]).Sign() == 1)
+			}
+
+			if tc.expTransfer {
+				suite.Require().Equal(sdk.NewDec(100-tc.amount), suite.balanceOf(suite.contract))
+				suite.Require().Equal(sdk.NewDec(tc.amount), suite.balanceOf(suite.recipient))
+			} else {
+				suite.Require().Equal(sdk.NewDec(100), suite.balanceOf(suite.contract))
+				suite.Require().True(suite.balanceOf(suite.recipient).IsZero())
+			}
+		})
+	}
+}
+
+func (suite *PrecompileTestSuite) TestDirectCall() {
+	// the sender calls the token contract directly
+	_, err := suite.transition(precompile.TokenContractAddress, suite.packTransfer(30))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(70), suite.balanceOf(suite.sender))
+	suite.Require().Equal(sdk.NewDec(30), suite.balanceOf(suite.recipient))
+
+	input, err := suite.abi.Pack("balanceOf", suite.recipient, sdk.DefaultBondDenom)
+	suite.Require().NoError(err)
+	ret, err := suite.transition(precompile.TokenContractAddress, input)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(30).BigInt(), new(big.Int).SetBytes(ret))
+
+	// unknown method is reverted
+	_, err = suite.transition(precompile.TokenContractAddress, []byte{0x01, 0x02, 0x03, 0x04})
+	suite.Require().Error(err)
+}
+
+func (suite *PrecompileTestSuite) TestStakingQuery() {
+	input, err := abi.JSON(strings.NewReader(`[{"type":"function","name":"getShares","stateMutability":"view",
+		"inputs":[{"name":"delegator","type":"address"},{"name":"validator","type":"address"}],
+		"outputs":[{"name":"shares","type":"uint256"}]}]`))
+	suite.Require().NoError(err)
+	payload, err := input.Pack("getShares", suite.sender, suite.recipient)
+	suite.Require().NoError(err)
+
+	ret, err := suite.transition(precompile.StakingContractAddress, payload)
+	suite.Require().NoError(err)
+	suite.Require().Equal(0, new(big.Int).SetBytes(ret).Sign())
+}
+
+const swapABI = `[
+	{"type":"function","name":"getReserves","stateMutability":"view",
+		"inputs":[{"name":"tokenA","type":"string"},{"name":"tokenB","type":"string"}],
+		"outputs":[{"name":"reserveA","type":"uint256"},{"name":"reserveB","type":"uint256"},{"name":"poolTokenSupply","type":"uint256"}]},
+	{"type":"function","name":"swap","stateMutability":"nonpayable",
+		"inputs":[{"name":"soldDenom","type":"string"},{"name":"soldAmount","type":"uint256"},{"name":"boughtDenom","type":"string"},{"name":"minBoughtAmount","type":"uint256"},{"name":"recipient","type":"address"}],
+		"outputs":[{"name":"boughtAmount","type":"uint256"}]}
+]`
+
+const stakingABI = `[
+	{"type":"function","name":"getValidator","stateMutability":"view",
+		"inputs":[{"name":"validator","type":"address"}],
+		"outputs":[{"name":"found","type":"bool"},{"name":"jailed","type":"bool"},{"name":"status","type":"uint8"},{"name":"delegatorShares","type":"uint256"},{"name":"minSelfDelegation","type":"uint256"},{"name":"moniker","type":"string"}]},
+	{"type":"function","name":"getShares","stateMutability":"view",
+		"inputs":[{"name":"delegator","type":"address"},{"name":"validator","type":"address"}],
+		"outputs":[{"name":"shares","type":"uint256"}]}
+]`
+
+// setupSwapPair creates the swap token pair of xxb and okt with 20xxb and 10okt pooled by the sender
+func (suite *PrecompileTestSuite) setupSwapPair() {
+	suite.app.TokenKeeper.NewToken(suite.ctx, token.Token{
+		Symbol: "xxb", OriginalSymbol: "xxb", WholeName: "xxb", OriginalTotalSupply: sdk.NewDec(1000), Type: 1,
+		Owner: suite.sender.Bytes(),
+	})
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, suite.sender.Bytes())
+	suite.Require().NoError(acc.SetCoins(acc.GetCoins().Add(sdk.NewDecCoin("xxb", sdk.NewInt(100)))))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	handler := ammswap.NewHandler(suite.app.SwapKeeper)
+	_, err := handler(suite.ctx, ammswaptypes.NewMsgCreateExchange("xxb", sdk.DefaultBondDenom, suite.sender.Bytes()))
+	suite.Require().NoError(err)
+	_, err = handler(suite.ctx, ammswap.NewMsgAddLiquidity(sdk.ZeroDec(), sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(10)),
+		sdk.NewDecCoin("xxb", sdk.NewInt(20)), math.MaxInt64, suite.sender.Bytes()))
+	suite.Require().NoError(err)
+}
+
+func (suite *PrecompileTestSuite) TestSwapGetReserves() {
+	suite.setupSwapPair()
+	swap, err := abi.JSON(strings.NewReader(swapABI))
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name               string
+		tokenA, tokenB     string
+		expErr             bool
+		reserveA, reserveB int64
+	}{
+		{"base token first", "xxb", sdk.DefaultBondDenom, false, 20, 10},
+		{"quote token first", sdk.DefaultBondDenom, "xxb", false, 10, 20},
+		{"pair not found", "yyb", sdk.DefaultBondDenom, true, 0, 0},
+	}
+	for _, tc := range testCases {
+		payload, err := swap.Pack("getReserves", tc.tokenA, tc.tokenB)
+		suite.Require().NoError(err)
+		ret, err := suite.transition(precompile.SwapContractAddress, payload)
+		if tc.expErr {
+			suite.Require().Error(err, tc.name)
+			continue
+		}
+		suite.Require().NoError(err, tc.name)
+
+		outputs, err := swap.Unpack("getReserves", ret)
+		suite.Require().NoError(err, tc.name)
+		suite.Require().Equal(sdk.NewDec(tc.reserveA).BigInt(), outputs[0], tc.name)
+		suite.Require().Equal(sdk.NewDec(tc.reserveB).BigInt(), outputs[1], tc.name)
+		suite.Require().Equal(1, outputs[2].(*big.Int).Sign(), tc.name)
+	}
+}
+
+func (suite *PrecompileTestSuite) TestSwap() {
+	swap, err := abi.JSON(strings.NewReader(swapABI))
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name        string
+		soldDenom   string
+		soldAmount  int64
+		boughtDenom string
+		minBought   int64
+		expErr      bool
+	}{
+		{"swap okt for xxb", sdk.DefaultBondDenom, 1, "xxb", 1, false},
+		{"invalid sold denom", "X-Y", 1, "xxb", 1, true},
+		{"invalid bought denom", sdk.DefaultBondDenom, 1, "X-Y", 1, true},
+		{"zero sold amount", sdk.DefaultBondDenom, 0, "xxb", 1, true},
+		{"insufficient balance", sdk.DefaultBondDenom, 1000, "xxb", 1, true},
+		{"less than the min bought amount", sdk.DefaultBondDenom, 1, "xxb", 10, true},
+		{"pair not found", sdk.DefaultBondDenom, 1, "yyb", 1, true},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.setupSwapPair()
+			senderBalance := suite.balanceOf(suite.sender)
+
+			payload, err := swap.Pack("swap", tc.soldDenom, sdk.NewDec(tc.soldAmount).BigInt(), tc.boughtDenom,
+				sdk.NewDec(tc.minBought).BigInt(), suite.recipient)
+			suite.Require().NoError(err)
+			ret, err := suite.transition(precompile.SwapContractAddress, payload)
+
+			bought := suite.app.TokenKeeper.GetCoins(suite.ctx, suite.recipient.Bytes()).AmountOf("xxb")
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Equal(senderBalance, suite.balanceOf(suite.sender))
+				suite.Require().True(bought.IsZero())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(senderBalance.Sub(sdk.NewDec(tc.soldAmount)), suite.balanceOf(suite.sender))
+			suite.Require().True(bought.GTE(sdk.NewDec(tc.minBought)))
+
+			outputs, err := swap.Unpack("swap", ret)
+			suite.Require().NoError(err)
+			suite.Require().Equal(bought.BigInt(), outputs[0])
+		})
+	}
+}
+
+func (suite *PrecompileTestSuite) TestStakingGetValidator() {
+	staking, err := abi.JSON(strings.NewReader(stakingABI))
+	suite.Require().NoError(err)
+
+	valAddr := ethcmn.BytesToAddress([]byte("validator"))
+	validator := stakingtypes.NewValidator(valAddr.Bytes(), ed25519.GenPrivKey().PubKey(),
+		stakingtypes.Description{Moniker: "moniker"}, sdk.NewDec(10))
+	validator.DelegatorShares = sdk.NewDec(5)
+	suite.app.StakingKeeper.SetValidator(suite.ctx, validator)
+
+	testCases := []struct {
+		name      string
+		validator ethcmn.Address
+		expFound  bool
+	}{
+		{"validator found", valAddr, true},
+		{"validator not found", suite.recipient, false},
+	}
+	for _, tc := range testCases {
+		payload, err := staking.Pack("getValidator", tc.validator)
+		suite.Require().NoError(err)
+		ret, err := suite.transition(precompile.StakingContractAddress, payload)
+		suite.Require().NoError(err, tc.name)
+
+		outputs, err := staking.Unpack("getValidator", ret)
+		suite.Require().NoError(err, tc.name)
+		suite.Require().Equal(tc.expFound, outputs[0], tc.name)
+		if !tc.expFound {
+			suite.Require().Equal("", outputs[5], tc.name)
+			continue
+		}
+		suite.Require().Equal(false, outputs[1], tc.name)
+		suite.Require().Equal(uint8(validator.Status), outputs[2], tc.name)
+		suite.Require().Equal(sdk.NewDec(5).BigInt(), outputs[3], tc.name)
+		suite.Require().Equal(sdk.NewDec(10).BigInt(), outputs[4], tc.name)
+		suite.Require().Equal("moniker", outputs[5], tc.name)
+	}
+}
+
+func (suite *PrecompileTestSuite) TestStakingInvalidInput() {
+	staking, err := abi.JSON(strings.NewReader(stakingABI))
+	suite.Require().NoError(err)
+	payload, err := staking.Pack("getShares", suite.sender, suite.recipient)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name    string
+		payload []byte
+	}{
+		{"no selector", payload[:3]},
+		{"unknown selector", append([]byte{0x01, 0x02, 0x03, 0x04}, payload[4:]...)},
+		{"truncated arguments", payload[:len(payload)-1]},
+	}
+	for _, tc := range testCases {
+		_, err := suite.transition(precompile.StakingContractAddress, tc.payload)
+		suite.Require().Error(err, tc.name)
+	}
+
+	// the getter doesn't accept value
+	csdb := types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+	recipient := precompile.StakingContractAddress
+	st := types.StateTransition{
+		Price:     big.NewInt(1),
+		GasLimit:  1000000,
+		Recipient: &recipient,
+		Amount:    sdk.NewDec(1).BigInt(),
+		Payload:   payload,
+		ChainID:   big.NewInt(1),
+		Csdb:      csdb,
+		TxHash:    &ethcmn.Hash{},
+		Sender:    suite.sender,
+	}
+	_, err = st.TransitionDb(suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), types.DefaultChainConfig())
+	suite.Require().Error(err)
+	suite.Require().Equal(sdk.NewDec(100), suite.balanceOf(suite.sender))
+}
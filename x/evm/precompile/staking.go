@@ -0,0 +1,60 @@
+package precompile
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+
+	"github.com/okex/okexchain/x/evm/types"
+)
+
+var stakingABI = MustParseABI(`[
+	{"type":"function","name":"getValidator","stateMutability":"view",
+		"inputs":[{"name":"validator","type":"address"}],
+		"outputs":[{"name":"found","type":"bool"},{"name":"jailed","type":"bool"},{"name":"status","type":"uint8"},{"name":"delegatorShares","type":"uint256"},{"name":"minSelfDelegation","type":"uint256"},{"name":"moniker","type":"string"}]},
+	{"type":"function","name":"getShares","stateMutability":"view",
+		"inputs":[{"name":"delegator","type":"address"},{"name":"validator","type":"address"}],
+		"outputs":[{"name":"shares","type":"uint256"}]}
+]`)
+
+const (
+	getValidatorGas = 2000
+	getSharesGas    = 1000
+)
+
+type stakingContract struct {
+	keeper StakingKeeper
+}
+
+// NewStakingContract creates the native contract to query the validators and the shares added to them. The shares
+// are uint256 with 18 decimals
+func NewStakingContract(keeper StakingKeeper) types.NativeContract {
+	sc := stakingContract{keeper: keeper}
+	return NewContract(stakingABI, map[string]Method{
+		"getValidator": {Gas: getValidatorGas, Run: sc.getValidator},
+		"getShares":    {Gas: getSharesGas, Run: sc.getShares},
+	})
+}
+
+// getValidator returns the info of validator, found is false if the validator doesn't exist
+func (sc stakingContract) getValidator(ctx sdk.Context, _ ethcmn.Address, args []interface{}) ([]interface{}, error) {
+	valAddr := sdk.ValAddress(args[0].(ethcmn.Address).Bytes())
+	val, found := sc.keeper.GetValidator(ctx, valAddr)
+	if !found {
+		return []interface{}{false, false, uint8(0), new(big.Int), new(big.Int), ""}, nil
+	}
+	return []interface{}{true, val.Jailed, uint8(val.Status), val.DelegatorShares.BigInt(),
+		val.MinSelfDelegation.BigInt(), val.Description.Moniker}, nil
+}
+
+// getShares returns the shares added to validator by delegator
+func (sc stakingContract) getShares(ctx sdk.Context, _ ethcmn.Address, args []interface{}) ([]interface{}, error) {
+	delAddr := sdk.AccAddress(args[0].(ethcmn.Address).Bytes())
+	valAddr := sdk.ValAddress(args[1].(ethcmn.Address).Bytes())
+	shares, found := sc.keeper.GetShares(ctx, delAddr, valAddr)
+	if !found {
+		return []interface{}{new(big.Int)}, nil
+	}
+	return []interface{}{shares.BigInt()}, nil
+}
@@ -0,0 +1,94 @@
+package precompile
+
+import (
+	"errors"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+
+	ammswap "github.com/okex/okexchain/x/ammswap/types"
+	"github.com/okex/okexchain/x/evm/types"
+)
+
+var swapABI = MustParseABI(`[
+	{"type":"function","name":"getReserves","stateMutability":"view",
+		"inputs":[{"name":"tokenA","type":"string"},{"name":"tokenB","type":"string"}],
+		"outputs":[{"name":"reserveA","type":"uint256"},{"name":"reserveB","type":"uint256"},{"name":"poolTokenSupply","type":"uint256"}]},
+	{"type":"function","name":"swap","stateMutability":"nonpayable",
+		"inputs":[{"name":"soldDenom","type":"string"},{"name":"soldAmount","type":"uint256"},{"name":"boughtDenom","type":"string"},{"name":"minBoughtAmount","type":"uint256"},{"name":"recipient","type":"address"}],
+		"outputs":[{"name":"boughtAmount","type":"uint256"}]}
+]`)
+
+const (
+	getReservesGas = 2000
+	swapGas        = 20000
+)
+
+var errSwapFailed = errors.New("swap failed")
+
+type swapContract struct {
+	keeper  SwapKeeper
+	handler sdk.Handler
+}
+
+// NewSwapContract creates the native contract to query the reserves of swap token pairs and to swap tokens with
+// the ammswap module. The amounts are uint256 with 18 decimals
+func NewSwapContract(keeper SwapKeeper, handler sdk.Handler) types.NativeContract {
+	sc := swapContract{keeper: keeper, handler: handler}
+	return NewContract(swapABI, map[string]Method{
+		"getReserves": {Gas: getReservesGas, Run: sc.getReserves},
+		"swap":        {Gas: swapGas, Write: true, Run: sc.swap},
+	})
+}
+
+// getReserves returns the pooled amounts of the two tokens in the order of arguments and the supply of pool token
+func (sc swapContract) getReserves(ctx sdk.Context, _ ethcmn.Address, args []interface{}) ([]interface{}, error) {
+	tokenA, tokenB := args[0].(string), args[1].(string)
+	pair, err := sc.keeper.GetSwapTokenPair(ctx, ammswap.GetSwapTokenPairName(tokenA, tokenB))
+	if err != nil {
+		return nil, err
+	}
+
+	reserveA, reserveB := pair.BasePooledCoin.Amount, pair.QuotePooledCoin.Amount
+	if pair.BasePooledCoin.Denom != tokenA {
+		reserveA, reserveB = reserveB, reserveA
+	}
+	supply := sc.keeper.GetPoolTokenAmount(ctx, pair.PoolTokenName)
+	return []interface{}{reserveA.BigInt(), reserveB.BigInt(), supply.BigInt()}, nil
+}
+
+// swap sells the tokens of caller for the tokens sent to recipient, and returns the bought amount
+func (sc swapContract) swap(ctx sdk.Context, caller ethcmn.Address, args []interface{}) ([]interface{}, error) {
+	soldCoin, err := newCoin(args[0].(string), args[1].(*big.Int))
+	if err != nil {
+		return nil, err
+	}
+	minBoughtCoin, err := newCoin(args[2].(string), args[3].(*big.Int))
+	if err != nil {
+		return nil, err
+	}
+	recipient := sdk.AccAddress(args[4].(ethcmn.Address).Bytes())
+
+	msg := ammswap.NewMsgTokenToToken(soldCoin, minBoughtCoin, ctx.BlockTime().Unix(), recipient, caller.Bytes())
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	balanceBefore := sc.balanceOf(ctx, recipient, minBoughtCoin.Denom)
+	res, err := sc.handler(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, errSwapFailed
+	}
+	ctx.EventManager().EmitEvents(res.Events)
+
+	bought := sc.balanceOf(ctx, recipient, minBoughtCoin.Denom).Sub(balanceBefore)
+	return []interface{}{bought.BigInt()}, nil
+}
+
+func (sc swapContract) balanceOf(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Dec {
+	return sc.keeper.GetTokenKeeper().GetCoins(ctx, addr).AmountOf(denom)
+}
@@ -0,0 +1,59 @@
+package precompile
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+
+	"github.com/okex/okexchain/x/evm/types"
+)
+
+var tokenABI = MustParseABI(`[
+	{"type":"function","name":"balanceOf","stateMutability":"view",
+		"inputs":[{"name":"account","type":"address"},{"name":"denom","type":"string"}],
+		"outputs":[{"name":"balance","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable",
+		"inputs":[{"name":"recipient","type":"address"},{"name":"denom","type":"string"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"success","type":"bool"}]}
+]`)
+
+const (
+	balanceOfGas = 1000
+	transferGas  = 10000
+)
+
+type tokenContract struct {
+	keeper TokenKeeper
+}
+
+// NewTokenContract creates the native contract to query and transfer the coins of the token module. The amounts are
+// uint256 with 18 decimals
+func NewTokenContract(keeper TokenKeeper) types.NativeContract {
+	tc := tokenContract{keeper: keeper}
+	return NewContract(tokenABI, map[string]Method{
+		"balanceOf": {Gas: balanceOfGas, Run: tc.balanceOf},
+		"transfer":  {Gas: transferGas, Write: true, Run: tc.transfer},
+	})
+}
+
+// balanceOf returns the balance of the denom of account
+func (tc tokenContract) balanceOf(ctx sdk.Context, _ ethcmn.Address, args []interface{}) ([]interface{}, error) {
+	account := sdk.AccAddress(args[0].(ethcmn.Address).Bytes())
+	balance := tc.keeper.GetCoins(ctx, account).AmountOf(args[1].(string))
+	return []interface{}{balance.BigInt()}, nil
+}
+
+// transfer sends the coins of caller to recipient
+func (tc tokenContract) transfer(ctx sdk.Context, caller ethcmn.Address, args []interface{}) ([]interface{}, error) {
+	recipient := sdk.AccAddress(args[0].(ethcmn.Address).Bytes())
+	coin, err := newCoin(args[1].(string), args[2].(*big.Int))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tc.keeper.SendCoinsFromAccountToAccount(ctx, caller.Bytes(), recipient, sdk.SysCoins{coin}); err != nil {
+		return nil, err
+	}
+	return []interface{}{true}, nil
+}
@@ -0,0 +1,48 @@
+package evm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/evm/types"
+	govtypes "github.com/okex/okexchain/x/gov/types"
+)
+
+// NewManageContractProposalHandler handles "gov" type message in "evm"
+func NewManageContractProposalHandler(k *Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, proposal *govtypes.Proposal) (err sdk.Error) {
+		switch content := proposal.Content.(type) {
+		case types.ManageContractDeploymentWhitelistProposal:
+			return handleManageContractDeploymentWhitelistProposal(ctx, k, content)
+		case types.ManageContractBlockedListProposal:
+			return handleManageContractBlockedListProposal(ctx, k, content)
+		default:
+			return common.ErrUnknownProposalType(types.ModuleName, content.ProposalType())
+		}
+	}
+}
+
+func handleManageContractDeploymentWhitelistProposal(ctx sdk.Context, k *Keeper,
+	proposal types.ManageContractDeploymentWhitelistProposal) sdk.Error {
+	if proposal.IsAdded {
+		// add deployer addresses into whitelist
+		k.SetContractDeploymentWhitelist(ctx, proposal.DistributorAddrs)
+		return nil
+	}
+
+	// remove deployer addresses from whitelist
+	k.DeleteContractDeploymentWhitelist(ctx, proposal.DistributorAddrs)
+	return nil
+}
+
+func handleManageContractBlockedListProposal(ctx sdk.Context, k *Keeper,
+	proposal types.ManageContractBlockedListProposal) sdk.Error {
+	if proposal.IsAdded {
+		// add contract addresses into blocked list
+		k.SetContractBlockedList(ctx, proposal.ContractAddrs)
+		return nil
+	}
+
+	// remove contract addresses from blocked list
+	k.DeleteContractBlockedList(ctx, proposal.ContractAddrs)
+	return nil
+}
@@ -0,0 +1,57 @@
+package evm_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/evm"
+	"github.com/okex/okexchain/x/evm/types"
+	govtypes "github.com/okex/okexchain/x/gov/types"
+)
+
+func (suite *EvmTestSuite) TestProposalHandler_ManageContractDeploymentWhitelistProposal() {
+	addr1 := sdk.AccAddress([]byte("address_1___________"))
+	addr2 := sdk.AccAddress([]byte("address_2___________"))
+	handler := evm.NewManageContractProposalHandler(suite.app.EvmKeeper)
+
+	proposal := govtypes.Proposal{Content: types.NewManageContractDeploymentWhitelistProposal(
+		"default title",
+		"default description",
+		types.AddressList{addr1, addr2},
+		true,
+	)}
+	suite.Require().NoError(handler(suite.ctx, &proposal))
+	suite.Require().Len(suite.app.EvmKeeper.GetContractDeploymentWhitelist(suite.ctx), 2)
+
+	proposal.Content = types.NewManageContractDeploymentWhitelistProposal(
+		"default title",
+		"default description",
+		types.AddressList{addr1},
+		false,
+	)
+	suite.Require().NoError(handler(suite.ctx, &proposal))
+	suite.Require().Equal(types.AddressList{addr2}, suite.app.EvmKeeper.GetContractDeploymentWhitelist(suite.ctx))
+}
+
+func (suite *EvmTestSuite) TestProposalHandler_ManageContractBlockedListProposal() {
+	addr1 := sdk.AccAddress([]byte("address_1___________"))
+	addr2 := sdk.AccAddress([]byte("address_2___________"))
+	handler := evm.NewManageContractProposalHandler(suite.app.EvmKeeper)
+
+	proposal := govtypes.Proposal{Content: types.NewManageContractBlockedListProposal(
+		"default title",
+		"default description",
+		types.AddressList{addr1, addr2},
+		true,
+	)}
+	suite.Require().NoError(handler(suite.ctx, &proposal))
+	suite.Require().Len(suite.app.EvmKeeper.GetContractBlockedList(suite.ctx), 2)
+
+	proposal.Content = types.NewManageContractBlockedListProposal(
+		"default title",
+		"default description",
+		types.AddressList{addr2},
+		false,
+	)
+	suite.Require().NoError(handler(suite.ctx, &proposal))
+	suite.Require().Equal(types.AddressList{addr1}, suite.app.EvmKeeper.GetContractBlockedList(suite.ctx))
+}
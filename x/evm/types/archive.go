@@ -0,0 +1,308 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/spf13/viper"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	archiveDir = "evm_archive"
+	// FlagEnableArchiveIndex is the flag to record the per-block state diffs of evm into the archive index
+	FlagEnableArchiveIndex = "enable-evm-archive-index"
+)
+
+var (
+	archiveIndex   *ArchiveIndex
+	archiveTracker *ArchiveTracker
+
+	archiveAccountPrefix = []byte("a") // archiveAccountPrefix + address + height (uint64 big endian) -> rlp(ArchiveAccount)
+	archiveStoragePrefix = []byte("s") // archiveStoragePrefix + address + storage key + height (uint64 big endian) -> value
+	archiveCodePrefix    = []byte("c") // archiveCodePrefix + code hash -> code
+	archiveSegmentPrefix = []byte("g") // archiveSegmentPrefix + start height -> end height (uint64 big endian)
+	archiveLatestKey     = []byte("latest")
+)
+
+// ArchiveAccount is the evm account recorded in the archive index
+type ArchiveAccount struct {
+	Balance  *big.Int
+	Nonce    uint64
+	CodeHash []byte
+}
+
+// ArchiveDiff is the state of the accounts, storage and codes changed in a block
+type ArchiveDiff struct {
+	Accounts map[ethcmn.Address]ArchiveAccount
+	Storage  map[ethcmn.Address]map[ethcmn.Hash]ethcmn.Hash
+	Codes    map[ethcmn.Hash][]byte
+}
+
+// NewArchiveDiff creates a new empty instance of ArchiveDiff
+func NewArchiveDiff() ArchiveDiff {
+	return ArchiveDiff{
+		Accounts: make(map[ethcmn.Address]ArchiveAccount),
+		Storage:  make(map[ethcmn.Address]map[ethcmn.Hash]ethcmn.Hash),
+		Codes:    make(map[ethcmn.Hash][]byte),
+	}
+}
+
+// SetStorage sets the value of the storage key in the diff
+func (d ArchiveDiff) SetStorage(addr ethcmn.Address, key, value ethcmn.Hash) {
+	if d.Storage[addr] == nil {
+		d.Storage[addr] = make(map[ethcmn.Hash]ethcmn.Hash)
+	}
+	d.Storage[addr][key] = value
+}
+
+// ArchiveIndex records the per-block state diffs of the evm accounts and storage into a side database, so that the
+// historical state is still available after the store of the height is pruned. The blocks are recorded in segments of
+// consecutive heights. The first block of a segment is a full snapshot of the state, and each following block only
+// records the accounts and storage changed by it. A new segment is started when some blocks are missed, e.g. the
+// archive index was disabled for a while, and the heights between the segments are reported as pruned.
+type ArchiveIndex struct {
+	db dbm.DB
+}
+
+// InitArchiveIndex initializes the archive index with the database and the tracker of the accounts and storage written
+// in the blocks
+func InitArchiveIndex(db dbm.DB) {
+	archiveIndex = &ArchiveIndex{db: db}
+	archiveTracker = NewArchiveTracker()
+}
+
+// GetArchiveIndex returns the archive index of the node, which is nil if it's disabled
+func GetArchiveIndex() *ArchiveIndex {
+	return archiveIndex
+}
+
+// GetArchiveTracker returns the tracker of the archive index, which is nil if the archive index is disabled
+func GetArchiveTracker() *ArchiveTracker {
+	return archiveTracker
+}
+
+// ArchiveDb opens the database of the archive index in the data directory of the node
+func ArchiveDb() dbm.DB {
+	dataDir := filepath.Join(viper.GetString("home"), "data")
+	db, err := sdk.NewLevelDB(archiveDir, dataDir)
+	if err != nil {
+		panic(err)
+	}
+	return db
+}
+
+// StartHeight returns the first height recorded in the archive index
+func (ai *ArchiveIndex) StartHeight() int64 {
+	it, err := ai.db.Iterator(archiveSegmentPrefix, sdk.PrefixEndBytes(archiveSegmentPrefix))
+	if err != nil {
+		return 0
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(it.Key()[len(archiveSegmentPrefix):]))
+}
+
+// LatestHeight returns the latest height recorded in the archive index
+func (ai *ArchiveIndex) LatestHeight() int64 {
+	return ai.getHeight(archiveLatestKey)
+}
+
+// Commit records the diff of the block at the height into the archive index. The diff must be a full snapshot of the
+// state if the height doesn't follow the latest height recorded, which starts a new segment
+func (ai *ArchiveIndex) Commit(height int64, diff ArchiveDiff) error {
+	latest := ai.LatestHeight()
+	if height <= latest {
+		return fmt.Errorf("height %d has been recorded, the latest height is %d", height, latest)
+	}
+	segmentStart := height
+	if latest != 0 && height == latest+1 {
+		segmentStart, _ = ai.segment(latest)
+	}
+
+	batch := ai.db.NewBatch()
+	defer batch.Close()
+
+	for addr, acc := range diff.Accounts {
+		bz, err := rlp.EncodeToBytes(acc)
+		if err != nil {
+			return err
+		}
+		batch.Set(heightKey(archiveAccountPrefixKey(addr), height), bz)
+	}
+	for addr, storage := range diff.Storage {
+		for key, value := range storage {
+			batch.Set(heightKey(archiveStoragePrefixKey(addr, key), height), value.Bytes())
+		}
+	}
+	for hash, code := range diff.Codes {
+		batch.Set(archiveCodeKey(hash.Bytes()), code)
+	}
+
+	batch.Set(archiveSegmentKey(segmentStart), sdk.Uint64ToBigEndian(uint64(height)))
+	batch.Set(archiveLatestKey, sdk.Uint64ToBigEndian(uint64(height)))
+	return batch.WriteSync()
+}
+
+// GetAccount returns the account at the height from the archive index
+func (ai *ArchiveIndex) GetAccount(addr ethcmn.Address, height int64) (ArchiveAccount, error) {
+	acc := ArchiveAccount{Balance: new(big.Int)}
+	bz, err := ai.get(archiveAccountPrefixKey(addr), height)
+	if err != nil || bz == nil {
+		// the account didn't exist at the height
+		return acc, err
+	}
+	err = rlp.DecodeBytes(bz, &acc)
+	return acc, err
+}
+
+// GetState returns the value of the storage key of the address at the height from the archive index
+func (ai *ArchiveIndex) GetState(addr ethcmn.Address, key ethcmn.Hash, height int64) (ethcmn.Hash, error) {
+	// the storage is recorded with the keys in the evm store, which are prefixed with the address
+	bz, err := ai.get(archiveStoragePrefixKey(addr, ethcrypto.Keccak256Hash(addr.Bytes(), key.Bytes())), height)
+	return ethcmn.BytesToHash(bz), err
+}
+
+// GetCode returns the code of the code hash from the archive index
+func (ai *ArchiveIndex) GetCode(codeHash []byte) ([]byte, error) {
+	return ai.db.Get(archiveCodeKey(codeHash))
+}
+
+// HasCode returns whether the code of the code hash is recorded in the archive index
+func (ai *ArchiveIndex) HasCode(codeHash []byte) bool {
+	has, err := ai.db.Has(archiveCodeKey(codeHash))
+	return err == nil && has
+}
+
+// get returns the latest value recorded at or before the height under the key prefix
+func (ai *ArchiveIndex) get(keyPrefix []byte, height int64) ([]byte, error) {
+	segmentStart, segmentEnd := ai.segment(height)
+	if segmentStart == 0 || height > segmentEnd {
+		return nil, sdkerrors.Wrapf(ErrStatePruned, "height %d isn't recorded by the archive index", height)
+	}
+
+	it, err := ai.db.ReverseIterator(keyPrefix, heightKey(keyPrefix, height+1))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	// the records before the snapshot of the segment are the state of the previous segments
+	if !it.Valid() || int64(binary.BigEndian.Uint64(it.Key()[len(keyPrefix):])) < segmentStart {
+		return nil, nil
+	}
+	return it.Value(), nil
+}
+
+// segment returns the start and end heights of the segment containing the height if the height is recorded, or the
+// nearest segment before the height. Both are 0 if there's no segment before the height
+func (ai *ArchiveIndex) segment(height int64) (start, end int64) {
+	it, err := ai.db.ReverseIterator(archiveSegmentPrefix, archiveSegmentKey(height+1))
+	if err != nil {
+		return 0, 0
+	}
+	defer it.Close()
+
+	if !it.Valid() || len(it.Value()) != 8 {
+		return 0, 0
+	}
+	return int64(binary.BigEndian.Uint64(it.Key()[len(archiveSegmentPrefix):])),
+		int64(binary.BigEndian.Uint64(it.Value()))
+}
+
+func (ai *ArchiveIndex) getHeight(key []byte) int64 {
+	bz, err := ai.db.Get(key)
+	if err != nil || len(bz) != 8 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+func archiveAccountPrefixKey(addr ethcmn.Address) []byte {
+	return append(append([]byte{}, archiveAccountPrefix...), addr.Bytes()...)
+}
+
+func archiveStoragePrefixKey(addr ethcmn.Address, storageKey ethcmn.Hash) []byte {
+	key := append(append([]byte{}, archiveStoragePrefix...), addr.Bytes()...)
+	return append(key, storageKey.Bytes()...)
+}
+
+func archiveSegmentKey(start int64) []byte {
+	return append(append([]byte{}, archiveSegmentPrefix...), sdk.Uint64ToBigEndian(uint64(start))...)
+}
+
+func archiveCodeKey(codeHash []byte) []byte {
+	return append(append([]byte{}, archiveCodePrefix...), codeHash...)
+}
+
+func heightKey(keyPrefix []byte, height int64) []byte {
+	return append(append([]byte{}, keyPrefix...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// ArchiveTracker tracks the accounts and storage written in the current block, whose state is recorded into the
+// archive index after the block is committed. The accounts are tracked by observing the writes of the account keeper
+type ArchiveTracker struct {
+	mtx      sync.Mutex
+	accounts map[ethcmn.Address]struct{}
+	storage  map[ethcmn.Address]map[ethcmn.Hash]struct{}
+}
+
+// NewArchiveTracker creates a new instance of ArchiveTracker
+func NewArchiveTracker() *ArchiveTracker {
+	return &ArchiveTracker{
+		accounts: make(map[ethcmn.Address]struct{}),
+		storage:  make(map[ethcmn.Address]map[ethcmn.Hash]struct{}),
+	}
+}
+
+// TrackAccount tracks the account of the address. It's a no-op on the nil tracker
+func (t *ArchiveTracker) TrackAccount(addr ethcmn.Address) {
+	if t == nil {
+		return
+	}
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.accounts[addr] = struct{}{}
+}
+
+// OnAccountUpdated implements the observer of the account keeper, which tracks the account written
+func (t *ArchiveTracker) OnAccountUpdated(acc authexported.Account) {
+	if acc != nil {
+		t.TrackAccount(ethcmn.BytesToAddress(acc.GetAddress()))
+	}
+}
+
+// TrackStorage tracks the storage key of the address. It's a no-op on the nil tracker
+func (t *ArchiveTracker) TrackStorage(addr ethcmn.Address, key ethcmn.Hash) {
+	if t == nil {
+		return
+	}
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	if t.storage[addr] == nil {
+		t.storage[addr] = make(map[ethcmn.Hash]struct{})
+	}
+	t.storage[addr][key] = struct{}{}
+}
+
+// Flush returns the tracked accounts and storage, and resets the tracker
+func (t *ArchiveTracker) Flush() (accounts map[ethcmn.Address]struct{}, storage map[ethcmn.Address]map[ethcmn.Hash]struct{}) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	accounts, storage = t.accounts, t.storage
+	t.accounts = make(map[ethcmn.Address]struct{})
+	t.storage = make(map[ethcmn.Address]map[ethcmn.Hash]struct{})
+	return
+}
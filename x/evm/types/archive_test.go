@@ -0,0 +1,158 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestArchiveIndex(t *testing.T) {
+	index := &ArchiveIndex{db: dbm.NewMemDB()}
+	addr := ethcmn.BytesToAddress([]byte("addr"))
+	key := ethcmn.BytesToHash([]byte("key"))
+	storeKey := ethcrypto.Keccak256Hash(addr.Bytes(), key.Bytes())
+	code := []byte("code")
+	codeHash := ethcrypto.Keccak256Hash(code)
+
+	// nothing is recorded
+	_, err := index.GetAccount(addr, 1)
+	require.Error(t, err)
+
+	// snapshot at height 10
+	diff := NewArchiveDiff()
+	diff.Accounts[addr] = ArchiveAccount{Balance: big.NewInt(100), Nonce: 1, CodeHash: codeHash.Bytes()}
+	diff.SetStorage(addr, storeKey, ethcmn.BytesToHash([]byte{0x1}))
+	diff.Codes[codeHash] = code
+	require.NoError(t, index.Commit(10, diff))
+
+	// the storage is changed at height 11, and the account is changed at height 12
+	diff = NewArchiveDiff()
+	diff.SetStorage(addr, storeKey, ethcmn.Hash{})
+	require.NoError(t, index.Commit(11, diff))
+	diff = NewArchiveDiff()
+	diff.Accounts[addr] = ArchiveAccount{Balance: big.NewInt(50), Nonce: 2, CodeHash: codeHash.Bytes()}
+	require.NoError(t, index.Commit(12, diff))
+	require.Equal(t, int64(10), index.StartHeight())
+	require.Equal(t, int64(12), index.LatestHeight())
+
+	for _, tc := range []struct {
+		height     int64
+		expBalance int64
+		expNonce   uint64
+		expValue   ethcmn.Hash
+	}{
+		{10, 100, 1, ethcmn.BytesToHash([]byte{0x1})},
+		{11, 100, 1, ethcmn.Hash{}},
+		{12, 50, 2, ethcmn.Hash{}},
+	} {
+		acc, err := index.GetAccount(addr, tc.height)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(tc.expBalance), acc.Balance)
+		require.Equal(t, tc.expNonce, acc.Nonce)
+		require.Equal(t, codeHash.Bytes(), acc.CodeHash)
+
+		value, err := index.GetState(addr, key, tc.height)
+		require.NoError(t, err)
+		require.Equal(t, tc.expValue, value)
+	}
+
+	// the account not recorded doesn't exist
+	acc, err := index.GetAccount(ethcmn.BytesToAddress([]byte("other")), 11)
+	require.NoError(t, err)
+	require.Equal(t, 0, acc.Balance.Sign())
+
+	// the heights out of the range are pruned
+	for _, height := range []int64{9, 13} {
+		_, err = index.GetAccount(addr, height)
+		require.True(t, ErrStatePruned.Is(err))
+		_, err = index.GetState(addr, key, height)
+		require.True(t, ErrStatePruned.Is(err))
+	}
+
+	require.True(t, index.HasCode(codeHash.Bytes()))
+	bz, err := index.GetCode(codeHash.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, code, bz)
+
+	// the recorded height can't be recorded again
+	require.Error(t, index.Commit(12, NewArchiveDiff()))
+
+	// a new segment is started by the snapshot at height 20 after some blocks are missed, and the storage of the
+	// previous segment doesn't exist in it
+	diff = NewArchiveDiff()
+	diff.Accounts[addr] = ArchiveAccount{Balance: big.NewInt(10), Nonce: 3, CodeHash: codeHash.Bytes()}
+	require.NoError(t, index.Commit(20, diff))
+	require.NoError(t, index.Commit(21, NewArchiveDiff()))
+	require.Equal(t, int64(10), index.StartHeight())
+	require.Equal(t, int64(21), index.LatestHeight())
+
+	for _, height := range []int64{13, 19, 22} {
+		_, err = index.GetAccount(addr, height)
+		require.True(t, ErrStatePruned.Is(err))
+	}
+	for _, tc := range []struct {
+		height     int64
+		expBalance int64
+		expValue   ethcmn.Hash
+	}{
+		{10, 100, ethcmn.BytesToHash([]byte{0x1})},
+		{12, 50, ethcmn.Hash{}},
+		{20, 10, ethcmn.Hash{}},
+		{21, 10, ethcmn.Hash{}},
+	} {
+		acc, err := index.GetAccount(addr, tc.height)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(tc.expBalance), acc.Balance)
+
+		value, err := index.GetState(addr, key, tc.height)
+		require.NoError(t, err)
+		require.Equal(t, tc.expValue, value)
+	}
+
+	// the storage set in the earlier segment doesn't exist in the snapshot of the new segment
+	diff = NewArchiveDiff()
+	other := ethcmn.BytesToAddress([]byte("other"))
+	diff.SetStorage(other, ethcrypto.Keccak256Hash(other.Bytes(), key.Bytes()), ethcmn.Hash{0x1})
+	require.NoError(t, index.Commit(22, diff))
+	require.NoError(t, index.Commit(30, NewArchiveDiff()))
+	value, err := index.GetState(other, key, 22)
+	require.NoError(t, err)
+	require.Equal(t, ethcmn.Hash{0x1}, value)
+	value, err = index.GetState(other, key, 30)
+	require.NoError(t, err)
+	require.Equal(t, ethcmn.Hash{}, value)
+}
+
+func TestArchiveTracker(t *testing.T) {
+	var nilTracker *ArchiveTracker
+	require.NotPanics(t, func() {
+		nilTracker.TrackAccount(ethcmn.Address{})
+		nilTracker.TrackStorage(ethcmn.Address{}, ethcmn.Hash{})
+	})
+
+	tracker := NewArchiveTracker()
+	addr := ethcmn.BytesToAddress([]byte("addr"))
+	tracker.TrackAccount(addr)
+	tracker.TrackStorage(addr, ethcmn.Hash{0x1})
+	tracker.TrackStorage(addr, ethcmn.Hash{0x1})
+
+	accounts, storage := tracker.Flush()
+	require.Len(t, accounts, 1)
+	require.Len(t, storage[addr], 1)
+
+	accounts, storage = tracker.Flush()
+	require.Empty(t, accounts)
+	require.Empty(t, storage)
+
+	// the accounts written by the account keeper are tracked
+	baseAcc := auth.NewBaseAccountWithAddress(addr.Bytes())
+	tracker.OnAccountUpdated(&baseAcc)
+	accounts, storage = tracker.Flush()
+	require.Len(t, accounts, 1)
+	require.Empty(t, storage)
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// CallArgs is the message executed without a transaction, i.e. eth_call and debug_traceCall
+type CallArgs struct {
+	From     common.Address  `json:"from"`
+	To       *common.Address `json:"to"`
+	Gas      hexutil.Uint64  `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Data     hexutil.Bytes   `json:"data"`
+}
+
+// toBigInt returns the big.Int of hexutil.Big, zero if it's nil
+func toBigInt(b *hexutil.Big) *big.Int {
+	if b == nil {
+		return new(big.Int)
+	}
+	return b.ToInt()
+}
+
+// GetGasPrice returns the gas price of the call
+func (args CallArgs) GetGasPrice() *big.Int {
+	return toBigInt(args.GasPrice)
+}
+
+// GetValue returns the value of the call
+func (args CallArgs) GetValue() *big.Int {
+	return toBigInt(args.Value)
+}
+
+// OverrideAccount indicates the overriding fields of account during the execution of a message call.
+// If State is set, the storage of account is replaced by it, otherwise the slots in StateDiff are overridden.
+// State and StateDiff can't be set at the same time
+type OverrideAccount struct {
+	Nonce     *hexutil.Uint64              `json:"nonce"`
+	Code      *hexutil.Bytes               `json:"code"`
+	Balance   **hexutil.Big                `json:"balance"`
+	State     *map[common.Hash]common.Hash `json:"state"`
+	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+}
+
+// StateOverride is the collection of overridden accounts, which is compatible with the one of go-ethereum
+type StateOverride map[common.Address]OverrideAccount
+
+// Apply overrides the fields of specified accounts into the state db
+func (diff StateOverride) Apply(csdb *CommitStateDB) error {
+	for addr, account := range diff {
+		if account.State != nil && account.StateDiff != nil {
+			return fmt.Errorf("account %s has both 'state' and 'stateDiff'", addr.Hex())
+		}
+
+		if account.Nonce != nil {
+			csdb.SetNonce(addr, uint64(*account.Nonce))
+		}
+		if account.Code != nil {
+			csdb.SetCode(addr, *account.Code)
+		}
+		if account.Balance != nil {
+			csdb.SetBalance(addr, (*account.Balance).ToInt())
+		}
+		if account.State != nil {
+			csdb.SetStorage(addr, *account.State)
+		}
+		if account.StateDiff != nil {
+			for key, value := range *account.StateDiff {
+				csdb.SetState(addr, key, value)
+			}
+		}
+	}
+	return csdb.Error()
+}
+
+// QuerySimulateCallParams is the params of the query to simulate a message call with state overrides
+type QuerySimulateCallParams struct {
+	Call      CallArgs      `json:"call"`
+	Overrides StateOverride `json:"overrides"`
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddressList is the type alias for []sdk.AccAddress
+type AddressList []sdk.AccAddress
+
+// String returns a human readable string representation of AddressList
+func (al AddressList) String() string {
+	var b strings.Builder
+	b.WriteString("Address List:\n")
+	for i, addr := range al {
+		b.WriteString(fmt.Sprintf("\t%s", addr.String()))
+		if i != len(al)-1 {
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// ValidateBasic checks that the address list isn't empty and contains no duplicated or empty address
+func (al AddressList) ValidateBasic() error {
+	if len(al) == 0 {
+		return ErrEmptyAddressList
+	}
+
+	seen := make(map[string]bool, len(al))
+	for _, addr := range al {
+		if addr.Empty() {
+			return ErrEmptyAddressList
+		}
+		if seen[addr.String()] {
+			return ErrDuplicatedAddr
+		}
+		seen[addr.String()] = true
+	}
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Contract deployment whitelist & contract blocked list
+// ----------------------------------------------------------------------------
+
+// SetContractDeploymentWhitelist sets the target address list into whitelist store
+func (csdb *CommitStateDB) SetContractDeploymentWhitelist(addrList AddressList) {
+	store := csdb.ctx.KVStore(csdb.storeKey)
+	for i := 0; i < len(addrList); i++ {
+		store.Set(GetContractDeploymentWhitelistMemberKey(addrList[i]), []byte(""))
+	}
+}
+
+// DeleteContractDeploymentWhitelist deletes the target address list from whitelist store
+func (csdb *CommitStateDB) DeleteContractDeploymentWhitelist(addrList AddressList) {
+	store := csdb.ctx.KVStore(csdb.storeKey)
+	for i := 0; i < len(addrList); i++ {
+		store.Delete(GetContractDeploymentWhitelistMemberKey(addrList[i]))
+	}
+}
+
+// GetContractDeploymentWhitelist gets the whole contract deployment whitelist currently
+func (csdb *CommitStateDB) GetContractDeploymentWhitelist() (whitelist AddressList) {
+	iterator := sdk.KVStorePrefixIterator(csdb.ctx.KVStore(csdb.storeKey), KeyPrefixContractDeploymentWhitelist)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		whitelist = append(whitelist, splitApprovedDeployerAddress(iterator.Key()))
+	}
+
+	return
+}
+
+// IsDeployerInWhitelist checks whether the deployer is in the whitelist as a distributor
+func (csdb *CommitStateDB) IsDeployerInWhitelist(deployerAddr sdk.AccAddress) bool {
+	return csdb.ctx.KVStore(csdb.storeKey).Has(GetContractDeploymentWhitelistMemberKey(deployerAddr))
+}
+
+// SetContractBlockedList sets the target address list into blocked list store
+func (csdb *CommitStateDB) SetContractBlockedList(addrList AddressList) {
+	store := csdb.ctx.KVStore(csdb.storeKey)
+	for i := 0; i < len(addrList); i++ {
+		store.Set(GetContractBlockedListMemberKey(addrList[i]), []byte(""))
+	}
+}
+
+// DeleteContractBlockedList deletes the target address list from blocked list store
+func (csdb *CommitStateDB) DeleteContractBlockedList(addrList AddressList) {
+	store := csdb.ctx.KVStore(csdb.storeKey)
+	for i := 0; i < len(addrList); i++ {
+		store.Delete(GetContractBlockedListMemberKey(addrList[i]))
+	}
+}
+
+// GetContractBlockedList gets the whole contract blocked list currently
+func (csdb *CommitStateDB) GetContractBlockedList() (blockedList AddressList) {
+	iterator := sdk.KVStorePrefixIterator(csdb.ctx.KVStore(csdb.storeKey), KeyPrefixContractBlockedList)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		blockedList = append(blockedList, splitBlockedContractAddress(iterator.Key()))
+	}
+
+	return
+}
+
+// IsContractInBlockedList checks whether the contract address is in the blocked list
+func (csdb *CommitStateDB) IsContractInBlockedList(contractAddr sdk.AccAddress) bool {
+	return csdb.ctx.KVStore(csdb.storeKey).Has(GetContractBlockedListMemberKey(contractAddr))
+}
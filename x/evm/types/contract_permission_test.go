@@ -0,0 +1,33 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressList_ValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("address_1___________"))
+	addr2 := sdk.AccAddress([]byte("address_2___________"))
+
+	testCases := []struct {
+		name     string
+		addrList AddressList
+		expErr   error
+	}{
+		{"valid address list", AddressList{addr1, addr2}, nil},
+		{"empty address list", AddressList{}, ErrEmptyAddressList},
+		{"empty address", AddressList{addr1, sdk.AccAddress{}}, ErrEmptyAddressList},
+		{"duplicated address", AddressList{addr1, addr2, addr1}, ErrDuplicatedAddr},
+	}
+
+	for _, tc := range testCases {
+		err := tc.addrList.ValidateBasic()
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Equal(t, tc.expErr, err, tc.name)
+		}
+	}
+}
@@ -28,6 +28,27 @@ var (
 	// ErrStrConvertFailed returns an error if failed to convert string
 	ErrStrConvertFailed = sdkerrors.Register(ModuleName, 9, "Failed to convert string")
 
+	// ErrUnauthorizedAccount returns an error if the deployer isn't in the contract deployment whitelist
+	ErrUnauthorizedAccount = sdkerrors.Register(ModuleName, 10, "failed. unauthorized account to deploy contract")
+
+	// ErrCallBlockedContract returns an error if the contract called is in the contract blocked list
+	ErrCallBlockedContract = sdkerrors.Register(ModuleName, 11, "failed. the contract is in the contract blocked list")
+
+	// ErrEmptyAddressList returns an error if the address list is empty
+	ErrEmptyAddressList = sdkerrors.Register(ModuleName, 12, "failed. empty address list is not allowed")
+
+	// ErrDuplicatedAddr returns an error if the address is duplicated in the address list
+	ErrDuplicatedAddr = sdkerrors.Register(ModuleName, 13, "failed. duplicated address in the address list")
+
+	// ErrContractAddressCollision returns an error if a native contract is deployed at the address of another contract
+	ErrContractAddressCollision = sdkerrors.Register(ModuleName, 14, "failed. contract address collision")
+
+	// ErrArchiveIndexDisabled returns an error if the archive index of the evm state isn't enabled on the node
+	ErrArchiveIndexDisabled = sdkerrors.Register(ModuleName, 15, "the evm archive index is disabled")
+
+	// ErrStatePruned returns an error if the state of the height is neither in the store nor in the archive index
+	ErrStatePruned = sdkerrors.Register(ModuleName, 16, "the state of the height is pruned")
+
 	CodeSpaceEvmCallFailed = uint32(7)
 
 	ErrorHexData = "HexData"
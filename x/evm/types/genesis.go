@@ -12,10 +12,12 @@ import (
 type (
 	// GenesisState defines the evm module genesis state
 	GenesisState struct {
-		Accounts    []GenesisAccount  `json:"accounts"`
-		TxsLogs     []TransactionLogs `json:"txs_logs"`
-		ChainConfig ChainConfig       `json:"chain_config"`
-		Params      Params            `json:"params"`
+		Accounts                    []GenesisAccount  `json:"accounts"`
+		TxsLogs                     []TransactionLogs `json:"txs_logs"`
+		ChainConfig                 ChainConfig       `json:"chain_config"`
+		Params                      Params            `json:"params"`
+		ContractDeploymentWhitelist AddressList       `json:"contract_deployment_whitelist"`
+		ContractBlockedList         AddressList       `json:"contract_blocked_list"`
 	}
 
 	// GenesisAccount defines an account to be initialized in the genesis state.
@@ -120,5 +122,16 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if len(gs.ContractDeploymentWhitelist) != 0 {
+		if err := gs.ContractDeploymentWhitelist.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid contract deployment whitelist: %w", err)
+		}
+	}
+	if len(gs.ContractBlockedList) != 0 {
+		if err := gs.ContractBlockedList.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid contract blocked list: %w", err)
+		}
+	}
+
 	return gs.Params.Validate()
 }
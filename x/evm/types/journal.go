@@ -193,6 +193,15 @@ type (
 		address *ethcmn.Address
 		slot    *ethcmn.Hash
 	}
+
+	// Changes of native modules made by native contracts.
+	nativeChange struct {
+		prevCtx sdk.Context
+	}
+	nativeCoinsChange struct {
+		account *ethcmn.Address
+		prev    sdk.SysCoins
+	}
 )
 
 func (ch createObjectChange) revert(s *CommitStateDB) {
@@ -375,3 +384,23 @@ func (ch accessListAddSlotChange) revert(s *CommitStateDB) {
 func (ch accessListAddSlotChange) dirtied() *ethcmn.Address {
 	return nil
 }
+
+func (ch nativeChange) revert(s *CommitStateDB) {
+	s.ctx = ch.prevCtx
+	s.nativeLayers = s.nativeLayers[:len(s.nativeLayers)-1]
+}
+
+func (ch nativeChange) dirtied() *ethcmn.Address {
+	return nil
+}
+
+func (ch nativeCoinsChange) revert(s *CommitStateDB) {
+	so := s.getStateObject(*ch.account)
+	if so != nil {
+		_ = so.account.SetCoins(ch.prev)
+	}
+}
+
+func (ch nativeCoinsChange) dirtied() *ethcmn.Address {
+	return ch.account
+}
@@ -27,6 +27,9 @@ var (
 	KeyPrefixStorage     = []byte{0x05}
 	KeyPrefixChainConfig = []byte{0x06}
 	KeyPrefixHeightHash  = []byte{0x07}
+
+	KeyPrefixContractDeploymentWhitelist = []byte{0x08}
+	KeyPrefixContractBlockedList         = []byte{0x09}
 )
 
 // HeightHashKey returns the key for the given chain epoch and height.
@@ -47,3 +50,23 @@ func BloomKey(height int64) []byte {
 func AddressStoragePrefix(address ethcmn.Address) []byte {
 	return append(KeyPrefixStorage, address.Bytes()...)
 }
+
+// GetContractDeploymentWhitelistMemberKey builds the key of a deployer in the contract deployment whitelist
+func GetContractDeploymentWhitelistMemberKey(distributorAddr sdk.AccAddress) []byte {
+	return append(KeyPrefixContractDeploymentWhitelist, distributorAddr...)
+}
+
+// GetContractBlockedListMemberKey builds the key of a contract in the contract blocked list
+func GetContractBlockedListMemberKey(contractAddr sdk.AccAddress) []byte {
+	return append(KeyPrefixContractBlockedList, contractAddr...)
+}
+
+// splitApprovedDeployerAddress splits the address of deployer from the key of contract deployment whitelist
+func splitApprovedDeployerAddress(key []byte) sdk.AccAddress {
+	return key[1:]
+}
+
+// splitBlockedContractAddress splits the address of contract from the key of contract blocked list
+func splitBlockedContractAddress(key []byte) sdk.AccAddress {
+	return key[1:]
+}
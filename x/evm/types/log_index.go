@@ -0,0 +1,358 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/viper"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	// LogIndexDir is the name of the database of the log index in the data directory
+	LogIndexDir = "evm_logs"
+
+	// FlagEnableLogIndex is the flag to index the evm logs by address and topics to serve eth_getLogs
+	FlagEnableLogIndex = "enable-evm-log-index"
+	// FlagLogIndexMaxRange is the flag of the max number of blocks queried from the log index in a request
+	FlagLogIndexMaxRange = "evm-log-index-max-range"
+	// FlagLogIndexMaxResults is the flag of the max number of logs returned from the log index in a request
+	FlagLogIndexMaxResults = "evm-log-index-max-results"
+
+	DefaultLogIndexMaxRange   = 1000000
+	DefaultLogIndexMaxResults = 10000
+)
+
+var (
+	logIndex *LogIndex
+
+	logIndexLogPrefix     = []byte("l") // logIndexLogPrefix + height + log index -> json(log)
+	logIndexAddressPrefix = []byte("a") // logIndexAddressPrefix + address + height + log index -> nil
+	logIndexTopicPrefix   = []byte("t") // logIndexTopicPrefix + topic position + topic + height + log index -> nil
+	logIndexStartKey      = []byte("start")
+	logIndexLatestKey     = []byte("latest")
+)
+
+// LogFilter is the criteria of the logs queried from the log index
+type LogFilter struct {
+	FromBlock int64
+	ToBlock   int64
+	Addresses []ethcmn.Address
+	Topics    [][]ethcmn.Hash
+	// Offset is the number of the matched logs skipped, and Limit is the max number of the logs returned
+	Offset int
+	Limit  int
+}
+
+// LogIndex indexes the evm logs by address and topic0-3 in a side database, which is built after each block is
+// committed. It serves the range queries of eth_getLogs without scanning the blocks one by one
+type LogIndex struct {
+	db         dbm.DB
+	maxRange   int64
+	maxResults int
+
+	mtx     sync.Mutex
+	pending []*ethtypes.Log
+}
+
+// InitLogIndex initializes the log index with the database and the query limits in the flags
+func InitLogIndex(db dbm.DB) {
+	logIndex = NewLogIndex(db, viper.GetInt64(FlagLogIndexMaxRange), viper.GetInt(FlagLogIndexMaxResults))
+}
+
+// NewLogIndex creates a new instance of LogIndex. The default limits are used if the limits are not positive
+func NewLogIndex(db dbm.DB, maxRange int64, maxResults int) *LogIndex {
+	if maxRange <= 0 {
+		maxRange = DefaultLogIndexMaxRange
+	}
+	if maxResults <= 0 {
+		maxResults = DefaultLogIndexMaxResults
+	}
+	return &LogIndex{db: db, maxRange: maxRange, maxResults: maxResults}
+}
+
+// GetLogIndex returns the log index of the node, which is nil if it's disabled
+func GetLogIndex() *LogIndex {
+	return logIndex
+}
+
+// LogIndexDb opens the database of the log index in the data directory of the node
+func LogIndexDb() dbm.DB {
+	dataDir := filepath.Join(viper.GetString("home"), "data")
+	db, err := sdk.NewLevelDB(LogIndexDir, dataDir)
+	if err != nil {
+		panic(err)
+	}
+	return db
+}
+
+// StartHeight returns the first height of the continuous blocks indexed
+func (li *LogIndex) StartHeight() int64 {
+	return li.getHeight(logIndexStartKey)
+}
+
+// LatestHeight returns the latest height indexed
+func (li *LogIndex) LatestHeight() int64 {
+	return li.getHeight(logIndexLatestKey)
+}
+
+// AddLogs adds the logs of a tx executed in the current block, which are indexed when the block is committed
+func (li *LogIndex) AddLogs(logs []*ethtypes.Log) {
+	li.mtx.Lock()
+	defer li.mtx.Unlock()
+	li.pending = append(li.pending, logs...)
+}
+
+// Commit indexes the logs added in the block of the height. The indexed range restarts from the height if some blocks
+// are missed, e.g. the log index was disabled for a while
+func (li *LogIndex) Commit(height int64) error {
+	li.mtx.Lock()
+	logs := li.pending
+	li.pending = nil
+	li.mtx.Unlock()
+
+	batch := li.db.NewBatch()
+	defer batch.Close()
+	if err := li.writeBlock(batch, height, logs); err != nil {
+		return err
+	}
+	if start, latest := li.StartHeight(), li.LatestHeight(); start == 0 || height > latest+1 {
+		batch.Set(logIndexStartKey, sdk.Uint64ToBigEndian(uint64(height)))
+	}
+	if height > li.LatestHeight() {
+		batch.Set(logIndexLatestKey, sdk.Uint64ToBigEndian(uint64(height)))
+	}
+	return batch.WriteSync()
+}
+
+// IndexBlock indexes the logs of the block at the height without changing the indexed range, which is used to rebuild
+// the log index of the existing blocks
+func (li *LogIndex) IndexBlock(height int64, logs []*ethtypes.Log) error {
+	batch := li.db.NewBatch()
+	defer batch.Close()
+	if err := li.writeBlock(batch, height, logs); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// ExtendRange extends the indexed range with the blocks rebuilt, which must be continuous with the range indexed
+func (li *LogIndex) ExtendRange(start, end int64) error {
+	if start > end {
+		return fmt.Errorf("invalid range [%d, %d]", start, end)
+	}
+
+	indexedStart, latest := li.StartHeight(), li.LatestHeight()
+	if indexedStart != 0 && (end+1 < indexedStart || start > latest+1) {
+		return fmt.Errorf("range [%d, %d] is not continuous with the indexed range [%d, %d]", start, end,
+			indexedStart, latest)
+	}
+
+	batch := li.db.NewBatch()
+	defer batch.Close()
+	if indexedStart == 0 || start < indexedStart {
+		batch.Set(logIndexStartKey, sdk.Uint64ToBigEndian(uint64(start)))
+	}
+	if end > latest {
+		batch.Set(logIndexLatestKey, sdk.Uint64ToBigEndian(uint64(end)))
+	}
+	return batch.WriteSync()
+}
+
+// GetLogs returns the logs matching the filter in the order of the blocks and log indexes. The range of the filter
+// must be in the indexed range and not longer than the max range. All the logs are returned if the limit is zero,
+// which fails if there are more logs than the max results
+func (li *LogIndex) GetLogs(filter LogFilter) ([]*ethtypes.Log, error) {
+	if filter.FromBlock > filter.ToBlock {
+		return nil, fmt.Errorf("invalid block range [%d, %d]", filter.FromBlock, filter.ToBlock)
+	}
+	if filter.ToBlock-filter.FromBlock+1 > li.maxRange {
+		return nil, fmt.Errorf("block range [%d, %d] exceeds the max range %d of the log index", filter.FromBlock,
+			filter.ToBlock, li.maxRange)
+	}
+	if start, latest := li.StartHeight(), li.LatestHeight(); start == 0 || filter.FromBlock < start ||
+		filter.ToBlock > latest {
+		return nil, fmt.Errorf("block range [%d, %d] is out of the indexed range [%d, %d]", filter.FromBlock,
+			filter.ToBlock, start, latest)
+	}
+	if filter.Offset < 0 || filter.Limit < 0 {
+		return nil, fmt.Errorf("invalid offset %d or limit %d", filter.Offset, filter.Limit)
+	}
+	// all the logs are returned without the limit, which fails if there are more logs than the max results
+	all := filter.Limit == 0
+	limit := filter.Limit
+	if all || limit > li.maxResults {
+		limit = li.maxResults
+	}
+
+	positions, err := li.candidates(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []*ethtypes.Log
+	skipped := 0
+	for _, position := range positions {
+		bz, err := li.db.Get(append(append([]byte{}, logIndexLogPrefix...), position...))
+		if err != nil {
+			return nil, err
+		}
+		var log ethtypes.Log
+		if err := json.Unmarshal(bz, &log); err != nil {
+			return nil, err
+		}
+		if !matchLog(&log, filter.Addresses, filter.Topics) {
+			continue
+		}
+
+		if skipped < filter.Offset {
+			skipped++
+			continue
+		}
+		if len(logs) == limit {
+			if all {
+				return nil, fmt.Errorf("query returned more than %d results, narrow the block range or paginate the query",
+					li.maxResults)
+			}
+			break
+		}
+		logs = append(logs, &log)
+	}
+	return logs, nil
+}
+
+// candidates returns the sorted positions (height + log index) of the logs which may match the filter. It scans the
+// address index if the addresses are given, or the index of the first topic position given, or all the logs in range
+func (li *LogIndex) candidates(filter LogFilter) ([][]byte, error) {
+	var prefixes [][]byte
+	switch {
+	case len(filter.Addresses) != 0:
+		for _, addr := range filter.Addresses {
+			prefixes = append(prefixes, append(append([]byte{}, logIndexAddressPrefix...), addr.Bytes()...))
+		}
+	default:
+		for i, topics := range filter.Topics {
+			if len(topics) == 0 {
+				continue
+			}
+			for _, topic := range topics {
+				prefixes = append(prefixes, topicIndexPrefixKey(i, topic))
+			}
+			break
+		}
+	}
+	if len(prefixes) == 0 {
+		prefixes = [][]byte{logIndexLogPrefix}
+	}
+
+	seen := make(map[string]struct{})
+	var positions [][]byte
+	for _, prefix := range prefixes {
+		it, err := li.db.Iterator(
+			append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(filter.FromBlock))...),
+			append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(filter.ToBlock+1))...),
+		)
+		if err != nil {
+			return nil, err
+		}
+		for ; it.Valid(); it.Next() {
+			position := it.Key()[len(prefix):]
+			if _, ok := seen[string(position)]; !ok {
+				seen[string(position)] = struct{}{}
+				positions = append(positions, position)
+			}
+		}
+		it.Close()
+	}
+
+	// the positions of different prefixes are merged into the order of the blocks
+	if len(prefixes) > 1 {
+		sort.Slice(positions, func(i, j int) bool {
+			return bytes.Compare(positions[i], positions[j]) < 0
+		})
+	}
+	return positions, nil
+}
+
+func (li *LogIndex) writeBlock(batch dbm.Batch, height int64, logs []*ethtypes.Log) error {
+	for _, log := range logs {
+		if log.Topics == nil {
+			// the topics are required in the json of the log
+			copied := *log
+			copied.Topics = []ethcmn.Hash{}
+			log = &copied
+		}
+		bz, err := json.Marshal(log)
+		if err != nil {
+			return err
+		}
+		position := logPositionKey(height, log.Index)
+		batch.Set(append(append([]byte{}, logIndexLogPrefix...), position...), bz)
+		batch.Set(append(append(append([]byte{}, logIndexAddressPrefix...), log.Address.Bytes()...), position...), []byte{})
+		for i, topic := range log.Topics {
+			batch.Set(append(topicIndexPrefixKey(i, topic), position...), []byte{})
+		}
+	}
+	return nil
+}
+
+func (li *LogIndex) getHeight(key []byte) int64 {
+	bz, err := li.db.Get(key)
+	if err != nil || len(bz) != 8 {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+func topicIndexPrefixKey(position int, topic ethcmn.Hash) []byte {
+	key := append(append([]byte{}, logIndexTopicPrefix...), byte(position))
+	return append(key, topic.Bytes()...)
+}
+
+func logPositionKey(height int64, index uint) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(height)), sdk.Uint64ToBigEndian(uint64(index))...)
+}
+
+// matchLog returns whether the log matches the addresses and the topics of each position. Empty addresses or topics of
+// a position match anything
+func matchLog(log *ethtypes.Log, addresses []ethcmn.Address, topics [][]ethcmn.Hash) bool {
+	if len(addresses) != 0 && !containsAddress(addresses, log.Address) {
+		return false
+	}
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+	for i, sub := range topics {
+		if len(sub) == 0 {
+			continue
+		}
+		match := false
+		for _, topic := range sub {
+			if log.Topics[i] == topic {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAddress(addresses []ethcmn.Address, addr ethcmn.Address) bool {
+	for _, address := range addresses {
+		if address == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"testing"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestLogIndex(t *testing.T) {
+	index := NewLogIndex(dbm.NewMemDB(), 10, 3)
+	addr1, addr2 := ethcmn.BytesToAddress([]byte("addr1")), ethcmn.BytesToAddress([]byte("addr2"))
+	topicA, topicB := ethcmn.BytesToHash([]byte("topicA")), ethcmn.BytesToHash([]byte("topicB"))
+	newLog := func(height uint64, index uint, addr ethcmn.Address, topics ...ethcmn.Hash) *ethtypes.Log {
+		return &ethtypes.Log{Address: addr, Topics: topics, BlockNumber: height, Index: index,
+			TxHash: ethcmn.BytesToHash([]byte("tx"))}
+	}
+
+	// blocks 5-7 are indexed after the blocks are committed
+	index.AddLogs([]*ethtypes.Log{newLog(5, 0, addr1, topicA), newLog(5, 1, addr2, topicB)})
+	require.NoError(t, index.Commit(5))
+	require.NoError(t, index.Commit(6))
+	index.AddLogs([]*ethtypes.Log{newLog(7, 0, addr1, topicB, topicA), newLog(7, 1, addr2, topicA)})
+	require.NoError(t, index.Commit(7))
+	require.Equal(t, int64(5), index.StartHeight())
+	require.Equal(t, int64(7), index.LatestHeight())
+
+	for _, tc := range []struct {
+		name      string
+		filter    LogFilter
+		expBlocks []uint64
+		expIdxs   []uint
+	}{
+		{"all", LogFilter{FromBlock: 5, ToBlock: 7}, nil, nil},
+		{"address", LogFilter{FromBlock: 5, ToBlock: 7, Addresses: []ethcmn.Address{addr1}},
+			[]uint64{5, 7}, []uint{0, 0}},
+		{"addresses", LogFilter{FromBlock: 5, ToBlock: 7, Addresses: []ethcmn.Address{addr2, addr1}, Limit: 3},
+			[]uint64{5, 5, 7}, []uint{0, 1, 0}},
+		{"topic0", LogFilter{FromBlock: 5, ToBlock: 7, Topics: [][]ethcmn.Hash{{topicA}}},
+			[]uint64{5, 7}, []uint{0, 1}},
+		{"topic1", LogFilter{FromBlock: 5, ToBlock: 7, Topics: [][]ethcmn.Hash{{}, {topicA}}},
+			[]uint64{7}, []uint{0}},
+		{"address and topic", LogFilter{FromBlock: 6, ToBlock: 7, Addresses: []ethcmn.Address{addr2},
+			Topics: [][]ethcmn.Hash{{topicA, topicB}}}, []uint64{7}, []uint{1}},
+		{"page", LogFilter{FromBlock: 5, ToBlock: 7, Offset: 1, Limit: 2}, []uint64{5, 7}, []uint{1, 0}},
+		{"last page", LogFilter{FromBlock: 5, ToBlock: 7, Offset: 3, Limit: 2}, []uint64{7}, []uint{1}},
+	} {
+		logs, err := index.GetLogs(tc.filter)
+		if tc.expBlocks == nil {
+			// more than the max results
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+		require.Len(t, logs, len(tc.expBlocks), tc.name)
+		for i, log := range logs {
+			require.Equal(t, tc.expBlocks[i], log.BlockNumber, tc.name)
+			require.Equal(t, tc.expIdxs[i], log.Index, tc.name)
+		}
+	}
+
+	// out of the indexed range or the max range
+	_, err := index.GetLogs(LogFilter{FromBlock: 4, ToBlock: 7})
+	require.Error(t, err)
+	_, err = index.GetLogs(LogFilter{FromBlock: 5, ToBlock: 8})
+	require.Error(t, err)
+	index.maxRange = 2
+	_, err = index.GetLogs(LogFilter{FromBlock: 5, ToBlock: 7, Addresses: []ethcmn.Address{addr1}})
+	require.Error(t, err)
+
+	// the rebuilt blocks must be continuous with the indexed ones
+	require.NoError(t, index.IndexBlock(3, []*ethtypes.Log{newLog(3, 0, addr1)}))
+	require.Error(t, index.ExtendRange(3, 3))
+	require.NoError(t, index.IndexBlock(4, nil))
+	require.NoError(t, index.ExtendRange(3, 4))
+	require.Equal(t, int64(3), index.StartHeight())
+	logs, err := index.GetLogs(LogFilter{FromBlock: 3, ToBlock: 4, Addresses: []ethcmn.Address{addr1}})
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+
+	// the indexed range restarts after some blocks are missed
+	require.NoError(t, index.Commit(10))
+	require.Equal(t, int64(10), index.StartHeight())
+	require.Equal(t, int64(10), index.LatestHeight())
+}
@@ -0,0 +1,249 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"unsafe"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// NativeContractCode is the code deployed at the addresses of native contracts. It starts with the designated invalid
+// opcode 0xfe, so that the call fails if it's ever executed by the evm interpreter
+var NativeContractCode = append([]byte{0xfe}, []byte("okexchain native contract")...)
+
+var (
+	errNativeContractNotFound = errors.New("native contract not found")
+	errNativeContractContext  = errors.New("native contract can only be called directly")
+	errNativeContractValue    = errors.New("native contract doesn't accept value")
+)
+
+// NativeContract is a stateful precompiled contract, which is executed with the keepers of native modules
+type NativeContract interface {
+	// RequiredGas returns the base gas cost of a call. The gas consumed by the native modules is charged additionally
+	RequiredGas(input []byte) uint64
+	// Run executes a call on the cached context of native modules. The state changes are discarded if an error is
+	// returned, readOnly is true if the call is a STATICCALL
+	Run(ctx sdk.Context, caller ethcmn.Address, input []byte, readOnly bool) ([]byte, error)
+}
+
+// NativeContracts is the native contracts by address
+type NativeContracts map[ethcmn.Address]NativeContract
+
+// NativeContractResolver resolves the native contracts which are created by the native modules at runtime, e.g. the
+// erc20 contracts of native tokens
+type NativeContractResolver interface {
+	GetNativeContract(ctx sdk.Context, addr ethcmn.Address) (NativeContract, bool)
+}
+
+// nativeLogsKey is the context key of the logs emitted by a native contract call
+type nativeLogsKey struct{}
+
+// AddNativeContractLog adds an evm log into the native contract call of ctx. The address and the position of the log
+// are filled after the call succeeds, and it's ignored if ctx isn't the context of a native contract call
+func AddNativeContractLog(ctx sdk.Context, topics []ethcmn.Hash, data []byte) {
+	if logs, ok := ctx.Value(nativeLogsKey{}).(*[]*ethtypes.Log); ok {
+		*logs = append(*logs, &ethtypes.Log{Topics: topics, Data: data})
+	}
+}
+
+// nativeLayer is the cache of the native module state changes made by a native contract call
+type nativeLayer struct {
+	parent sdk.Context
+	ctx    sdk.Context
+	write  func()
+}
+
+// hookedInterpreter implements vm.Interpreter in front of the evm interpreter, so that every call of the evm goes
+// through it, including the inner CALL, CALLCODE, DELEGATECALL and STATICCALL. It rejects the code of the contracts in
+// the blocked list and executes the native contracts with the state of CommitStateDB
+type hookedInterpreter struct {
+	native         nativeInterpreter
+	evmInterpreter vm.Interpreter
+}
+
+// CanRun returns true for any code, which is run by the evm interpreter unless it's the one of native contracts
+func (hi hookedInterpreter) CanRun([]byte) bool {
+	return true
+}
+
+// Run executes the code of contract if it isn't the code of a contract in the blocked list
+func (hi hookedInterpreter) Run(contract *vm.Contract, input []byte, readOnly bool) ([]byte, error) {
+	// CodeAddr is nil for the init code of a contract creation
+	if contract.CodeAddr != nil && hi.native.csdb.IsContractInBlockedList(contract.CodeAddr.Bytes()) {
+		return nil, ErrCallBlockedContract
+	}
+	if hi.native.CanRun(contract.Code) {
+		return hi.native.Run(contract, input, readOnly)
+	}
+	return hi.evmInterpreter.Run(contract, input, readOnly)
+}
+
+// nativeInterpreter executes the native contracts with the state of CommitStateDB
+type nativeInterpreter struct {
+	csdb *CommitStateDB
+}
+
+// CanRun returns true if the code is the one of native contracts
+func (ni nativeInterpreter) CanRun(code []byte) bool {
+	return bytes.Equal(code, NativeContractCode)
+}
+
+// Run executes the native contract at the address of contract
+func (ni nativeInterpreter) Run(contract *vm.Contract, input []byte, readOnly bool) ([]byte, error) {
+	nc, found := ni.csdb.getNativeContract(contract.Address())
+	if !found {
+		return nil, errNativeContractNotFound
+	}
+	// reject DELEGATECALL and CALLCODE, which run the code with the storage of caller
+	if contract.CodeAddr == nil || *contract.CodeAddr != contract.Address() {
+		return nil, errNativeContractContext
+	}
+	if contract.Value().Sign() != 0 {
+		return nil, errNativeContractValue
+	}
+	if !contract.UseGas(nc.RequiredGas(input)) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	ret, gasUsed, err := ni.csdb.callNativeContract(nc, contract.Address(), contract.Caller(), input, contract.Gas,
+		readOnly)
+	contract.UseGas(gasUsed)
+	return ret, err
+}
+
+// getNativeContract returns the native contract at addr, which is either registered or resolved at runtime
+func (csdb *CommitStateDB) getNativeContract(addr ethcmn.Address) (NativeContract, bool) {
+	if nc, found := csdb.nativeContracts[addr]; found {
+		return nc, true
+	}
+	if csdb.nativeContractResolver == nil {
+		return nil, false
+	}
+	return csdb.nativeContractResolver.GetNativeContract(csdb.ctx, addr)
+}
+
+// setHookedInterpreter installs the hooked interpreter into evm in place of the evm interpreter.
+// NOTE: go-ethereum doesn't support stateful precompiled contracts nor a hook of inner calls, so the unexported
+// interpreters of evm are set by reflection.
+func setHookedInterpreter(evm *vm.EVM, csdb *CommitStateDB) {
+	interpreters := []vm.Interpreter{
+		hookedInterpreter{native: nativeInterpreter{csdb: csdb}, evmInterpreter: evm.Interpreter()},
+	}
+	field := reflect.ValueOf(evm).Elem().FieldByName("interpreters")
+	if !field.IsValid() || field.Type() != reflect.TypeOf(interpreters) {
+		panic("the interpreters of go-ethereum evm are not found, the hooked interpreter can't be installed")
+	}
+	reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem().Set(reflect.ValueOf(interpreters))
+}
+
+// callNativeContract executes the native contract on a new cache layer of the current context, and returns the gas
+// consumed by the native modules. The layer is kept until the state transition is finished and is journaled, so that
+// it's discarded if the evm reverts to a previous snapshot.
+// The balances of the live state objects are written into the layer before the call, and they are updated with the
+// balances in the layer after the call, so that the native modules and the evm see the same accounts.
+func (csdb *CommitStateDB) callNativeContract(nc NativeContract, addr, caller ethcmn.Address, input []byte, gas uint64,
+	readOnly bool) (ret []byte, gasUsed uint64, err error) {
+	parentCtx := csdb.ctx
+	layerCtx, write := parentCtx.CacheContext()
+	csdb.ctx = layerCtx
+	defer func() {
+		if err != nil || readOnly {
+			csdb.ctx = parentCtx
+		}
+	}()
+
+	for _, entry := range csdb.stateObjects {
+		if so := entry.stateObject; !so.deleted && !so.suicided {
+			if err = csdb.updateStateObject(so); err != nil {
+				return nil, 0, err
+			}
+		}
+	}
+
+	gasMeter := sdk.NewGasMeter(gas)
+	logs := new([]*ethtypes.Log)
+	ret, err = runNativeContract(nc, layerCtx.WithGasMeter(gasMeter).WithValue(nativeLogsKey{}, logs), caller, input,
+		readOnly)
+	if gasUsed = gasMeter.GasConsumed(); gasUsed > gas {
+		gasUsed = gas
+	}
+	if err != nil || readOnly {
+		return ret, gasUsed, err
+	}
+
+	csdb.journal.append(nativeChange{prevCtx: parentCtx})
+	csdb.nativeLayers = append(csdb.nativeLayers, nativeLayer{parent: parentCtx, ctx: layerCtx, write: write})
+	for _, log := range *logs {
+		log.Address = addr
+		log.BlockNumber = uint64(parentCtx.BlockHeight())
+		csdb.AddLog(log)
+	}
+
+	for _, entry := range csdb.stateObjects {
+		so := entry.stateObject
+		if so.deleted || so.suicided {
+			continue
+		}
+		acc := csdb.accountKeeper.GetAccount(layerCtx, so.account.GetAddress())
+		if acc == nil || coinsEqual(acc.GetCoins(), so.account.GetCoins()) {
+			continue
+		}
+		csdb.journal.append(nativeCoinsChange{account: &so.address, prev: so.account.GetCoins()})
+		if err = so.account.SetCoins(acc.GetCoins()); err != nil {
+			return nil, gasUsed, err
+		}
+	}
+	return ret, gasUsed, nil
+}
+
+// runNativeContract runs the native contract and converts the out of gas panic of the gas meter into the evm error
+func runNativeContract(nc NativeContract, ctx sdk.Context, caller ethcmn.Address, input []byte,
+	readOnly bool) (ret []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			ret, err = nil, vm.ErrOutOfGas
+		}
+	}()
+
+	return nc.Run(ctx, caller, input, readOnly)
+}
+
+// commitNativeLayers writes the cache layers of native contract calls into the context before the first call, and
+// emits the events of the native modules
+func (csdb *CommitStateDB) commitNativeLayers() {
+	if len(csdb.nativeLayers) == 0 {
+		return
+	}
+
+	// each layer is cached on the previous one
+	for i := len(csdb.nativeLayers) - 1; i >= 0; i-- {
+		csdb.nativeLayers[i].write()
+	}
+	csdb.ctx = csdb.nativeLayers[0].parent
+	for _, layer := range csdb.nativeLayers {
+		csdb.ctx.EventManager().EmitEvents(layer.ctx.EventManager().Events())
+	}
+	csdb.nativeLayers = nil
+}
+
+// coinsEqual returns true if the two coins have the same amounts of all denominations
+func coinsEqual(a, b sdk.SysCoins) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, coin := range a {
+		if !b.AmountOf(coin.Denom).Equal(coin.Amount) {
+			return false
+		}
+	}
+	return true
+}
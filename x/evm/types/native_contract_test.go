@@ -0,0 +1,27 @@
+package types
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSetHookedInterpreter fails if go-ethereum changes the unexported interpreters of evm, which are set by reflection
+func TestSetHookedInterpreter(t *testing.T) {
+	evm := vm.NewEVM(vm.BlockContext{}, vm.TxContext{}, nil, DefaultChainConfig().EthereumConfig(big.NewInt(1)),
+		vm.Config{})
+	evmInterpreter := evm.Interpreter()
+	require.IsType(t, &vm.EVMInterpreter{}, evmInterpreter)
+
+	require.NotPanics(t, func() { setHookedInterpreter(evm, &CommitStateDB{}) })
+
+	field := reflect.ValueOf(evm).Elem().FieldByName("interpreters")
+	require.True(t, field.IsValid())
+	require.Equal(t, 1, field.Len())
+	require.Equal(t, reflect.TypeOf(hookedInterpreter{}), field.Index(0).Elem().Type())
+	// the code which isn't the one of native contracts is still run by the evm interpreter
+	require.Equal(t, evmInterpreter, evm.Interpreter())
+}
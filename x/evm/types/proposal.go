@@ -0,0 +1,176 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/okex/okexchain/x/gov/types"
+)
+
+const (
+	// proposalTypeManageContractDeploymentWhitelist defines the type for a ManageContractDeploymentWhitelistProposal
+	proposalTypeManageContractDeploymentWhitelist = "ManageContractDeploymentWhitelist"
+	// proposalTypeManageContractBlockedList defines the type for a ManageContractBlockedListProposal
+	proposalTypeManageContractBlockedList = "ManageContractBlockedList"
+)
+
+func init() {
+	govtypes.RegisterProposalType(proposalTypeManageContractDeploymentWhitelist)
+	govtypes.RegisterProposalType(proposalTypeManageContractBlockedList)
+	govtypes.RegisterProposalTypeCodec(ManageContractDeploymentWhitelistProposal{},
+		"okexchain/evm/ManageContractDeploymentWhitelistProposal")
+	govtypes.RegisterProposalTypeCodec(ManageContractBlockedListProposal{}, "okexchain/evm/ManageContractBlockedListProposal")
+}
+
+var (
+	_ govtypes.Content = (*ManageContractDeploymentWhitelistProposal)(nil)
+	_ govtypes.Content = (*ManageContractBlockedListProposal)(nil)
+)
+
+// ManageContractDeploymentWhitelistProposal - structure for the proposal to add or delete deployer addresses
+// from the contract deployment whitelist
+type ManageContractDeploymentWhitelistProposal struct {
+	Title            string      `json:"title" yaml:"title"`
+	Description      string      `json:"description" yaml:"description"`
+	DistributorAddrs AddressList `json:"distributor_addresses" yaml:"distributor_addresses"`
+	IsAdded          bool        `json:"is_added" yaml:"is_added"`
+}
+
+// NewManageContractDeploymentWhitelistProposal creates a new instance of ManageContractDeploymentWhitelistProposal
+func NewManageContractDeploymentWhitelistProposal(title, description string, distributorAddrs AddressList, isAdded bool,
+) ManageContractDeploymentWhitelistProposal {
+	return ManageContractDeploymentWhitelistProposal{
+		Title:            title,
+		Description:      description,
+		DistributorAddrs: distributorAddrs,
+		IsAdded:          isAdded,
+	}
+}
+
+// GetTitle returns title of the proposal object
+func (mp ManageContractDeploymentWhitelistProposal) GetTitle() string {
+	return mp.Title
+}
+
+// GetDescription returns description of the proposal object
+func (mp ManageContractDeploymentWhitelistProposal) GetDescription() string {
+	return mp.Description
+}
+
+// ProposalRoute returns route key of the proposal object
+func (mp ManageContractDeploymentWhitelistProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of the proposal object
+func (mp ManageContractDeploymentWhitelistProposal) ProposalType() string {
+	return proposalTypeManageContractDeploymentWhitelist
+}
+
+// ValidateBasic validates the proposal
+func (mp ManageContractDeploymentWhitelistProposal) ValidateBasic() sdk.Error {
+	if err := validateProposalContent(mp.Title, mp.Description); err != nil {
+		return err
+	}
+
+	if mp.ProposalType() != proposalTypeManageContractDeploymentWhitelist {
+		return govtypes.ErrInvalidProposalType(mp.ProposalType())
+	}
+
+	return mp.DistributorAddrs.ValidateBasic()
+}
+
+// String returns a human readable string representation of a ManageContractDeploymentWhitelistProposal
+func (mp ManageContractDeploymentWhitelistProposal) String() string {
+	return fmt.Sprintf(`ManageContractDeploymentWhitelistProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ IsAdded:				%t
+ %s`,
+		mp.Title, mp.Description, mp.ProposalType(), mp.IsAdded, mp.DistributorAddrs)
+}
+
+// ManageContractBlockedListProposal - structure for the proposal to add or delete contract addresses
+// from the contract blocked list
+type ManageContractBlockedListProposal struct {
+	Title         string      `json:"title" yaml:"title"`
+	Description   string      `json:"description" yaml:"description"`
+	ContractAddrs AddressList `json:"contract_addresses" yaml:"contract_addresses"`
+	IsAdded       bool        `json:"is_added" yaml:"is_added"`
+}
+
+// NewManageContractBlockedListProposal creates a new instance of ManageContractBlockedListProposal
+func NewManageContractBlockedListProposal(title, description string, contractAddrs AddressList, isAdded bool,
+) ManageContractBlockedListProposal {
+	return ManageContractBlockedListProposal{
+		Title:         title,
+		Description:   description,
+		ContractAddrs: contractAddrs,
+		IsAdded:       isAdded,
+	}
+}
+
+// GetTitle returns title of the proposal object
+func (mp ManageContractBlockedListProposal) GetTitle() string {
+	return mp.Title
+}
+
+// GetDescription returns description of the proposal object
+func (mp ManageContractBlockedListProposal) GetDescription() string {
+	return mp.Description
+}
+
+// ProposalRoute returns route key of the proposal object
+func (mp ManageContractBlockedListProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of the proposal object
+func (mp ManageContractBlockedListProposal) ProposalType() string {
+	return proposalTypeManageContractBlockedList
+}
+
+// ValidateBasic validates the proposal
+func (mp ManageContractBlockedListProposal) ValidateBasic() sdk.Error {
+	if err := validateProposalContent(mp.Title, mp.Description); err != nil {
+		return err
+	}
+
+	if mp.ProposalType() != proposalTypeManageContractBlockedList {
+		return govtypes.ErrInvalidProposalType(mp.ProposalType())
+	}
+
+	return mp.ContractAddrs.ValidateBasic()
+}
+
+// String returns a human readable string representation of a ManageContractBlockedListProposal
+func (mp ManageContractBlockedListProposal) String() string {
+	return fmt.Sprintf(`ManageContractBlockedListProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ IsAdded:				%t
+ %s`,
+		mp.Title, mp.Description, mp.ProposalType(), mp.IsAdded, mp.ContractAddrs)
+}
+
+func validateProposalContent(title, description string) sdk.Error {
+	if len(strings.TrimSpace(title)) == 0 {
+		return govtypes.ErrInvalidProposalContent("title is required")
+	}
+	if len(title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent("title length is longer than the maximum title length")
+	}
+
+	if len(description) == 0 {
+		return govtypes.ErrInvalidProposalContent("description is required")
+	}
+
+	if len(description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent("description length is longer than the maximum description length")
+	}
+
+	return nil
+}
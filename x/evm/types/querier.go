@@ -21,6 +21,15 @@ const (
 	QueryParameters   = "params"
 	QueryHeightToHash = "heightToHash"
 	QuerySection      = "section"
+	QueryTrace        = "trace"
+	QuerySimulateCall = "simulateCall"
+
+	QueryArchiveAccount = "archiveAccount"
+	QueryArchiveStorage = "archiveStorage"
+	QueryArchiveCode    = "archiveCode"
+
+	QueryContractDeploymentWhitelist = "contract-deployment-whitelist"
+	QueryContractBlockedList         = "contract-blocked-list"
 )
 
 // QueryResBalance is response type for balance query
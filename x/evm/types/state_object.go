@@ -246,6 +246,7 @@ func (so *stateObject) commitState() {
 
 	for _, state := range so.dirtyStorage {
 		// NOTE: key is already prefixed from GetStorageByAddressKey
+		so.stateDB.archiveTracker.TrackStorage(so.address, state.Key)
 
 		// delete empty values from the store
 		if (state.Value == ethcmn.Hash{}) {
@@ -276,6 +277,28 @@ func (so *stateObject) commitState() {
 	so.dirtyStorage = Storage{}
 }
 
+// clearStorage deletes all the storage of the state object from the KVStore and the caches
+func (so *stateObject) clearStorage() {
+	ctx := so.stateDB.ctx
+	store := prefix.NewStore(ctx.KVStore(so.stateDB.storeKey), AddressStoragePrefix(so.Address()))
+
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+		so.stateDB.archiveTracker.TrackStorage(so.address, ethcmn.BytesToHash(key))
+	}
+
+	so.originStorage = Storage{}
+	so.dirtyStorage = Storage{}
+	so.keyToOriginStorageIndex = make(map[ethcmn.Hash]int)
+	so.keyToDirtyStorageIndex = make(map[ethcmn.Hash]int)
+}
+
 // commitCode persists the state object's code to the KVStore.
 func (so *stateObject) commitCode() {
 	ctx := so.stateDB.ctx
@@ -446,7 +469,13 @@ func (so *stateObject) touch() {
 // GetStorageByAddressKey returns a hash of the composite key for a state
 // object's storage prefixed with it's address.
 func (so stateObject) GetStorageByAddressKey(key []byte) ethcmn.Hash {
-	prefix := so.Address().Bytes()
+	return GetStorageByAddressKey(so.Address(), key)
+}
+
+// GetStorageByAddressKey returns a hash of the composite key for the storage
+// of the address, which is the key of the storage in the store
+func GetStorageByAddressKey(addr ethcmn.Address, key []byte) ethcmn.Hash {
+	prefix := addr.Bytes()
 	compositeKey := make([]byte, len(prefix)+len(key))
 
 	copy(compositeKey, prefix)
@@ -39,6 +39,9 @@ type StateTransition struct {
 
 	CoinDenom string
 	GasReturn uint64
+
+	// Tracer is attached to the EVM if it's not nil, i.e debug_traceTransaction
+	Tracer vm.Tracer
 }
 
 // GasInfo returns the gas limit, gas consumed and gas refunded from the EVM transition
@@ -72,7 +75,8 @@ func GetHashFn(ctx sdk.Context, csdb *CommitStateDB) vm.GetHashFunc {
 		case ctx.BlockHeight() > int64(height):
 			// Case 2: if the chain is not the current height we need to retrieve the hash from the store for the
 			// current chain epoch. This only applies if the current height is greater than the requested height.
-			return csdb.WithContext(ctx).GetHeightHash(height)
+			// NOTE: the context of csdb isn't replaced, since it may be the cache layer of native contract calls
+			return csdb.getHeightHash(ctx, height)
 
 		default:
 			// Case 3: heights greater than the current one returns an empty hash.
@@ -109,8 +113,14 @@ func (st StateTransition) newEVM(
 	vmConfig := vm.Config{
 		ExtraEips: extraEIPs,
 	}
+	if st.Tracer != nil {
+		vmConfig.Debug = true
+		vmConfig.Tracer = st.Tracer
+	}
 
-	return vm.NewEVM(blockCtx, txCtx, csdb, config.EthereumConfig(st.ChainID), vmConfig)
+	evm := vm.NewEVM(blockCtx, txCtx, csdb, config.EthereumConfig(st.ChainID), vmConfig)
+	setHookedInterpreter(evm, csdb)
+	return evm
 }
 
 // TransitionDb will transition the state by applying the current transaction and
@@ -166,8 +176,9 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (*Ex
 	// create contract or execute call
 	switch contractCreation {
 	case true:
-		if !params.EnableCreate {
-			return nil, ErrCreateDisabled
+		// the contract deployment is open to the distributors in whitelist even if it's disabled
+		if !params.EnableCreate && !csdb.IsDeployerInWhitelist(st.Sender.Bytes()) {
+			return nil, sdkerrors.Wrapf(ErrUnauthorizedAccount, "sender %s", st.Sender.Hex())
 		}
 
 		ret, contractAddress, leftOverGas, err = evm.Create(senderRef, st.Payload, gasLimit, st.Amount)
@@ -176,6 +187,10 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (*Ex
 		if !params.EnableCall {
 			return nil, ErrCallDisabled
 		}
+		// the contracts in the blocked list called by inner calls are rejected by the hooked interpreter
+		if csdb.IsContractInBlockedList(st.Recipient.Bytes()) {
+			return nil, sdkerrors.Wrapf(ErrCallBlockedContract, "contract %s", st.Recipient.Hex())
+		}
 
 		// Increment the nonce for the next transaction	(just for evm state transition)
 		csdb.SetNonce(st.Sender, csdb.GetNonce(st.Sender)+1)
@@ -202,6 +217,9 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (*Ex
 		return nil, newRevertError(ret, err)
 	}
 
+	// write the state changes of native modules made by native contracts
+	csdb.commitNativeLayers()
+
 	// Resets nonce to value pre state transition
 	csdb.SetNonce(st.Sender, currentNonce)
 
@@ -121,6 +121,7 @@ func (suite *StateDBTestSuite) TestTransitionDb() {
 	priv, err := ethsecp256k1.GenerateKey()
 	suite.Require().NoError(err)
 	recipient := ethcrypto.PubkeyToAddress(priv.ToECDSA().PublicKey)
+	blockedContract := ethcmn.BytesToAddress([]byte("blocked contract"))
 
 	testCase := []struct {
 		name     string
@@ -224,6 +225,48 @@ func (suite *StateDBTestSuite) TestTransitionDb() {
 			},
 			false,
 		},
+		{
+			"create disabled but sender in contract deployment whitelist",
+			func() {
+				suite.stateDB.SetContractDeploymentWhitelist(types.AddressList{suite.address.Bytes()})
+				suite.ctx = suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+			},
+			types.StateTransition{
+				AccountNonce: 124,
+				Price:        big.NewInt(10),
+				GasLimit:     11,
+				Recipient:    nil,
+				Amount:       big.NewInt(0),
+				Payload:      nil,
+				ChainID:      big.NewInt(1),
+				Csdb:         suite.stateDB,
+				TxHash:       &ethcmn.Hash{},
+				Sender:       suite.address,
+				Simulate:     suite.ctx.IsCheckTx(),
+			},
+			true,
+		},
+		{
+			"call contract in blocked list",
+			func() {
+				suite.stateDB.SetParams(types.DefaultParams())
+				suite.stateDB.SetContractBlockedList(types.AddressList{blockedContract.Bytes()})
+			},
+			types.StateTransition{
+				AccountNonce: 123,
+				Price:        big.NewInt(10),
+				GasLimit:     11,
+				Recipient:    &blockedContract,
+				Amount:       big.NewInt(0),
+				Payload:      []byte("data"),
+				ChainID:      big.NewInt(1),
+				Csdb:         suite.stateDB,
+				TxHash:       &ethcmn.Hash{},
+				Sender:       suite.address,
+				Simulate:     suite.ctx.IsCheckTx(),
+			},
+			false,
+		},
 		{
 			"nil gas price",
 			func() {
@@ -287,3 +330,76 @@ func (suite *StateDBTestSuite) TestTransitionDb() {
 	suite.Require().Equal(fromBalance, sdk.NewDec(4940).BigInt())
 	suite.Require().Equal(toBalance, sdk.NewDec(50).BigInt())
 }
+
+func (suite *StateDBTestSuite) TestTransitionDb_BlockedInnerCall() {
+	suite.ctx = suite.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	suite.stateDB = types.CreateEmptyCommitStateDB(suite.app.EvmKeeper.GenerateCSDBParams(), suite.ctx)
+
+	// the blocked contract stores 1 into the slot 0, which is the slot of the caller for a DELEGATECALL
+	blockedContract := ethcmn.BytesToAddress([]byte("blocked contract"))
+	suite.stateDB.SetCode(blockedContract, []byte{0x60, 0x01, 0x60, 0x00, 0x55, 0x00})
+	// the caller contracts store the success flag of the inner call into the slot 1
+	callContract := func(op byte) []byte {
+		code := []byte{0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00}
+		if op == 0xf1 {
+			// value of CALL
+			code = append(code, 0x60, 0x00)
+		}
+		code = append(code, 0x73)
+		code = append(code, blockedContract.Bytes()...)
+		return append(code, 0x5a, op, 0x60, 0x01, 0x55, 0x00)
+	}
+	caller := ethcmn.BytesToAddress([]byte("caller contract"))
+	delegateCaller := ethcmn.BytesToAddress([]byte("delegate caller contract"))
+	suite.stateDB.SetCode(caller, callContract(0xf1))
+	suite.stateDB.SetCode(delegateCaller, callContract(0xf4))
+	suite.Require().NoError(suite.stateDB.Finalise(true))
+
+	slot0, slot1 := ethcmn.BigToHash(big.NewInt(0)), ethcmn.BigToHash(big.NewInt(1))
+	testCases := []struct {
+		name     string
+		blocked  bool
+		contract ethcmn.Address
+		// the contract whose slot 0 is written by the code of the blocked contract
+		storage ethcmn.Address
+	}{
+		{"inner call", false, caller, blockedContract},
+		{"inner delegate call", false, delegateCaller, delegateCaller},
+		{"inner call of blocked contract", true, caller, blockedContract},
+		{"inner delegate call of blocked contract", true, delegateCaller, delegateCaller},
+	}
+
+	for i, tc := range testCases {
+		suite.stateDB.SetState(tc.storage, slot0, ethcmn.Hash{})
+		suite.stateDB.SetState(tc.contract, slot1, ethcmn.Hash{})
+		if tc.blocked {
+			suite.stateDB.SetContractBlockedList(types.AddressList{blockedContract.Bytes()})
+		} else {
+			suite.stateDB.DeleteContractBlockedList(types.AddressList{blockedContract.Bytes()})
+		}
+		suite.Require().NoError(suite.stateDB.Finalise(true), tc.name)
+
+		contract := tc.contract
+		st := types.StateTransition{
+			AccountNonce: uint64(i),
+			Price:        big.NewInt(1),
+			GasLimit:     10000000,
+			Recipient:    &contract,
+			Amount:       big.NewInt(0),
+			ChainID:      big.NewInt(1),
+			Csdb:         suite.stateDB,
+			TxHash:       &ethcmn.Hash{},
+			Sender:       suite.address,
+		}
+		// the outer call succeeds while the inner call of the blocked contract fails
+		_, err := st.TransitionDb(suite.ctx, types.DefaultChainConfig())
+		suite.Require().NoError(err, tc.name)
+
+		written, succeeded := ethcmn.BigToHash(big.NewInt(1)), ethcmn.BigToHash(big.NewInt(1))
+		if tc.blocked {
+			written, succeeded = ethcmn.Hash{}, ethcmn.Hash{}
+		}
+		suite.Require().Equal(written, suite.stateDB.GetState(tc.storage, slot0), tc.name)
+		suite.Require().Equal(succeeded, suite.stateDB.GetState(tc.contract, slot1), tc.name)
+	}
+}
@@ -38,6 +38,11 @@ type CommitStateDBParams struct {
 	AccountKeeper AccountKeeper
 	SupplyKeeper  SupplyKeeper
 	BankKeeper    bank.Keeper
+
+	NativeContracts        NativeContracts
+	NativeContractResolver NativeContractResolver
+
+	ArchiveTracker *ArchiveTracker
 }
 
 // CommitStateDB implements the Geth state.StateDB interface. Instead of using
@@ -94,6 +99,14 @@ type CommitStateDB struct {
 	// Per-transaction access list
 	accessList *accessList
 
+	// native contracts and the cache layers of the native module state changes made by them
+	nativeContracts        NativeContracts
+	nativeContractResolver NativeContractResolver
+	nativeLayers           []nativeLayer
+
+	// tracker of the accounts and storage changed in the block, which is nil if the archive index is disabled
+	archiveTracker *ArchiveTracker
+
 	// mutex for state deep copying
 	lock sync.Mutex
 
@@ -137,6 +150,10 @@ func CreateEmptyCommitStateDB(csdbParams CommitStateDBParams, ctx sdk.Context) *
 		supplyKeeper:  csdbParams.SupplyKeeper,
 		bankKeeper:    csdbParams.BankKeeper,
 
+		nativeContracts:        csdbParams.NativeContracts,
+		nativeContractResolver: csdbParams.NativeContractResolver,
+		archiveTracker:         csdbParams.ArchiveTracker,
+
 		stateObjects:         []stateEntry{},
 		addressToObjectIndex: make(map[ethcmn.Address]int),
 		stateObjectsDirty:    make(map[ethcmn.Address]struct{}),
@@ -221,6 +238,22 @@ func (csdb *CommitStateDB) SetCode(addr ethcmn.Address, code []byte) {
 	}
 }
 
+// SetStorage replaces the entire storage of an account with the given one.
+// NOTE: the storage in the KVStore is deleted immediately, so it must be only used on a
+// state which is never committed, e.g. the state overrides of eth_call.
+func (csdb *CommitStateDB) SetStorage(addr ethcmn.Address, storage map[ethcmn.Hash]ethcmn.Hash) {
+	csdb.GetOrNewStateObject(addr)
+	so := csdb.getStateObject(addr)
+	if so == nil {
+		return
+	}
+
+	so.clearStorage()
+	for key, value := range storage {
+		so.SetState(nil, key, value)
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Transaction logs
 // Required for upgrade logic or ease of querying.
@@ -323,7 +356,13 @@ func (csdb *CommitStateDB) SlotInAccessList(addr ethcmn.Address, slot ethcmn.Has
 
 // GetHeightHash returns the block header hash associated with a given block height and chain epoch number.
 func (csdb *CommitStateDB) GetHeightHash(height uint64) ethcmn.Hash {
-	store := prefix.NewStore(csdb.ctx.KVStore(csdb.storeKey), KeyPrefixHeightHash)
+	return csdb.getHeightHash(csdb.ctx, height)
+}
+
+// getHeightHash returns the block header hash associated with a given block height and chain epoch number
+// with the given context.
+func (csdb *CommitStateDB) getHeightHash(ctx sdk.Context, height uint64) ethcmn.Hash {
+	store := prefix.NewStore(ctx.KVStore(csdb.storeKey), KeyPrefixHeightHash)
 	key := HeightHashKey(height)
 	bz := store.Get(key)
 	if len(bz) == 0 {
@@ -598,6 +637,7 @@ func (csdb *CommitStateDB) updateStateObject(so *stateObject) error {
 	}
 
 	csdb.accountKeeper.SetAccount(csdb.ctx, so.account)
+	csdb.archiveTracker.TrackAccount(so.address)
 	// return csdb.bankKeeper.SetBalance(csdb.ctx, so.account.Address, newBalance)
 	return nil
 }
@@ -606,6 +646,7 @@ func (csdb *CommitStateDB) updateStateObject(so *stateObject) error {
 func (csdb *CommitStateDB) deleteStateObject(so *stateObject) {
 	so.deleted = true
 	csdb.accountKeeper.RemoveAccount(csdb.ctx, so.account)
+	csdb.archiveTracker.TrackAccount(so.address)
 }
 
 // ----------------------------------------------------------------------------
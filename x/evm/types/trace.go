@@ -0,0 +1,166 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+const (
+	// FlagDebugAPI enables the debug_ prefixed set of APIs and the trace query of evm module
+	FlagDebugAPI = "debug-api"
+
+	// DefaultTraceTimeout is the amount of time a javascript tracer can run for a single transaction
+	DefaultTraceTimeout = 5 * time.Second
+)
+
+// TraceConfig holds the options of tracing, which is compatible with the one of go-ethereum.
+// The struct logger is used if Tracer is empty, otherwise Tracer is the name of a built-in javascript
+// tracer (e.g. callTracer) or the code of a javascript tracer
+type TraceConfig struct {
+	DisableMemory     bool    `json:"disableMemory"`
+	DisableStack      bool    `json:"disableStack"`
+	DisableStorage    bool    `json:"disableStorage"`
+	DisableReturnData bool    `json:"disableReturnData"`
+	Limit             int     `json:"limit"`
+	Tracer            *string `json:"tracer"`
+	Timeout           *string `json:"timeout"`
+}
+
+// QueryTraceParams is the params of the trace query. Txs are the raw transactions of the block, which must be
+// queried at the height before BlockHeight. The ethereum transactions in Txs are re-executed in order and the ones
+// with index >= TraceFrom are traced. Call is traced on the state after Txs if it's not nil
+type QueryTraceParams struct {
+	BlockHeight     int64        `json:"block_height"`
+	BlockTime       time.Time    `json:"block_time"`
+	BlockHash       common.Hash  `json:"block_hash"`
+	ParentHash      common.Hash  `json:"parent_hash"`
+	ProposerAddress []byte       `json:"proposer_address"`
+	Txs             [][]byte     `json:"txs"`
+	TraceFrom       int          `json:"trace_from"`
+	Call            *CallArgs    `json:"call"`
+	Config          *TraceConfig `json:"config"`
+}
+
+// TxTraceResult is the result of tracing a transaction
+type TxTraceResult struct {
+	TxHash common.Hash     `json:"txHash"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// StructLogExecutionResult is the result of tracing with the struct logger
+type StructLogExecutionResult struct {
+	Gas         uint64         `json:"gas"`
+	Failed      bool           `json:"failed"`
+	ReturnValue string         `json:"returnValue"`
+	StructLogs  []StructLogRes `json:"structLogs"`
+}
+
+// StructLogRes stores a structured log emitted by the EVM while replaying a transaction in debug mode
+type StructLogRes struct {
+	Pc      uint64             `json:"pc"`
+	Op      string             `json:"op"`
+	Gas     uint64             `json:"gas"`
+	GasCost uint64             `json:"gasCost"`
+	Depth   int                `json:"depth"`
+	Error   string             `json:"error,omitempty"`
+	Stack   *[]string          `json:"stack,omitempty"`
+	Memory  *[]string          `json:"memory,omitempty"`
+	Storage *map[string]string `json:"storage,omitempty"`
+}
+
+// FormatStructLogs formats the logs of struct logger for json output
+func FormatStructLogs(logs []vm.StructLog) []StructLogRes {
+	formatted := make([]StructLogRes, len(logs))
+	for index, trace := range logs {
+		formatted[index] = StructLogRes{
+			Pc:      trace.Pc,
+			Op:      trace.Op.String(),
+			Gas:     trace.Gas,
+			GasCost: trace.GasCost,
+			Depth:   trace.Depth,
+		}
+		if trace.Err != nil {
+			formatted[index].Error = trace.Err.Error()
+		}
+		if trace.Stack != nil {
+			stack := make([]string, len(trace.Stack))
+			for i, stackValue := range trace.Stack {
+				stack[i] = fmt.Sprintf("%x", math.PaddedBigBytes(stackValue, 32))
+			}
+			formatted[index].Stack = &stack
+		}
+		if trace.Memory != nil {
+			memory := make([]string, 0, (len(trace.Memory)+31)/32)
+			for i := 0; i+32 <= len(trace.Memory); i += 32 {
+				memory = append(memory, fmt.Sprintf("%x", trace.Memory[i:i+32]))
+			}
+			formatted[index].Memory = &memory
+		}
+		if trace.Storage != nil {
+			storage := make(map[string]string)
+			for i, storageValue := range trace.Storage {
+				storage[fmt.Sprintf("%x", i)] = fmt.Sprintf("%x", storageValue)
+			}
+			formatted[index].Storage = &storage
+		}
+	}
+	return formatted
+}
+
+// NewTracer creates the tracer by config. The returned stop function must be called after tracing to release
+// the timer of javascript tracer
+func NewTracer(config *TraceConfig) (vm.Tracer, func(), error) {
+	if config == nil || config.Tracer == nil || *config.Tracer == "" {
+		logConfig := &vm.LogConfig{}
+		if config != nil {
+			logConfig.DisableMemory = config.DisableMemory
+			logConfig.DisableStack = config.DisableStack
+			logConfig.DisableStorage = config.DisableStorage
+			logConfig.DisableReturnData = config.DisableReturnData
+			logConfig.Limit = config.Limit
+		}
+		return vm.NewStructLogger(logConfig), func() {}, nil
+	}
+
+	timeout := DefaultTraceTimeout
+	if config.Timeout != nil {
+		var err error
+		if timeout, err = time.ParseDuration(*config.Timeout); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	tracer, err := tracers.New(*config.Tracer)
+	if err != nil {
+		return nil, nil, err
+	}
+	timer := time.AfterFunc(timeout, func() {
+		tracer.Stop(errors.New("execution timeout"))
+	})
+	return tracer, func() { timer.Stop() }, nil
+}
+
+// GetTraceResult returns the json result of tracer after the execution
+func GetTraceResult(tracer vm.Tracer, gasUsed uint64, failed bool) (json.RawMessage, error) {
+	switch tracer := tracer.(type) {
+	case *vm.StructLogger:
+		return json.Marshal(StructLogExecutionResult{
+			Gas:         gasUsed,
+			Failed:      failed,
+			ReturnValue: fmt.Sprintf("%x", tracer.Output()),
+			StructLogs:  FormatStructLogs(tracer.StructLogs()),
+		})
+	case *tracers.Tracer:
+		return tracer.GetResult()
+	default:
+		return nil, fmt.Errorf("unsupported tracer type %T", tracer)
+	}
+}
@@ -16,6 +16,8 @@ const (
 	ProposalTypeText  = types.ProposalTypeText
 	QueryParams       = types.QueryParams
 
+	ProposalTypeExecuteMsgs = types.ProposalTypeExecuteMsgs
+
 	StatusNil           = types.StatusNil
 	StatusDepositPeriod = types.StatusDepositPeriod
 	StatusVotingPeriod  = types.StatusVotingPeriod
@@ -52,6 +54,10 @@ var (
 	NewTallyResultFromMap      = types.NewTallyResultFromMap
 	EmptyTallyResult           = types.EmptyTallyResult
 	NewTextProposal            = types.NewTextProposal
+	NewExecuteMsgsProposal     = types.NewExecuteMsgsProposal
+	RegisterExecutableMsg      = types.RegisterExecutableMsg
+	IsExecutableMsg            = types.IsExecutableMsg
+	GovernanceAddress          = types.GovernanceAddress
 	RegisterProposalType       = types.RegisterProposalType
 	ContentFromProposalType    = types.ContentFromProposalType
 	IsValidProposalType        = types.IsValidProposalType
@@ -97,4 +103,6 @@ type (
 	Vote              = types.Vote
 	Votes             = types.Votes
 	Keeper            = keeper.Keeper
+
+	ExecuteMsgsProposal = types.ExecuteMsgsProposal
 )
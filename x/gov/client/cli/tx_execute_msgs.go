@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/okex/okexchain/x/gov/types"
+)
+
+// ExecuteMsgsProposalJSON defines an ExecuteMsgsProposal with a deposit
+type ExecuteMsgsProposalJSON struct {
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Msgs        []sdk.Msg    `json:"msgs" yaml:"msgs"`
+	Deposit     sdk.SysCoins `json:"deposit" yaml:"deposit"`
+}
+
+// ParseExecuteMsgsProposalJSON reads and parses an ExecuteMsgsProposalJSON from a file
+func ParseExecuteMsgsProposalJSON(cdc *codec.Codec, proposalFile string) (ExecuteMsgsProposalJSON, error) {
+	proposal := ExecuteMsgsProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// GetCmdSubmitExecuteMsgsProposal implements the command to submit an execute messages proposal
+func GetCmdSubmitExecuteMsgsProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute-msgs [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to execute messages signed by the governance module account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to execute messages signed by the governance module account %s along
+with an initial deposit. The messages are run in order once the proposal passes, and none of them takes effect if any
+of them fails. Only the message types declared executable by their modules are accepted.
+
+Example:
+$ %s tx gov submit-proposal execute-msgs <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Transfer the ownership of xxb",
+  "description": "Hand the token over to the new team",
+  "msgs": [
+    {
+      "type": "okexchain/token/MsgTransferOwnership",
+      "value": {
+        "from_address": "%s",
+        "to_address": "okexchain1hw4r48aww06ldrfeuq2v438ujnl6alsz0685a0",
+        "symbol": "xxb"
+      }
+    }
+  ],
+  "deposit": [
+    {
+      "denom": "%s",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				types.GovernanceAddress(), version.ClientName, types.GovernanceAddress(), sdk.DefaultBondDenom,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseExecuteMsgsProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewExecuteMsgsProposal(proposal.Title, proposal.Description, proposal.Msgs)
+			msg := types.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
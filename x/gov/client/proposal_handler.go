@@ -5,9 +5,14 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/okex/okexchain/x/gov/client/cli"
 	"github.com/okex/okexchain/x/gov/client/rest"
 )
 
+// ExecuteMsgsProposalHandler is the execute messages proposal handler
+var ExecuteMsgsProposalHandler = NewProposalHandler(cli.GetCmdSubmitExecuteMsgsProposal,
+	rest.ExecuteMsgsProposalRESTHandler)
+
 // function to create the rest handler
 type RESTHandlerFn func(context.CLIContext) rest.ProposalRESTHandler
 
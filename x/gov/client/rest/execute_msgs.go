@@ -0,0 +1,55 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/okex/okexchain/x/gov/types"
+)
+
+// ExecuteMsgsProposalReq defines an execute messages proposal request body
+type ExecuteMsgsProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Msgs        []sdk.Msg      `json:"msgs" yaml:"msgs"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.SysCoins   `json:"deposit" yaml:"deposit"`
+}
+
+// ExecuteMsgsProposalRESTHandler returns a ProposalRESTHandler that exposes the execute messages REST handler with a
+// given sub-route
+func ExecuteMsgsProposalRESTHandler(cliCtx context.CLIContext) ProposalRESTHandler {
+	return ProposalRESTHandler{
+		SubRoute: "execute_msgs",
+		Handler:  postExecuteMsgsProposalHandlerFn(cliCtx),
+	}
+}
+
+func postExecuteMsgsProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ExecuteMsgsProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewExecuteMsgsProposal(req.Title, req.Description, req.Msgs)
+		msg := types.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
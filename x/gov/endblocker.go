@@ -25,15 +25,35 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 
 // handle proposals which is executed on future block height appointed when it is submitted
 func handleWaitingProposals(ctx sdk.Context, k keeper.Keeper, logger log.Logger) {
-	k.IterateWaitingProposalsQueue(ctx, uint64(ctx.BlockHeight()), func(proposal Proposal) bool {
+	type waitingProposal struct {
+		proposal Proposal
+		height   uint64
+	}
+
+	curHeight := uint64(ctx.BlockHeight())
+	var waitingProposals []waitingProposal
+	k.IterateAllWaitingProposals(ctx, func(proposal Proposal, _, height uint64) bool {
+		if height > curHeight {
+			return true
+		}
+		waitingProposals = append(waitingProposals, waitingProposal{proposal, height})
+		return false
+	})
+
+	for _, wp := range waitingProposals {
+		proposal := wp.proposal
+		// the entry is consumed whatever the result is, a handler that needs to be retried inserts a new one
+		k.RemoveFromWaitingProposalQueue(ctx, wp.height, proposal.ProposalID)
+
 		handler := k.Router().GetRoute(proposal.ProposalRoute())
 		cacheCtx, writeCache := ctx.CacheContext()
 		err := handler(cacheCtx, &proposal)
 		if err != nil {
 			logger.Info(
-				fmt.Sprintf("proposal %d (%s) excute failed",
+				fmt.Sprintf("proposal %d (%s) excute failed: %s",
 					proposal.ProposalID,
 					proposal.GetTitle(),
+					err,
 				),
 			)
 		} else {
@@ -45,9 +65,7 @@ func handleWaitingProposals(ctx sdk.Context, k keeper.Keeper, logger log.Logger)
 			)
 			writeCache()
 		}
-
-		return false
-	})
+	}
 }
 
 func handleInActiveProposals(ctx sdk.Context, k keeper.Keeper, logger log.Logger) {
@@ -81,10 +99,25 @@ func handleActiveProposals(ctx sdk.Context, k keeper.Keeper, logger log.Logger)
 	k.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal Proposal) bool {
 
 		status, distribute, tallyResults := keeper.Tally(ctx, k, proposal, true)
+		// the expedited proposal failing its tally is voted in the normal voting period instead
+		if proposal.Expedited && status != StatusPassed {
+			proposal.FinalTallyResult = tallyResults
+			if k.FallbackExpeditedProposal(ctx, &proposal) {
+				logger.Info(
+					fmt.Sprintf("expedited proposal %d (%s) tallied; result: falls back to the normal voting period",
+						proposal.ProposalID, proposal.GetTitle(),
+					),
+				)
+				return false
+			}
+			status, distribute, tallyResults = keeper.Tally(ctx, k, proposal, true)
+		}
+
 		tagValue, logMsg := handleProposalAfterTally(ctx, k, &proposal, distribute, status)
 		proposal.FinalTallyResult = tallyResults
 		k.SetProposal(ctx, proposal)
 		k.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+		k.RecordProxyParticipation(ctx, proposal.ProposalID)
 		k.DeleteVotes(ctx, proposal.ProposalID)
 
 		logger.Info(
@@ -238,3 +238,92 @@ func TestEndBlockerIterateWaitingProposalsQueue(t *testing.T) {
 	require.False(t, waitingQueue.Valid())
 	waitingQueue.Close()
 }
+
+// expeditedTextProposal is a text proposal which can be submitted as an expedited one
+type expeditedTextProposal struct {
+	types.TextProposal
+	Expedited bool `json:"expedited"`
+}
+
+func (etp expeditedTextProposal) IsExpedited() bool { return etp.Expedited }
+
+func TestEndBlockerExpeditedProposalFallback(t *testing.T) {
+	ctx, _, gk, sk, _ := keeper.CreateTestInput(t, false, 100000)
+	gk.Cdc().RegisterConcrete(expeditedTextProposal{}, "test/gov/ExpeditedTextProposal", nil)
+	govHandler := NewHandler(gk)
+
+	ctx = ctx.WithBlockHeight(int64(sk.GetEpoch(ctx)))
+	skHandler := staking.NewHandler(sk)
+	valAddrs := make([]sdk.ValAddress, len(keeper.Addrs[:4]))
+	for i, addr := range keeper.Addrs[:4] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+	keeper.CreateValidators(t, skHandler, ctx, valAddrs, []int64{10, 10, 10, 10})
+	staking.EndBlocker(ctx, sk)
+
+	content := expeditedTextProposal{types.NewTextProposal("Test", "description").(types.TextProposal), true}
+	initialDeposit := sdk.SysCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 150)}
+	_, err := govHandler(ctx, NewMsgSubmitProposal(content, initialDeposit, keeper.Addrs[0]))
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "expedited proposals are not enabled")
+
+	depositParams := gk.GetDepositParams(ctx)
+	depositParams.ExpeditedMinDeposit = sdk.SysCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 500)}
+	gk.SetDepositParams(ctx, depositParams)
+	votingParams := gk.GetVotingParams(ctx)
+	votingParams.ExpeditedVotingPeriod = time.Hour * 24
+	gk.SetVotingParams(ctx, votingParams)
+	tallyParams := gk.GetTallyParams(ctx)
+	tallyParams.ExpeditedThreshold = sdk.NewDecWithPrec(667, 3)
+	gk.SetTallyParams(ctx, tallyParams)
+
+	// the expedited proposal requires the higher deposit
+	res, err := govHandler(ctx, NewMsgSubmitProposal(content, initialDeposit, keeper.Addrs[0]))
+	require.Nil(t, err)
+	var proposalID uint64
+	gk.Cdc().MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID)
+	proposal, ok := gk.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	require.True(t, proposal.Expedited)
+	require.Equal(t, StatusDepositPeriod, proposal.Status)
+
+	_, err = govHandler(ctx, NewMsgDeposit(keeper.Addrs[1], proposalID,
+		sdk.SysCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 350)}))
+	require.Nil(t, err)
+	proposal, _ = gk.GetProposal(ctx, proposalID)
+	require.Equal(t, StatusVotingPeriod, proposal.Status)
+	require.Equal(t, proposal.VotingStartTime.Add(votingParams.ExpeditedVotingPeriod), proposal.VotingEndTime)
+
+	// 2/3 of yes passes the normal threshold but not the expedited one
+	for i, option := range []types.VoteOption{types.OptionYes, types.OptionYes, types.OptionNo} {
+		_, err = govHandler(ctx, NewMsgVote(keeper.Addrs[i], proposalID, option))
+		require.Nil(t, err)
+	}
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader).WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, gk)
+
+	// the proposal falls back to the normal voting period with its deposits and votes
+	proposal, _ = gk.GetProposal(ctx, proposalID)
+	require.Equal(t, StatusVotingPeriod, proposal.Status)
+	require.False(t, proposal.Expedited)
+	require.Equal(t, proposal.VotingStartTime.Add(votingParams.VotingPeriod), proposal.VotingEndTime)
+	require.Len(t, gk.GetVotes(ctx, proposalID), 3)
+	require.Equal(t, depositParams.ExpeditedMinDeposit, gk.SupplyKeeper().
+		GetModuleAccount(ctx, types.ModuleName).GetCoins())
+	fallbacks := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeExpeditedProposalFallback {
+			fallbacks++
+		}
+	}
+	require.Equal(t, 1, fallbacks)
+
+	newHeader.Time = proposal.VotingEndTime
+	ctx = ctx.WithBlockHeader(newHeader)
+	EndBlocker(ctx, gk)
+	proposal, _ = gk.GetProposal(ctx, proposalID)
+	require.Equal(t, StatusPassed, proposal.Status)
+}
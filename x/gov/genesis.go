@@ -30,17 +30,20 @@ func DefaultGenesisState() GenesisState {
 		StartingProposalID: 1,
 		Proposals:          []types.Proposal{},
 		DepositParams: DepositParams{
-			MinDeposit:       minDeposit,
-			MaxDepositPeriod: time.Hour * 24,
+			MinDeposit:          minDeposit,
+			MaxDepositPeriod:    time.Hour * 24,
+			ExpeditedMinDeposit: minDeposit.MulDec(sdk.NewDec(5)),
 		},
 		VotingParams: VotingParams{
-			VotingPeriod: time.Hour * 72,
+			VotingPeriod:          time.Hour * 72,
+			ExpeditedVotingPeriod: time.Hour * 24,
 		},
 		TallyParams: TallyParams{
-			Quorum:          sdk.NewDecWithPrec(334, 3),
-			Threshold:       sdk.NewDecWithPrec(5, 1),
-			Veto:            sdk.NewDecWithPrec(334, 3),
-			YesInVotePeriod: sdk.NewDecWithPrec(667, 3),
+			Quorum:             sdk.NewDecWithPrec(334, 3),
+			Threshold:          sdk.NewDecWithPrec(5, 1),
+			Veto:               sdk.NewDecWithPrec(334, 3),
+			YesInVotePeriod:    sdk.NewDecWithPrec(667, 3),
+			ExpeditedThreshold: sdk.NewDecWithPrec(667, 3),
 		},
 	}
 }
@@ -89,6 +92,10 @@ func ValidateGenesis(data GenesisState) error {
 			data.DepositParams.MinDeposit.String())
 	}
 
+	if err := types.ValidateExpeditedParams(data.DepositParams, data.VotingParams, data.TallyParams); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -188,17 +188,20 @@ func TestGenesisState_Equal(t *testing.T) {
 		StartingProposalID: 1,
 		Proposals:          []types.Proposal{},
 		DepositParams: DepositParams{
-			MinDeposit:       minDeposit,
-			MaxDepositPeriod: time.Hour * 24,
+			MinDeposit:          minDeposit,
+			MaxDepositPeriod:    time.Hour * 24,
+			ExpeditedMinDeposit: sdk.SysCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(500))},
 		},
 		VotingParams: VotingParams{
-			VotingPeriod: time.Hour * 72,
+			VotingPeriod:          time.Hour * 72,
+			ExpeditedVotingPeriod: time.Hour * 24,
 		},
 		TallyParams: TallyParams{
-			Quorum:          sdk.NewDecWithPrec(334, 3),
-			Threshold:       sdk.NewDecWithPrec(5, 1),
-			Veto:            sdk.NewDecWithPrec(334, 3),
-			YesInVotePeriod: sdk.NewDecWithPrec(667, 3),
+			Quorum:             sdk.NewDecWithPrec(334, 3),
+			Threshold:          sdk.NewDecWithPrec(5, 1),
+			Veto:               sdk.NewDecWithPrec(334, 3),
+			YesInVotePeriod:    sdk.NewDecWithPrec(667, 3),
+			ExpeditedThreshold: sdk.NewDecWithPrec(667, 3),
 		},
 	}
 	require.True(t, expected.equal(DefaultGenesisState()))
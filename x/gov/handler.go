@@ -122,6 +122,7 @@ func handleMsgVote(ctx sdk.Context, k keeper.Keeper, msg MsgVote) (*sdk.Result,
 		handleProposalAfterTally(ctx, k, &proposal, distribute, status)
 		k.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
 		proposal.VotingEndTime = ctx.BlockHeader().Time
+		k.RecordProxyParticipation(ctx, proposal.ProposalID)
 		k.DeleteVotes(ctx, proposal.ProposalID)
 	}
 	k.SetProposal(ctx, proposal)
@@ -27,6 +27,11 @@ func tryEnterVotingPeriod(
 		phr := keeper.proposalHandlerRouter.GetRoute(proposal.ProposalRoute())
 		minDeposit = phr.GetMinDeposit(ctx, proposal.Content)
 	}
+	if proposal.Expedited {
+		if expeditedMinDeposit := keeper.GetDepositParams(ctx).ExpeditedMinDeposit; expeditedMinDeposit.IsAllGTE(minDeposit) {
+			minDeposit = expeditedMinDeposit
+		}
+	}
 
 	if proposal.Status == types.StatusDepositPeriod && proposal.TotalDeposit.IsAllGTE(minDeposit) {
 		keeper.activateVotingPeriod(ctx, proposal)
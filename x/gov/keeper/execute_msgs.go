@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/gov/types"
+)
+
+// ExecuteMsgs runs the messages of a passed execute messages proposal through the message router of the app in
+// order. It stops at the first failed message, so the caller is expected to run it on a cache context and only write
+// the cache back when no error is returned.
+//
+// The messages are allowed to spend the coins of the governance module account beyond the deposits it holds only.
+func (keeper Keeper) ExecuteMsgs(ctx sdk.Context, msgs []sdk.Msg) sdk.Error {
+	if keeper.msgRouter == nil {
+		return types.ErrExecuteMsgFailed(0, "message router has not been set")
+	}
+
+	govAddr := keeper.supplyKeeper.GetModuleAddress(types.ModuleName)
+	for i, msg := range msgs {
+		// the executable messages may be changed by an upgrade after the proposal was submitted
+		if !types.IsExecutableMsg(msg) {
+			return types.ErrMsgNotExecutable(i, msg)
+		}
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(govAddr) {
+			return types.ErrInvalidExecutableMsgSigner(i, govAddr.String())
+		}
+
+		handler := keeper.msgRouter.Route(ctx, msg.Route())
+		if handler == nil {
+			return types.ErrExecuteMsgFailed(i, fmt.Sprintf("unrecognized message route: %s", msg.Route()))
+		}
+
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return types.ErrExecuteMsgFailed(i, err.Error())
+		}
+		ctx.EventManager().EmitEvents(res.Events)
+	}
+
+	deposits := sdk.SysCoins{}
+	keeper.IterateAllDeposits(ctx, func(deposit types.Deposit) bool {
+		deposits = deposits.Add(deposit.Amount...)
+		return false
+	})
+	if balance := keeper.GetGovernanceAccount(ctx).GetCoins(); !balance.IsAllGTE(deposits) {
+		return types.ErrDepositsSpent(deposits.String(), balance.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/gov/types"
+)
+
+const testExecRoute = "testexec"
+
+// testSendMsg sends coins from the governance module account
+type testSendMsg struct {
+	To     sdk.AccAddress `json:"to"`
+	Amount sdk.SysCoins   `json:"amount"`
+}
+
+func (msg testSendMsg) Route() string                { return testExecRoute }
+func (msg testSendMsg) Type() string                 { return "send" }
+func (msg testSendMsg) ValidateBasic() error         { return nil }
+func (msg testSendMsg) GetSignBytes() []byte         { return nil }
+func (msg testSendMsg) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{types.GovernanceAddress()} }
+
+// testUndeclaredMsg is not declared executable by governance
+type testUndeclaredMsg struct {
+	testSendMsg
+}
+
+func (msg testUndeclaredMsg) Type() string { return "undeclared" }
+
+func init() {
+	types.RegisterExecutableMsg(testSendMsg{}, "test/gov/testSendMsg")
+}
+
+func newTestExecRouter(k Keeper) sdk.Router {
+	router := baseapp.NewRouter()
+	router.AddRoute(testExecRoute, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		sendMsg := msg.(testSendMsg)
+		if sendMsg.Amount.IsZero() {
+			return nil, errors.New("nothing to send")
+		}
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sendMsg.To,
+			sendMsg.Amount); err != nil {
+			return nil, err
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent("test_send"))
+		return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+	})
+	return router
+}
+
+func TestKeeper_ExecuteMsgs(t *testing.T) {
+	ctx, _, keeper, _, _ := CreateTestInput(t, false, 1000)
+	send := func(amount int64) testSendMsg {
+		return testSendMsg{Addrs[1], sdk.NewCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, amount))}
+	}
+
+	// no message router
+	require.NotNil(t, keeper.ExecuteMsgs(ctx, []sdk.Msg{send(1)}))
+	keeper.SetMsgRouter(newTestExecRouter(keeper))
+
+	// the governance module account holds a deposit of 100 and 50 more
+	proposal, err := keeper.SubmitProposal(ctx, types.NewTextProposal("Test", "description"))
+	require.Nil(t, err)
+	require.Nil(t, keeper.AddDeposit(ctx, proposal.ProposalID, Addrs[0],
+		sdk.NewCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 100)), ""))
+	require.Nil(t, keeper.supplyKeeper.SendCoinsFromAccountToModule(ctx, Addrs[0], types.ModuleName,
+		sdk.NewCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 50))))
+	balance := keeper.bankKeeper.GetCoins(ctx, Addrs[1])
+
+	// only the declared messages are executed
+	err = keeper.ExecuteMsgs(ctx, []sdk.Msg{testUndeclaredMsg{send(1)}})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "not declared executable")
+
+	// a failed message fails all of them, which are run on a cache context as the passed proposals are
+	cacheCtx, _ := ctx.CacheContext()
+	require.NotNil(t, keeper.ExecuteMsgs(cacheCtx, []sdk.Msg{send(1), send(0)}))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Nil(t, keeper.ExecuteMsgs(ctx, []sdk.Msg{send(20), send(10)}))
+	sent := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "test_send" {
+			sent++
+		}
+	}
+	require.Equal(t, 2, sent)
+	require.True(t, balance.Add(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 30)).IsEqual(
+		keeper.bankKeeper.GetCoins(ctx, Addrs[1])))
+
+	// the deposits can't be spent
+	err = keeper.ExecuteMsgs(ctx, []sdk.Msg{send(30)})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "deposits")
+}
+
+func TestExecuteMsgsProposal_ValidateBasic(t *testing.T) {
+	msg := testSendMsg{Addrs[0], sdk.NewCoins(sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 1))}
+	require.Nil(t, types.NewExecuteMsgsProposal("Test", "description", []sdk.Msg{msg}).ValidateBasic())
+
+	require.NotNil(t, types.NewExecuteMsgsProposal("Test", "description", nil).ValidateBasic())
+	require.NotNil(t, types.NewExecuteMsgsProposal("", "description", []sdk.Msg{msg}).ValidateBasic())
+	require.NotNil(t, types.NewExecuteMsgsProposal("Test", "description",
+		[]sdk.Msg{testUndeclaredMsg{msg}}).ValidateBasic())
+
+	msgs := make([]sdk.Msg, types.MaxExecutableMsgs+1)
+	for i := range msgs {
+		msgs[i] = msg
+	}
+	require.NotNil(t, types.NewExecuteMsgsProposal("Test", "description", msgs).ValidateBasic())
+}
@@ -26,6 +26,9 @@ type StakingKeeper interface {
 
 	// gov use it for getting votes of delegator which has been voted to validator
 	Delegator(ctx sdk.Context, delAddr sdk.AccAddress) stakingexported.DelegatorI
+
+	// gov use it for tracking the vote participation of proxies when a proposal ends
+	RecordProxyParticipation(ctx sdk.Context, voters []sdk.AccAddress)
 }
 
 // SupplyKeeper defines the supply Keeper for module accounts
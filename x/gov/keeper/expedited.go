@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/gov/types"
+)
+
+// IsExpeditedEnabled returns a boolean determining if the expedited proposals are enabled, which requires all of the
+// expedited deposit, voting and tally params to be set
+func (keeper Keeper) IsExpeditedEnabled(ctx sdk.Context) bool {
+	expeditedThreshold := keeper.GetTallyParams(ctx).ExpeditedThreshold
+	return !keeper.GetDepositParams(ctx).ExpeditedMinDeposit.Empty() &&
+		keeper.GetVotingParams(ctx).ExpeditedVotingPeriod > 0 &&
+		!expeditedThreshold.IsNil() && expeditedThreshold.IsPositive()
+}
+
+// FallbackExpeditedProposal turns an expedited proposal whose expedited tally failed into a normal one. The proposal
+// keeps its deposits and votes, and is tallied again at the end of the normal voting period since the voting start.
+// It returns false if the normal voting period is already over, when the proposal is expected to be tallied as a
+// normal one right away.
+func (keeper Keeper) FallbackExpeditedProposal(ctx sdk.Context, proposal *types.Proposal) bool {
+	var votingPeriod time.Duration
+	if !keeper.proposalHandlerRouter.HasRoute(proposal.ProposalRoute()) {
+		votingPeriod = keeper.GetVotingPeriod(ctx, proposal.Content)
+	} else {
+		phr := keeper.proposalHandlerRouter.GetRoute(proposal.ProposalRoute())
+		votingPeriod = phr.GetVotingPeriod(ctx, proposal.Content)
+	}
+
+	proposal.Expedited = false
+	votingEndTime := proposal.VotingStartTime.Add(votingPeriod)
+	if !votingEndTime.After(ctx.BlockHeader().Time) {
+		return false
+	}
+
+	keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+	proposal.VotingEndTime = votingEndTime
+	keeper.InsertActiveProposalQueue(ctx, proposal.ProposalID, proposal.VotingEndTime)
+	keeper.SetProposal(ctx, *proposal)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExpeditedProposalFallback,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalID)),
+			sdk.NewAttribute(types.AttributeKeyVotingEndTime, proposal.VotingEndTime.String()),
+		),
+	)
+	return true
+}
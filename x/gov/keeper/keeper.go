@@ -49,6 +49,9 @@ type Keeper struct {
 
 	// Proposal module parameter router
 	proposalHandlerRouter ProposalHandlerRouter
+
+	// Message router of the app to run the messages of the passed execute messages proposals
+	msgRouter sdk.Router
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -88,6 +91,11 @@ func NewKeeper(
 	return keeper
 }
 
+// SetMsgRouter sets the message router of the app which runs the messages of the passed execute messages proposals
+func (keeper *Keeper) SetMsgRouter(msgRouter sdk.Router) {
+	keeper.msgRouter = msgRouter
+}
+
 // BankKeeper returns bank keeper in gov keeper
 func (keeper Keeper) BankKeeper() BankKeeper {
 	return keeper.bankKeeper
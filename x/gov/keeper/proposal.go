@@ -13,6 +13,9 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content types.Content) (typ
 	if !keeper.router.HasRoute(content.ProposalRoute()) {
 		return types.Proposal{}, types.ErrNoProposalHandlerExists(content)
 	}
+	if types.IsExpeditedContent(content) && !keeper.IsExpeditedEnabled(ctx) {
+		return types.Proposal{}, types.ErrExpeditedProposalNotEnabled()
+	}
 
 	proposalID, err := keeper.GetProposalID(ctx)
 	if err != nil {
@@ -166,6 +169,11 @@ func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal *types.Propo
 		phr := keeper.proposalHandlerRouter.GetRoute(proposal.ProposalRoute())
 		votingPeriod = phr.GetVotingPeriod(ctx, proposal.Content)
 	}
+	if proposal.Expedited {
+		if expeditedVotingPeriod := keeper.GetVotingParams(ctx).ExpeditedVotingPeriod; expeditedVotingPeriod < votingPeriod {
+			votingPeriod = expeditedVotingPeriod
+		}
+	}
 	// calculate the end time of voting
 	proposal.VotingEndTime = proposal.VotingStartTime.Add(votingPeriod)
 	proposal.Status = types.StatusVotingPeriod
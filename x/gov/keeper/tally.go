@@ -54,10 +54,11 @@ func tallyDelegatorVotes(
 			for _, val := range delegation.GetShareAddedValidatorAddresses() {
 				valAddrStr := val.String()
 				if valInfo, ok := currValidators[valAddrStr]; ok {
-					valInfo.DelegatorDeductions = valInfo.DelegatorDeductions.Add(delegation.GetLastAddedShares())
+					// the delegator might allocate different shares to each validator
+					votedPower := delegation.GetSharesAddedTo(val)
+					valInfo.DelegatorDeductions = valInfo.DelegatorDeductions.Add(votedPower)
 					currValidators[valAddrStr] = valInfo
 
-					votedPower := delegation.GetLastAddedShares()
 					// calculate vote power of delegator for voterPowerRate
 					if voteP != nil && vote.Voter.Equals(voteP.Voter) {
 						voterPower.Add(votedPower)
@@ -166,9 +167,13 @@ func tallyStatusInVotePeriod(
 
 // tally and return status expire voting period end time
 func tallyStatusExpireVotePeriod(
-	ctx sdk.Context, keeper Keeper, tallyResults types.TallyResult,
+	ctx sdk.Context, keeper Keeper, tallyResults types.TallyResult, expedited bool,
 ) (types.ProposalStatus, bool) {
 	tallyParams := keeper.GetTallyParams(ctx)
+	threshold := tallyParams.Threshold
+	if expedited {
+		threshold = tallyParams.ExpeditedThreshold
+	}
 	totalVoted := tallyResults.TotalVotedPower
 	totalPower := tallyResults.TotalPower
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudo code.
@@ -189,8 +194,8 @@ func tallyStatusExpireVotePeriod(
 	if tallyResults.NoWithVeto.Quo(totalVoted).GT(tallyParams.Veto) {
 		return types.StatusRejected, true
 	}
-	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	if tallyResults.Yes.Quo(totalVoted.Sub(tallyResults.Abstain)).GT(tallyParams.Threshold) {
+	// If more than 1/2 (or the expedited threshold) of non-abstaining voters vote Yes, proposal passes
+	if tallyResults.Yes.Quo(totalVoted.Sub(tallyResults.Abstain)).GT(threshold) {
 		return types.StatusPassed, false
 	}
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
@@ -207,7 +212,7 @@ func Tally(ctx sdk.Context, keeper Keeper, proposal types.Proposal, isExpireVote
 	tallyResults.TotalVotedPower = totalVotedPower
 
 	if isExpireVoteEndTime {
-		status, distribute := tallyStatusExpireVotePeriod(ctx, keeper, tallyResults)
+		status, distribute := tallyStatusExpireVotePeriod(ctx, keeper, tallyResults, proposal.Expedited)
 		return status, distribute, tallyResults
 	}
 	status, distribute := tallyStatusInVotePeriod(ctx, keeper, tallyResults)
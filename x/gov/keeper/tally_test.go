@@ -313,3 +313,50 @@ func TestTallyDelegatorOverride(t *testing.T) {
 	require.Equal(t, types.StatusPassed, status)
 	require.Equal(t, expectedTallyResult, tallyResults)
 }
+
+func TestTallyDelegatorWeighted(t *testing.T) {
+	ctx, _, keeper, sk, _ := CreateTestInput(t, false, 100000)
+	ctx = ctx.WithBlockHeight(int64(sk.GetEpoch(ctx)))
+	ctx = ctx.WithBlockTime(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	stakingHandler := staking.NewHandler(sk)
+	valAddrs := make([]sdk.ValAddress, len(Addrs[:3]))
+	for i, addr := range Addrs[:3] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+	CreateValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 5, 5})
+	staking.EndBlocker(ctx, sk)
+
+	coin, err := sdk.ParseDecCoin("10.0" + common.NativeToken)
+	require.Nil(t, err)
+	_, err = stakingHandler(ctx, staking.NewMsgDeposit(Addrs[3], coin))
+	require.Nil(t, err)
+
+	// 70% of the shares to the second validator and 30% to the third one
+	addSharesMsg := staking.NewMsgAddSharesWeighted(Addrs[3], valAddrs[1:],
+		[]sdk.Dec{sdk.NewDecWithPrec(7, 1), sdk.NewDecWithPrec(3, 1)})
+	_, err = stakingHandler(ctx, addSharesMsg)
+	require.Nil(t, err)
+
+	content := types.NewTextProposal("Test", "description")
+	proposal, err := keeper.SubmitProposal(ctx, content)
+	require.Nil(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+	proposalID := proposal.ProposalID
+
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[0], types.OptionNo)
+	require.Nil(t, err)
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[1], types.OptionYes)
+	require.Nil(t, err)
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[2], types.OptionYes)
+	require.Nil(t, err)
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[3], types.OptionNo)
+	require.Nil(t, err)
+
+	// the delegator overrides 7 shares of the second validator and 3 shares of the third one
+	expectedTallyResult := newTallyResult(t, "13", "2", "0.0", "11", "0.0", "13")
+	status, dist, tallyResults := Tally(ctx, keeper, proposal, true)
+	require.False(t, dist)
+	require.Equal(t, types.StatusRejected, status)
+	require.Equal(t, expectedTallyResult, tallyResults)
+}
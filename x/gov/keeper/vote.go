@@ -67,6 +67,17 @@ func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sd
 	store.Delete(types.VoteKey(proposalID, voterAddr))
 }
 
+// RecordProxyParticipation reports the voters of an ended proposal to the staking keeper, which tracks the vote
+// participation of the proxies. It must be called before the votes of the proposal are deleted
+func (keeper Keeper) RecordProxyParticipation(ctx sdk.Context, proposalID uint64) {
+	votes := keeper.GetVotes(ctx, proposalID)
+	voters := make([]sdk.AccAddress, len(votes))
+	for i, vote := range votes {
+		voters[i] = vote.Voter
+	}
+	keeper.sk.RecordProxyParticipation(ctx, voters)
+}
+
 // GetAllVotes returns all the votes from the store
 func (keeper Keeper) GetAllVotes(ctx sdk.Context) (votes types.Votes) {
 	keeper.IterateAllVotes(ctx, func(vote types.Vote) bool {
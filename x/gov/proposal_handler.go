@@ -0,0 +1,21 @@
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/gov/keeper"
+	"github.com/okex/okexchain/x/gov/types"
+)
+
+// NewProposalHandler creates the handler of the governance module-based proposals. The messages of the passed execute
+// messages proposals are run by the keeper, and the rest are handled by ProposalHandler.
+func NewProposalHandler(k *keeper.Keeper) Handler {
+	return func(ctx sdk.Context, proposal *Proposal) sdk.Error {
+		switch content := proposal.Content.(type) {
+		case types.ExecuteMsgsProposal:
+			return k.ExecuteMsgs(ctx, content.Msgs)
+		default:
+			return ProposalHandler(ctx, proposal)
+		}
+	}
+}
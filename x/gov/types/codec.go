@@ -2,6 +2,7 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // module codec
@@ -18,6 +19,7 @@ func RegisterCodec(cdc *codec.Codec) {
 
 	cdc.RegisterConcrete(TextProposal{}, "okexchain/gov/TextProposal", nil)
 	cdc.RegisterConcrete(SoftwareUpgradeProposal{}, "okexchain/gov/SoftwareUpgradeProposal", nil)
+	cdc.RegisterConcrete(ExecuteMsgsProposal{}, "okexchain/gov/ExecuteMsgsProposal", nil)
 }
 
 // RegisterProposalTypeCodec registers an external proposal content type defined
@@ -30,4 +32,6 @@ func RegisterProposalTypeCodec(o interface{}, name string) {
 // TODO determine a good place to seal this codec
 func init() {
 	RegisterCodec(ModuleCdc)
+	// the executable messages are registered by their modules through RegisterExecutableMsg
+	ModuleCdc.RegisterInterface((*sdk.Msg)(nil), nil)
 }
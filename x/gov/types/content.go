@@ -25,6 +25,20 @@ type Content interface {
 	String() string
 }
 
+// ExpeditableContent defines the Content which can be submitted as an expedited proposal. An expedited proposal
+// requires a higher deposit, and is voted in a shorter voting period with a higher threshold. It falls back to the
+// normal voting period if the expedited tally fails.
+type ExpeditableContent interface {
+	Content
+	IsExpedited() bool
+}
+
+// IsExpeditedContent returns a boolean determining if the content is submitted as an expedited proposal
+func IsExpeditedContent(c Content) bool {
+	ec, ok := c.(ExpeditableContent)
+	return ok && ec.IsExpedited()
+}
+
 // Handler defines a function that handles a proposal after it has passed the
 // governance process.
 type Handler func(ctx sdk.Context, proposal *Proposal) sdk.Error
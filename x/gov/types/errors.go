@@ -24,6 +24,11 @@ const (
 	CodeInvalidHeight            uint32 = BaseGovError + 10
 	CodeInvalidCoins             uint32 = BaseGovError + 11
 	CodeUnknownParamType         uint32 = BaseGovError + 12
+	CodeMsgNotExecutable         uint32 = BaseGovError + 13
+	CodeInvalidExecutableSigner  uint32 = BaseGovError + 14
+	CodeExecuteMsgFailed         uint32 = BaseGovError + 15
+	CodeDepositsSpent            uint32 = BaseGovError + 16
+	CodeExpeditedNotEnabled      uint32 = BaseGovError + 17
 )
 
 func ErrInvalidAddress(address string) sdk.Error {
@@ -80,3 +85,28 @@ func ErrInvalidCoins() sdk.Error {
 func ErrUnknownGovParamType() sdk.Error {
 	return sdkerrors.New(DefaultCodespace, CodeUnknownParamType, "unkonwn gov param type")
 }
+
+func ErrMsgNotExecutable(index int, msg interface{}) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeMsgNotExecutable,
+		fmt.Sprintf("message #%d '%T' is not declared executable by governance", index, msg))
+}
+
+func ErrInvalidExecutableMsgSigner(index int, govAddr string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeInvalidExecutableSigner,
+		fmt.Sprintf("message #%d must be signed by the governance module account %s only", index, govAddr))
+}
+
+func ErrExecuteMsgFailed(index int, msg string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeExecuteMsgFailed, fmt.Sprintf("message #%d failed: %s", index, msg))
+}
+
+func ErrDepositsSpent(deposits, balance string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeDepositsSpent,
+		fmt.Sprintf("the deposits %s held by the governance module account must not be spent, the balance is %s",
+			deposits, balance))
+}
+
+func ErrExpeditedProposalNotEnabled() sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeExpeditedNotEnabled,
+		"expedited proposals are not enabled without the expedited deposit, voting and tally params")
+}
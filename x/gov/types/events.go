@@ -19,4 +19,7 @@ const (
 	AttributeValueProposalPassed   = "proposal_passed"   // met vote quorum
 	AttributeValueProposalRejected = "proposal_rejected" // didn't meet vote quorum
 	AttributeValueProposalFailed   = "proposal_failed"   // error on proposal handler
+
+	EventTypeExpeditedProposalFallback = "expedited_proposal_fallback"
+	AttributeKeyVotingEndTime          = "voting_end_time"
 )
@@ -0,0 +1,114 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
+// nolint
+const (
+	ProposalTypeExecuteMsgs string = "ExecuteMsgs"
+
+	// MaxExecutableMsgs is the maximum amount of messages carried by an execute messages proposal
+	MaxExecutableMsgs = 16
+)
+
+var executableMsgs = map[string]struct{}{}
+
+// RegisterExecutableMsg declares a message type that the passed execute messages proposals are allowed to run, and
+// registers it for the internal ModuleCdc. It will panic if the message type is already registered.
+//
+// NOTE: Modules declare their executable message types in the init functions of their types packages.
+func RegisterExecutableMsg(msg sdk.Msg, name string) {
+	key := executableMsgKey(msg)
+	if _, ok := executableMsgs[key]; ok {
+		panic(fmt.Sprintf("already registered executable message: %s", key))
+	}
+
+	executableMsgs[key] = struct{}{}
+	ModuleCdc.RegisterConcrete(msg, name, nil)
+}
+
+// IsExecutableMsg returns a boolean determining if the message type is declared executable by the governance
+func IsExecutableMsg(msg sdk.Msg) bool {
+	_, ok := executableMsgs[executableMsgKey(msg)]
+	return ok
+}
+
+func executableMsgKey(msg sdk.Msg) string {
+	return fmt.Sprintf("%s/%s", msg.Route(), msg.Type())
+}
+
+// GovernanceAddress returns the address of the governance module account, which signs the messages executed by the
+// governance
+func GovernanceAddress() sdk.AccAddress {
+	return supply.NewModuleAddress(ModuleName)
+}
+
+// ExecuteMsgsProposal carries the messages signed by the governance module account which are run atomically once the
+// proposal passes
+type ExecuteMsgsProposal struct {
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	Msgs        []sdk.Msg `json:"msgs" yaml:"msgs"`
+}
+
+// NewExecuteMsgsProposal creates a new execute messages proposal
+func NewExecuteMsgsProposal(title, description string, msgs []sdk.Msg) ExecuteMsgsProposal {
+	return ExecuteMsgsProposal{title, description, msgs}
+}
+
+// Implements Proposal Interface
+var _ Content = ExecuteMsgsProposal{}
+
+// nolint
+func (emp ExecuteMsgsProposal) GetTitle() string       { return emp.Title }
+func (emp ExecuteMsgsProposal) GetDescription() string { return emp.Description }
+func (emp ExecuteMsgsProposal) ProposalRoute() string  { return RouterKey }
+func (emp ExecuteMsgsProposal) ProposalType() string   { return ProposalTypeExecuteMsgs }
+
+// ValidateBasic validates the abstract of the proposal and all of its messages
+func (emp ExecuteMsgsProposal) ValidateBasic() sdk.Error {
+	if err := ValidateAbstract(DefaultCodespace, emp); err != nil {
+		return err
+	}
+
+	if len(emp.Msgs) == 0 {
+		return ErrInvalidProposalContent("messages are required")
+	}
+	if len(emp.Msgs) > MaxExecutableMsgs {
+		return ErrInvalidProposalContent(fmt.Sprintf("messages are more than %d", MaxExecutableMsgs))
+	}
+
+	govAddr := GovernanceAddress()
+	for i, msg := range emp.Msgs {
+		if msg == nil || !IsExecutableMsg(msg) {
+			return ErrMsgNotExecutable(i, msg)
+		}
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(govAddr) {
+			return ErrInvalidExecutableMsgSigner(i, govAddr.String())
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return ErrInvalidProposalContent(fmt.Sprintf("message #%d: %s", i, err.Error()))
+		}
+	}
+
+	return nil
+}
+
+func (emp ExecuteMsgsProposal) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(`Execute Messages Proposal:
+  Title:       %s
+  Description: %s
+  Messages:`, emp.Title, emp.Description))
+	for i, msg := range emp.Msgs {
+		sb.WriteString(fmt.Sprintf("\n    #%d %s/%s", i, msg.Route(), msg.Type()))
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
@@ -30,6 +30,8 @@ func ParamKeyTable() subspace.KeyTable {
 type DepositParams struct {
 	MinDeposit       sdk.SysCoins  `json:"min_deposit,omitempty" yaml:"min_deposit,omitempty"`               //  Minimum deposit for a proposal to enter voting period.
 	MaxDepositPeriod time.Duration `json:"max_deposit_period,omitempty" yaml:"max_deposit_period,omitempty"` //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+	// Minimum deposit for an expedited proposal to enter voting period, which is no less than the normal one
+	ExpeditedMinDeposit sdk.SysCoins `json:"expedited_min_deposit,omitempty" yaml:"expedited_min_deposit,omitempty"`
 }
 
 // NewDepositParams creates a new DepositParams object
@@ -42,13 +44,15 @@ func NewDepositParams(minDeposit sdk.SysCoins, maxDepositPeriod time.Duration) D
 
 func (dp DepositParams) String() string {
 	return fmt.Sprintf(`Deposit Params:
-  Min Deposit:        %s
-  Max Deposit Period: %s`, dp.MinDeposit, dp.MaxDepositPeriod)
+  Min Deposit:           %s
+  Max Deposit Period:    %s
+  Expedited Min Deposit: %s`, dp.MinDeposit, dp.MaxDepositPeriod, dp.ExpeditedMinDeposit)
 }
 
 // Checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return dp.MinDeposit.IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		dp.ExpeditedMinDeposit.IsEqual(dp2.ExpeditedMinDeposit)
 }
 
 func validateDepositParams(i interface{}) error {
@@ -64,6 +68,20 @@ func validateDepositParams(i interface{}) error {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
 
+	return v.validateExpedited()
+}
+
+func (dp DepositParams) validateExpedited() error {
+	if dp.ExpeditedMinDeposit.Empty() {
+		return nil
+	}
+	if !dp.ExpeditedMinDeposit.IsValid() {
+		return fmt.Errorf("invalid expedited minimum deposit: %s", dp.ExpeditedMinDeposit)
+	}
+	if !dp.ExpeditedMinDeposit.IsAllGTE(dp.MinDeposit) {
+		return fmt.Errorf("expedited minimum deposit must not be less than minimum deposit: %s",
+			dp.ExpeditedMinDeposit)
+	}
 	return nil
 }
 
@@ -73,6 +91,8 @@ type TallyParams struct {
 	Threshold       sdk.Dec `json:"threshold,omitempty" yaml:"threshold,omitempty"`                   //  Minimum proportion of Yes votes for proposal to pass. Initial value: 0.5
 	Veto            sdk.Dec `json:"veto,omitempty" yaml:"veto,omitempty"`                             //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
 	YesInVotePeriod sdk.Dec `json:"yes_in_vote_period,omitempty" yaml:"yes_in_vote_period,omitempty"` //
+	// Minimum proportion of Yes votes for an expedited proposal to pass, which is higher than the normal threshold
+	ExpeditedThreshold sdk.Dec `json:"expedited_threshold,omitempty" yaml:"expedited_threshold,omitempty"`
 }
 
 // NewTallyParams creates a new TallyParams object
@@ -86,10 +106,11 @@ func NewTallyParams(quorum, threshold, veto sdk.Dec) TallyParams {
 
 func (tp TallyParams) String() string {
 	return fmt.Sprintf(`Tally Params:
-  Quorum:             %s
-  Threshold:          %s
-  Veto:               %s`,
-		tp.Quorum, tp.Threshold, tp.Veto)
+  Quorum:              %s
+  Threshold:           %s
+  Veto:                %s
+  Expedited Threshold: %s`,
+		tp.Quorum, tp.Threshold, tp.Veto, tp.ExpeditedThreshold)
 }
 
 func validateTallyParams(i interface{}) error {
@@ -117,12 +138,27 @@ func validateTallyParams(i interface{}) error {
 		return fmt.Errorf("veto threshold too large: %s", v)
 	}
 
+	return v.validateExpedited()
+}
+
+func (tp TallyParams) validateExpedited() error {
+	if tp.ExpeditedThreshold.IsNil() || tp.ExpeditedThreshold.IsZero() {
+		return nil
+	}
+	if tp.ExpeditedThreshold.LTE(tp.Threshold) {
+		return fmt.Errorf("expedited vote threshold must be higher than vote threshold: %s", tp)
+	}
+	if tp.ExpeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", tp)
+	}
 	return nil
 }
 
 // Param around Voting in governance
 type VotingParams struct {
 	VotingPeriod time.Duration `json:"voting_period,omitempty" yaml:"voting_period,omitempty"` //  Length of the voting period.
+	// Length of the voting period of an expedited proposal, which is shorter than the normal one
+	ExpeditedVotingPeriod time.Duration `json:"expedited_voting_period,omitempty" yaml:"expedited_voting_period,omitempty"`
 }
 
 // NewVotingParams creates a new VotingParams object
@@ -134,7 +170,8 @@ func NewVotingParams(votingPeriod time.Duration) VotingParams {
 
 func (vp VotingParams) String() string {
 	return fmt.Sprintf(`Voting Params:
-  Voting Period:      %s`, vp.VotingPeriod)
+  Voting Period:           %s
+  Expedited Voting Period: %s`, vp.VotingPeriod, vp.ExpeditedVotingPeriod)
 }
 
 func validateVotingParams(i interface{}) error {
@@ -147,9 +184,28 @@ func validateVotingParams(i interface{}) error {
 		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
 	}
 
+	return v.validateExpedited()
+}
+
+func (vp VotingParams) validateExpedited() error {
+	if vp.ExpeditedVotingPeriod < 0 || (vp.ExpeditedVotingPeriod > 0 && vp.ExpeditedVotingPeriod >= vp.VotingPeriod) {
+		return fmt.Errorf("expedited voting period must be shorter than voting period: %s", vp.ExpeditedVotingPeriod)
+	}
 	return nil
 }
 
+// ValidateExpeditedParams validates the expedited deposit, voting and tally params. The expedited proposals are
+// disabled with the zero values.
+func ValidateExpeditedParams(dp DepositParams, vp VotingParams, tp TallyParams) error {
+	if err := dp.validateExpedited(); err != nil {
+		return err
+	}
+	if err := vp.validateExpedited(); err != nil {
+		return err
+	}
+	return tp.validateExpedited()
+}
+
 // Params returns all of the governance params
 type Params struct {
 	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
@@ -24,6 +24,8 @@ type Proposal struct {
 
 	VotingStartTime time.Time `json:"voting_start_time" yaml:"voting_start_time"` // Time of the block where MinDeposit was reached. -1 if MinDeposit is not reached
 	VotingEndTime   time.Time `json:"voting_end_time" yaml:"voting_end_time"`     // Time that the VotingPeriod for this proposal will end and votes will be tallied
+
+	Expedited bool `json:"expedited" yaml:"expedited"` // Whether the proposal is voted in the expedited voting period with the expedited threshold
 }
 
 func NewProposal(ctx sdk.Context, totalVoting sdk.Dec, content Content, id uint64, submitTime, depositEndTime time.Time) Proposal {
@@ -35,6 +37,7 @@ func NewProposal(ctx sdk.Context, totalVoting sdk.Dec, content Content, id uint6
 		TotalDeposit:     sdk.SysCoins{},
 		SubmitTime:       submitTime,
 		DepositEndTime:   depositEndTime,
+		Expedited:        IsExpeditedContent(content),
 	}
 }
 
@@ -49,10 +52,11 @@ func (p Proposal) String() string {
   Total Deposit:      %s
   Voting Start Time:  %s
   Voting End Time:    %s
+  Expedited:          %t
   Description:        %s`,
 		p.ProposalID, p.GetTitle(), p.ProposalType(),
 		p.Status, p.SubmitTime, p.DepositEndTime,
-		p.TotalDeposit, p.VotingStartTime, p.VotingEndTime, p.GetDescription(),
+		p.TotalDeposit, p.VotingStartTime, p.VotingEndTime, p.Expedited, p.GetDescription(),
 	)
 }
 
@@ -354,6 +358,7 @@ func (sup SoftwareUpgradeProposal) String() string {
 var validProposalTypes = map[string]struct{}{
 	ProposalTypeText:            {},
 	ProposalTypeSoftwareUpgrade: {},
+	ProposalTypeExecuteMsgs:     {},
 }
 
 // RegisterProposalType registers a proposal type. It will panic if the type is
@@ -30,6 +30,10 @@ type (
 	ParamChange = sdkparams.ParamChange
 	// ParameterChangeProposal is alias of ParameterChangeProposal in types
 	ParameterChangeProposal = types.ParameterChangeProposal
+	// RevertParamChangeProposal is alias of RevertParamChangeProposal in types
+	RevertParamChangeProposal = types.RevertParamChangeProposal
+	// ParamChangeRecord is alias of ParamChangeRecord in types
+	ParamChangeRecord = types.ParamChangeRecord
 )
 
 var (
@@ -38,4 +42,7 @@ var (
 	NewParamChange  = sdkparams.NewParamChange
 	DefaultParams   = types.DefaultParams
 	NewParamSetPair = sdkparams.NewParamSetPair
+
+	NewParameterChangeProposal   = types.NewParameterChangeProposal
+	NewRevertParamChangeProposal = types.NewRevertParamChangeProposal
 )
@@ -3,8 +3,10 @@ package cli
 import (
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"strconv"
 	"strings"
 
+	paramscutils "github.com/okex/okexchain/x/params/client/utils"
 	"github.com/okex/okexchain/x/params/types"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -21,6 +23,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	queryCmd.AddCommand(flags.GetCommands(
 		GetCmdQueryParams(queryRoute, cdc),
+		GetCmdCheckParamChanges(queryRoute, cdc),
+		GetCmdQueryParamChangeRecord(queryRoute, cdc),
 	)...)
 
 	return queryCmd
@@ -51,3 +55,80 @@ $ okexchaincli query params params
 		},
 	}
 }
+
+// GetCmdCheckParamChanges implements the command to check the changes of a parameter change proposal against the
+// current state before submitting it
+func GetCmdCheckParamChanges(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "check-param-changes [proposal-file]",
+		Short: "Dry run the changes of a parameter change proposal against the current state",
+		Long: strings.TrimSpace(`Dry run the changes of a parameter change proposal against the current state. The
+proposal file is the one submitted by the param-change proposal command. None of the changes takes effect if any of
+them fails once the proposal passes.
+
+$ okexchaincli query params check-param-changes <path/to/proposal.json>
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := paramscutils.ParseParamChangeProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryCheckParamChangesParams(proposal.Changes.ToParamChanges()))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCheckParamChanges)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var checks types.ParamChangeChecks
+			cdc.MustUnmarshalJSON(res, &checks)
+			return cliCtx.PrintOutput(checks)
+		},
+	}
+}
+
+// GetCmdQueryParamChangeRecord implements the command to query the previous values of the parameters changed by a
+// proposal
+func GetCmdQueryParamChangeRecord(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "param-change-record [proposal-id]",
+		Short: "Query the previous values of the parameters changed by a proposal",
+		Long: strings.TrimSpace(`Query the previous values of the parameters changed by a proposal, which are restored
+by a revert-param-change proposal:
+
+$ okexchaincli query params param-change-record 1
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryParamChangeRecordParams(proposalID))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParamChangeRecord)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var record types.ParamChangeRecord
+			cdc.MustUnmarshalJSON(res, &record)
+			return cliCtx.PrintOutput(record)
+		},
+	}
+}
@@ -29,13 +29,15 @@ func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
 The proposal details must be supplied via a JSON file. For values that contains
 objects, only non-empty fields will be updated.
 
-IMPORTANT: Currently parameter changes are evaluated but not validated, so it is
-very important that any "value" change is valid (ie. correct type and within bounds)
-for its respective parameter, eg. "MaxValidators" should be an integer and not a decimal.
+All the changes are validated against the current state before any of them is
+written, so none of them takes effect if any "value" is invalid (ie. wrong type or
+out of bounds) for its respective parameter. The changes can be checked before the
+submission by:
 
-Proper vetting of a parameter change proposal should prevent this from happening
-(no deposits should occur during the governance process), but it should be noted
-regardless.
+$ %s query params check-param-changes <path/to/proposal.json>
+
+The previous values of the changed parameters are recorded, and can be restored by
+a revert-param-change proposal.
 
 Example:
 $ %s tx gov submit-proposal param-change <path/to/proposal.json> --from=<key_or_address>
@@ -61,7 +63,7 @@ Where proposal.json contains:
   "height": "1000"
 }
 `,
-				version.ClientName, sdk.DefaultBondDenom,
+				version.ClientName, version.ClientName, sdk.DefaultBondDenom,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -93,3 +95,60 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+// GetCmdSubmitRevertProposal implements a command handler for submitting a revert parameter change proposal
+// transaction
+func GetCmdSubmitRevertProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revert-param-change [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal restoring the parameters changed by a passed parameter change proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal restoring the parameters changed by a passed parameter change proposal
+to their previous values along with an initial deposit. The proposal is rejected if any of the parameters has been
+changed since the proposal to revert. The previous values can be queried by:
+
+$ %s query params param-change-record <proposal-id>
+
+Example:
+$ %s tx gov submit-proposal revert-param-change <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Revert Staking Param Change",
+  "description": "Restore max validators",
+  "proposal_id": "1",
+  "deposit": [
+    {
+      "denom": "%s",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName, version.ClientName, sdk.DefaultBondDenom,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := paramscutils.ParseRevertParamChangeProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRevertParamChangeProposal(proposal.Title, proposal.Description, proposal.ProposalID)
+			msg := govTypes.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -8,3 +8,7 @@ import (
 
 // ProposalHandler is the param change proposal handler in cmsdk
 var ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
+
+// RevertProposalHandler is the revert param change proposal handler
+var RevertProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitRevertProposal,
+	rest.RevertProposalRESTHandler)
@@ -11,6 +11,7 @@ import (
 	"github.com/okex/okexchain/x/gov"
 	govrest "github.com/okex/okexchain/x/gov/client/rest"
 	paramscutils "github.com/okex/okexchain/x/params/client/utils"
+	"github.com/okex/okexchain/x/params/types"
 )
 
 // ProposalRESTHandler returns a ProposalRESTHandler that exposes the param change REST handler with a given sub-route
@@ -44,3 +45,35 @@ func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+// RevertProposalRESTHandler returns a ProposalRESTHandler that exposes the revert param change REST handler with a
+// given sub-route
+func RevertProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "revert_param_change",
+		Handler:  postRevertProposalHandlerFn(cliCtx),
+	}
+}
+
+func postRevertProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req paramscutils.RevertParamChangeProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewRevertParamChangeProposal(req.Title, req.Description, req.ProposalID)
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -43,6 +43,25 @@ type (
 		Deposit     sdk.SysCoins     `json:"deposit" yaml:"deposit"`
 		Height      uint64           `json:"height" yaml:"height"`
 	}
+
+	// RevertParamChangeProposalJSON defines a RevertParamChangeProposal with a deposit used to parse revert parameter
+	// change proposals from a JSON file
+	RevertParamChangeProposalJSON struct {
+		Title       string       `json:"title" yaml:"title"`
+		Description string       `json:"description" yaml:"description"`
+		ProposalID  uint64       `json:"proposal_id" yaml:"proposal_id"`
+		Deposit     sdk.SysCoins `json:"deposit" yaml:"deposit"`
+	}
+
+	// RevertParamChangeProposalReq defines a revert parameter change proposal request body
+	RevertParamChangeProposalReq struct {
+		BaseReq     rest.BaseReq   `json:"base_req" yaml:"base_req"`
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		ProposalID  uint64         `json:"proposal_id" yaml:"proposal_id"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.SysCoins   `json:"deposit" yaml:"deposit"`
+	}
 )
 
 // ToParamChange converts a ParamChangeJSON object to ParamChange
@@ -74,3 +93,19 @@ func ParseParamChangeProposalJSON(cdc *codec.Codec, proposalFile string) (ParamC
 
 	return proposal, nil
 }
+
+// ParseRevertParamChangeProposalJSON reads and parses a RevertParamChangeProposalJSON from file
+func ParseRevertParamChangeProposalJSON(cdc *codec.Codec, proposalFile string) (RevertParamChangeProposalJSON, error) {
+	var proposal RevertParamChangeProposalJSON
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -17,4 +17,5 @@ func init() {
 // RegisterCodec registers all necessary param module types with a given codec.
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(types.ParameterChangeProposal{}, "okexchain/params/ParameterChangeProposal", nil)
+	cdc.RegisterConcrete(types.RevertParamChangeProposal{}, "okexchain/params/RevertParamChangeProposal", nil)
 }
@@ -10,7 +10,8 @@ import (
 
 // Keeper is the struct of params keeper
 type Keeper struct {
-	cdc *codec.Codec
+	cdc      *codec.Codec
+	storeKey sdk.StoreKey
 	sdkparams.Keeper
 	// the reference to the Paramstore to get and set gov specific params
 	paramSpace sdkparams.Subspace
@@ -29,6 +30,7 @@ func NewKeeper(cdc *codec.Codec, key *sdk.KVStoreKey, tkey *sdk.TransientStoreKe
 		Keeper: sdkparams.NewKeeper(cdc, key, tkey),
 	}
 	k.cdc = cdc
+	k.storeKey = key
 	k.paramSpace = k.Subspace(DefaultParamspace).WithKeyTable(types.ParamKeyTable())
 	return k
 }
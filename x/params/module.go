@@ -26,7 +26,8 @@ var (
 
 // GenesisState contains all params state that must be provided at genesis
 type GenesisState struct {
-	Params types.Params `json:"params" yaml:"params"`
+	Params             types.Params             `json:"params" yaml:"params"`
+	ParamChangeRecords types.ParamChangeRecords `json:"param_change_records" yaml:"param_change_records"`
 }
 
 // DefaultGenesisState returns the default genesis state of this module
@@ -42,6 +43,14 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("params deposit amount must be a valid sdk.Coins amount, is %s",
 			data.Params.MinDeposit.String())
 	}
+
+	proposalIDs := make(map[uint64]bool, len(data.ParamChangeRecords))
+	for _, record := range data.ParamChangeRecords {
+		if proposalIDs[record.ProposalID] {
+			return fmt.Errorf("duplicate param change record of proposal %d", record.ProposalID)
+		}
+		proposalIDs[record.ProposalID] = true
+	}
 	return nil
 }
 
@@ -104,13 +113,17 @@ func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.Va
 	var genesisState GenesisState
 	ModuleCdc.MustUnmarshalJSON(data, &genesisState)
 	am.keeper.SetParams(ctx, genesisState.Params)
+	for _, record := range genesisState.ParamChangeRecords {
+		am.keeper.SetParamChangeRecord(ctx, record)
+	}
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis exports the module genesis state
 func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 	gs := GenesisState{
-		Params: am.keeper.GetParams(ctx),
+		Params:             am.keeper.GetParams(ctx),
+		ParamChangeRecords: am.keeper.GetParamChangeRecords(ctx),
 	}
 	return ModuleCdc.MustMarshalJSON(gs)
 }
@@ -0,0 +1,38 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/params/types"
+)
+
+func TestGenesisParamChangeRecords(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	am := NewAppModule(keeper)
+
+	genesisState := DefaultGenesisState()
+	genesisState.ParamChangeRecords = types.ParamChangeRecords{
+		types.NewParamChangeRecord(1, 5, []types.PreviousParam{
+			{Subspace: DefaultParamspace, Key: string(types.KeyMaxBlockHeight), Value: `"100"`},
+		}),
+		types.NewParamChangeRecord(3, 8, []types.PreviousParam{
+			{Subspace: DefaultParamspace, Key: string(types.KeyMinDeposit), Absent: true},
+		}),
+	}
+	require.Nil(t, ValidateGenesis(genesisState))
+
+	am.InitGenesis(ctx, ModuleCdc.MustMarshalJSON(genesisState))
+	record, found := keeper.GetParamChangeRecord(ctx, 3)
+	require.True(t, found)
+	require.Equal(t, genesisState.ParamChangeRecords[1], record)
+
+	var exported GenesisState
+	ModuleCdc.MustUnmarshalJSON(am.ExportGenesis(ctx), &exported)
+	require.Equal(t, genesisState.ParamChangeRecords, exported.ParamChangeRecords)
+
+	// the records of a proposal are unique
+	genesisState.ParamChangeRecords = append(genesisState.ParamChangeRecords, genesisState.ParamChangeRecords[0])
+	require.NotNil(t, ValidateGenesis(genesisState))
+}
@@ -0,0 +1,96 @@
+package params
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdkparams "github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/okex/okexchain/x/params/types"
+)
+
+// SetParamChangeRecord sets the previous values of the parameters changed by a proposal into the store
+func (keeper Keeper) SetParamChangeRecord(ctx sdk.Context, record types.ParamChangeRecord) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.GetParamChangeRecordKey(record.ProposalID), keeper.cdc.MustMarshalBinaryLengthPrefixed(record))
+}
+
+// GetParamChangeRecord gets the previous values of the parameters changed by a proposal from the store
+func (keeper Keeper) GetParamChangeRecord(ctx sdk.Context, proposalID uint64) (record types.ParamChangeRecord,
+	found bool) {
+	bz := ctx.KVStore(keeper.storeKey).Get(types.GetParamChangeRecordKey(proposalID))
+	if bz == nil {
+		return
+	}
+
+	keeper.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &record)
+	return record, true
+}
+
+// DeleteParamChangeRecord deletes the parameter change record of a proposal from the store
+func (keeper Keeper) DeleteParamChangeRecord(ctx sdk.Context, proposalID uint64) {
+	ctx.KVStore(keeper.storeKey).Delete(types.GetParamChangeRecordKey(proposalID))
+}
+
+// IterateParamChangeRecords iterates over all the parameter change records in the order of the proposal ids
+func (keeper Keeper) IterateParamChangeRecords(ctx sdk.Context, cb func(record types.ParamChangeRecord) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), types.ParamChangeRecordKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ParamChangeRecord
+		keeper.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetParamChangeRecords gets all the parameter change records from the store
+func (keeper Keeper) GetParamChangeRecords(ctx sdk.Context) (records types.ParamChangeRecords) {
+	keeper.IterateParamChangeRecords(ctx, func(record types.ParamChangeRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	return
+}
+
+// getPreviousParam gets the current value of the parameter to be changed, or marks it absent if it has never been set
+func (keeper Keeper) getPreviousParam(ctx sdk.Context, c ParamChange) types.PreviousParam {
+	previous := types.PreviousParam{Subspace: c.Subspace, Key: c.Key}
+	ss, _ := keeper.GetSubspace(c.Subspace)
+	if !ss.Has(ctx, []byte(c.Key)) {
+		previous.Absent = true
+		return previous
+	}
+	previous.Value = string(ss.GetRaw(ctx, []byte(c.Key)))
+	return previous
+}
+
+// deleteParam deletes a parameter from the store of its subspace, which is prefixed with the subspace name and '/'
+func (keeper Keeper) deleteParam(ctx sdk.Context, subspace, key string) {
+	store := prefix.NewStore(ctx.KVStore(keeper.storeKey), append([]byte(subspace), '/'))
+	store.Delete([]byte(key))
+}
+
+// checkParamChangeConflict checks whether the parameters still have the values set by the proposal of the record. A
+// parameter changed since then makes the revert of the proposal conflict with the later change
+func (keeper Keeper) checkParamChangeConflict(ctx sdk.Context, record types.ParamChangeRecord) sdk.Error {
+	for _, c := range record.Changes {
+		ss, ok := keeper.GetSubspace(c.Subspace)
+		if !ok {
+			return sdkerrors.Wrap(sdkparams.ErrUnknownSubspace, c.Subspace)
+		}
+
+		key := []byte(c.Key)
+		if c.Removed {
+			if ss.Has(ctx, key) {
+				return types.ErrParamChangeConflict(record.ProposalID, c.Subspace, c.Key)
+			}
+			continue
+		}
+		if !ss.Has(ctx, key) || string(ss.GetRaw(ctx, key)) != c.NewValue {
+			return types.ErrParamChangeConflict(record.ProposalID, c.Subspace, c.Key)
+		}
+	}
+	return nil
+}
@@ -20,6 +20,8 @@ func NewParamChangeProposalHandler(k *Keeper) govtypes.Handler {
 		switch c := proposal.Content.(type) {
 		case types.ParameterChangeProposal:
 			return handleParameterChangeProposal(ctx, k, proposal)
+		case types.RevertParamChangeProposal:
+			return handleRevertParamChangeProposal(ctx, k, proposal)
 		default:
 			return common.ErrUnknownProposalType(DefaultCodespace, fmt.Sprintf("%T", c))
 		}
@@ -37,26 +39,118 @@ func handleParameterChangeProposal(ctx sdk.Context, k *Keeper, proposal *govtype
 	}
 
 	defer k.gk.RemoveFromWaitingProposalQueue(ctx, paramProposal.Height, proposal.ProposalID)
-	return changeParams(ctx, k, paramProposal)
+	return changeParams(ctx, k, proposal.ProposalID, paramProposal.Changes, nil)
 }
 
-func changeParams(ctx sdk.Context, k *Keeper, paramProposal types.ParameterChangeProposal) sdk.Error {
-	for _, c := range paramProposal.Changes {
-		ss, ok := k.GetSubspace(c.Subspace)
-		if !ok {
-			return sdkerrors.Wrap(sdkparams.ErrUnknownSubspace, c.Subspace)
+func handleRevertParamChangeProposal(ctx sdk.Context, k *Keeper, proposal *govtypes.Proposal) sdk.Error {
+	revertProposal := proposal.Content.(types.RevertParamChangeProposal)
+	record, found := k.GetParamChangeRecord(ctx, revertProposal.ProposalID)
+	if !found {
+		return types.ErrUnknownParamChangeRecord(revertProposal.ProposalID)
+	}
+
+	// the parameters changed again since the proposal are not overridden
+	if err := k.checkParamChangeConflict(ctx, record); err != nil {
+		return err
+	}
+
+	// the revert is recorded as well, so that it can be reverted by another proposal
+	changes, removals := record.RevertChanges()
+	if err := changeParams(ctx, k, proposal.ProposalID, changes, removals); err != nil {
+		return err
+	}
+	k.DeleteParamChangeRecord(ctx, revertProposal.ProposalID)
+	return nil
+}
+
+// changeParams applies all the changes and removals or none of them. Every change is validated against its subspace
+// before any of them is written, and the previous values of the changed parameters are recorded under the proposal id
+// along with the new ones, with the ones which have never been set marked absent.
+func changeParams(ctx sdk.Context, k *Keeper, proposalID uint64, changes, removals []ParamChange) sdk.Error {
+	if _, err := dryRunParamChanges(ctx, k, changes); err != nil {
+		return err
+	}
+	for _, r := range removals {
+		if _, ok := k.GetSubspace(r.Subspace); !ok {
+			return sdkerrors.Wrap(sdkparams.ErrUnknownSubspace, r.Subspace)
+		}
+	}
+
+	previous := make([]types.PreviousParam, 0, len(changes)+len(removals))
+	for _, c := range changes {
+		previous = append(previous, k.getPreviousParam(ctx, c))
+	}
+	for _, r := range removals {
+		previous = append(previous, k.getPreviousParam(ctx, r))
+	}
+
+	for _, c := range changes {
+		if err := updateParam(ctx, k, c); err != nil {
+			return err
+		}
+	}
+	for _, r := range removals {
+		k.deleteParam(ctx, r.Subspace, r.Key)
+	}
+
+	// the values set are recorded as stored, which a later revert checks the parameters against
+	for i, c := range changes {
+		ss, _ := k.GetSubspace(c.Subspace)
+		previous[i].NewValue = string(ss.GetRaw(ctx, []byte(c.Key)))
+	}
+	for i := range removals {
+		previous[len(changes)+i].Removed = true
+	}
+
+	k.SetParamChangeRecord(ctx, types.NewParamChangeRecord(proposalID, ctx.BlockHeight(), previous))
+	return nil
+}
+
+// dryRunParamChanges applies the changes in order on a cache context which is discarded, and returns the result of
+// each one of them with the first error
+func dryRunParamChanges(ctx sdk.Context, k *Keeper, changes []ParamChange) (types.ParamChangeChecks, sdk.Error) {
+	cacheCtx, _ := ctx.CacheContext()
+	checks := make(types.ParamChangeChecks, len(changes))
+	var firstErr sdk.Error
+	for i, c := range changes {
+		checks[i] = types.ParamChangeCheck{Subspace: c.Subspace, Key: c.Key, Value: c.Value}
+		if ss, ok := k.GetSubspace(c.Subspace); ok {
+			checks[i].CurrentValue = string(ss.GetRaw(cacheCtx, []byte(c.Key)))
 		}
 
-		err := ss.Update(ctx, []byte(c.Key), []byte(c.Value))
-		if err != nil {
-			return sdkerrors.Wrap(sdkparams.ErrSettingParameter, err.Error())
+		if err := updateParam(cacheCtx, k, c); err != nil {
+			checks[i].Error = err.Error()
+			if firstErr == nil {
+				firstErr = err
+			}
 		}
 	}
+
+	return checks, firstErr
+}
+
+// updateParam updates a parameter with the raw value of the change. The panic of the subspace on an unregistered key
+// is turned into an error.
+func updateParam(ctx sdk.Context, k *Keeper, c ParamChange) (err sdk.Error) {
+	ss, ok := k.GetSubspace(c.Subspace)
+	if !ok {
+		return sdkerrors.Wrap(sdkparams.ErrUnknownSubspace, c.Subspace)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = sdkerrors.Wrap(sdkparams.ErrSettingParameter, fmt.Sprintf("%v", r))
+		}
+	}()
+
+	if err := ss.Update(ctx, []byte(c.Key), []byte(c.Value)); err != nil {
+		return sdkerrors.Wrap(sdkparams.ErrSettingParameter, err.Error())
+	}
 	return nil
 }
 
-func checkDenom(paramProposal types.ParameterChangeProposal) sdk.Error {
-	for _, c := range paramProposal.Changes {
+func checkDenom(changes []ParamChange) sdk.Error {
+	for _, c := range changes {
 		if c.Subspace == "evm" && c.Key == "EVMDenom" {
 			return sdkerrors.Wrap(sdkparams.ErrSettingParameter, "evm denom can not be reset")
 		}
@@ -70,7 +164,7 @@ func checkDenom(paramProposal types.ParameterChangeProposal) sdk.Error {
 // GetMinDeposit implements ProposalHandler interface
 func (keeper Keeper) GetMinDeposit(ctx sdk.Context, content govtypes.Content) (minDeposit sdk.SysCoins) {
 	switch content.(type) {
-	case types.ParameterChangeProposal:
+	case types.ParameterChangeProposal, types.RevertParamChangeProposal:
 		minDeposit = keeper.GetParams(ctx).MinDeposit
 	}
 
@@ -80,7 +174,7 @@ func (keeper Keeper) GetMinDeposit(ctx sdk.Context, content govtypes.Content) (m
 // GetMaxDepositPeriod implements ProposalHandler interface
 func (keeper Keeper) GetMaxDepositPeriod(ctx sdk.Context, content govtypes.Content) (maxDepositPeriod time.Duration) {
 	switch content.(type) {
-	case types.ParameterChangeProposal:
+	case types.ParameterChangeProposal, types.RevertParamChangeProposal:
 		maxDepositPeriod = keeper.GetParams(ctx).MaxDepositPeriod
 	}
 
@@ -90,7 +184,7 @@ func (keeper Keeper) GetMaxDepositPeriod(ctx sdk.Context, content govtypes.Conte
 // GetVotingPeriod implements ProposalHandler interface
 func (keeper Keeper) GetVotingPeriod(ctx sdk.Context, content govtypes.Content) (votingPeriod time.Duration) {
 	switch content.(type) {
-	case types.ParameterChangeProposal:
+	case types.ParameterChangeProposal, types.RevertParamChangeProposal:
 		votingPeriod = keeper.GetParams(ctx).VotingPeriod
 	}
 
@@ -99,14 +193,63 @@ func (keeper Keeper) GetVotingPeriod(ctx sdk.Context, content govtypes.Content)
 
 // CheckMsgSubmitProposal implements ProposalHandler interface
 func (keeper Keeper) CheckMsgSubmitProposal(ctx sdk.Context, msg govtypes.MsgSubmitProposal) sdk.Error {
-	paramsChangeProposal := msg.Content.(types.ParameterChangeProposal)
+	switch content := msg.Content.(type) {
+	case types.ParameterChangeProposal:
+		return keeper.checkParamChangeProposal(ctx, msg, content)
+	case types.RevertParamChangeProposal:
+		return keeper.checkRevertParamChangeProposal(ctx, msg, content)
+	default:
+		return common.ErrUnknownProposalType(DefaultCodespace, fmt.Sprintf("%T", content))
+	}
+}
 
+func (keeper Keeper) checkParamChangeProposal(ctx sdk.Context, msg govtypes.MsgSubmitProposal,
+	paramsChangeProposal types.ParameterChangeProposal) sdk.Error {
 	if sdk.HigherThanMercury(ctx.BlockHeight()) {
-		if err := checkDenom(paramsChangeProposal); err != nil {
+		if err := checkDenom(paramsChangeProposal.Changes); err != nil {
 			return err
 		}
 	}
 
+	if err := keeper.checkProposer(ctx, msg); err != nil {
+		return err
+	}
+
+	curHeight := uint64(ctx.BlockHeight())
+	maxHeight := keeper.GetParams(ctx).MaxBlockHeight
+	if maxHeight == 0 {
+		maxHeight = math.MaxInt64 - paramsChangeProposal.Height
+	}
+	if paramsChangeProposal.Height < curHeight || paramsChangeProposal.Height > curHeight+maxHeight {
+		return govtypes.ErrInvalidHeight(paramsChangeProposal.Height, curHeight, maxHeight)
+	}
+
+	// run simulation with cache context
+	_, err := dryRunParamChanges(ctx, &keeper, paramsChangeProposal.Changes)
+	return err
+}
+
+func (keeper Keeper) checkRevertParamChangeProposal(ctx sdk.Context, msg govtypes.MsgSubmitProposal,
+	revertProposal types.RevertParamChangeProposal) sdk.Error {
+	if err := keeper.checkProposer(ctx, msg); err != nil {
+		return err
+	}
+
+	record, found := keeper.GetParamChangeRecord(ctx, revertProposal.ProposalID)
+	if !found {
+		return types.ErrUnknownParamChangeRecord(revertProposal.ProposalID)
+	}
+	if err := keeper.checkParamChangeConflict(ctx, record); err != nil {
+		return err
+	}
+
+	// run simulation with cache context, the removals of the absent parameters always succeed
+	changes, _ := record.RevertChanges()
+	_, err := dryRunParamChanges(ctx, &keeper, changes)
+	return err
+}
+
+func (keeper Keeper) checkProposer(ctx sdk.Context, msg govtypes.MsgSubmitProposal) sdk.Error {
 	// check message sender is current validator
 	if !keeper.sk.IsValidator(ctx, msg.Proposer) {
 		return govtypes.ErrInvalidProposer()
@@ -120,19 +263,7 @@ func (keeper Keeper) CheckMsgSubmitProposal(ctx sdk.Context, msg govtypes.MsgSub
 	if err := common.HasSufficientCoins(msg.Proposer, keeper.ck.GetCoins(ctx, msg.Proposer), msg.InitialDeposit); err != nil {
 		return sdk.ErrInvalidCoins(err.Error())
 	}
-
-	curHeight := uint64(ctx.BlockHeight())
-	maxHeight := keeper.GetParams(ctx).MaxBlockHeight
-	if maxHeight == 0 {
-		maxHeight = math.MaxInt64 - paramsChangeProposal.Height
-	}
-	if paramsChangeProposal.Height < curHeight || paramsChangeProposal.Height > curHeight+maxHeight {
-		return govtypes.ErrInvalidHeight(paramsChangeProposal.Height, curHeight, maxHeight)
-	}
-
-	// run simulation with cache context
-	cacheCtx, _ := ctx.CacheContext()
-	return changeParams(cacheCtx, &keeper, paramsChangeProposal)
+	return nil
 }
 
 // nolint
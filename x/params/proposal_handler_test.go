@@ -0,0 +1,156 @@
+package params
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	govtypes "github.com/okex/okexchain/x/gov/types"
+	"github.com/okex/okexchain/x/params/types"
+)
+
+func createTestInput(t *testing.T) (sdk.Context, Keeper) {
+	keyParams := sdk.NewKVStoreKey(StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.Nil(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "okexchain", Height: 10}, false, log.NewNopLogger())
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	keeper := NewKeeper(cdc, keyParams, tkeyParams)
+	keeper.SetParams(ctx, types.DefaultParams())
+	return ctx, keeper
+}
+
+func TestChangeParams(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	maxBlockHeight := keeper.GetParams(ctx).MaxBlockHeight
+	minDeposit := keeper.GetParams(ctx).MinDeposit
+	validChange := NewParamChange(DefaultParamspace, string(types.KeyMaxBlockHeight), `"200"`)
+
+	// a later invalid change fails all of them
+	for _, invalidChange := range []ParamChange{
+		NewParamChange(DefaultParamspace, string(types.KeyVotingPeriod), `"0"`),
+		NewParamChange(DefaultParamspace, "UnknownKey", `"1"`),
+		NewParamChange("unknown", string(types.KeyMaxBlockHeight), `"1"`),
+	} {
+		changes := []ParamChange{validChange, invalidChange}
+		checks, err := dryRunParamChanges(ctx, &keeper, changes)
+		require.NotNil(t, err)
+		require.False(t, checks.Passed())
+		require.Empty(t, checks[0].Error)
+		require.NotEmpty(t, checks[1].Error)
+
+		require.NotNil(t, changeParams(ctx, &keeper, 1, changes, nil))
+		require.Equal(t, maxBlockHeight, keeper.GetParams(ctx).MaxBlockHeight)
+		_, found := keeper.GetParamChangeRecord(ctx, 1)
+		require.False(t, found)
+	}
+
+	changes := []ParamChange{
+		validChange,
+		NewParamChange(DefaultParamspace, string(types.KeyMinDeposit), `[{"denom":"okt","amount":"50"}]`),
+	}
+	checks, err := dryRunParamChanges(ctx, &keeper, changes)
+	require.Nil(t, err)
+	require.True(t, checks.Passed())
+	require.Equal(t, maxBlockHeight, keeper.GetParams(ctx).MaxBlockHeight)
+
+	require.Nil(t, changeParams(ctx, &keeper, 1, changes, nil))
+	require.Equal(t, uint64(200), keeper.GetParams(ctx).MaxBlockHeight)
+	require.Equal(t, sdk.NewDecCoinsFromDec("okt", sdk.NewDec(50)), keeper.GetParams(ctx).MinDeposit)
+
+	// the previous values are recorded and restored
+	record, found := keeper.GetParamChangeRecord(ctx, 1)
+	require.True(t, found)
+	require.Len(t, record.Changes, 2)
+	require.Equal(t, ctx.BlockHeight(), record.Height)
+
+	revertChanges, removals := record.RevertChanges()
+	require.Empty(t, removals)
+	require.Nil(t, changeParams(ctx, &keeper, 2, revertChanges, removals))
+	require.Equal(t, maxBlockHeight, keeper.GetParams(ctx).MaxBlockHeight)
+	require.True(t, minDeposit.IsEqual(keeper.GetParams(ctx).MinDeposit))
+}
+
+func TestRevertAbsentParam(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	// a subspace whose parameters have never been set
+	ss := keeper.Subspace("unset").WithKeyTable(types.ParamKeyTable())
+	key := string(types.KeyMaxBlockHeight)
+
+	require.Nil(t, changeParams(ctx, &keeper, 1, []ParamChange{NewParamChange("unset", key, `"200"`)}, nil))
+	require.True(t, ss.Has(ctx, []byte(key)))
+
+	record, found := keeper.GetParamChangeRecord(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, []types.PreviousParam{{Subspace: "unset", Key: key, Absent: true, NewValue: `"200"`}},
+		record.Changes)
+
+	// reverting deletes the parameter, and the revert records the value it deleted
+	revertChanges, removals := record.RevertChanges()
+	require.Empty(t, revertChanges)
+	require.Nil(t, changeParams(ctx, &keeper, 2, revertChanges, removals))
+	require.False(t, ss.Has(ctx, []byte(key)))
+
+	record, found = keeper.GetParamChangeRecord(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, []types.PreviousParam{{Subspace: "unset", Key: key, Value: `"200"`, Removed: true}},
+		record.Changes)
+}
+
+func TestRevertParamChangeConflict(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	key := string(types.KeyMaxBlockHeight)
+	maxBlockHeight := keeper.GetParams(ctx).MaxBlockHeight
+
+	require.Nil(t, changeParams(ctx, &keeper, 1, []ParamChange{NewParamChange(DefaultParamspace, key, `"200"`)}, nil))
+	record, found := keeper.GetParamChangeRecord(ctx, 1)
+	require.True(t, found)
+	require.Nil(t, keeper.checkParamChangeConflict(ctx, record))
+
+	// a later proposal changes the parameter again, so the revert would override it
+	require.Nil(t, changeParams(ctx, &keeper, 2, []ParamChange{NewParamChange(DefaultParamspace, key, `"300"`)}, nil))
+	require.NotNil(t, keeper.checkParamChangeConflict(ctx, record))
+
+	proposal := &govtypes.Proposal{ProposalID: 3, Content: types.NewRevertParamChangeProposal("title", "desc", 1)}
+	require.NotNil(t, handleRevertParamChangeProposal(ctx, &keeper, proposal))
+	require.Equal(t, uint64(300), keeper.GetParams(ctx).MaxBlockHeight)
+	_, found = keeper.GetParamChangeRecord(ctx, 1)
+	require.True(t, found)
+
+	// the revert succeeds once the later change is reverted
+	proposal = &govtypes.Proposal{ProposalID: 4, Content: types.NewRevertParamChangeProposal("title", "desc", 2)}
+	require.Nil(t, handleRevertParamChangeProposal(ctx, &keeper, proposal))
+	proposal = &govtypes.Proposal{ProposalID: 5, Content: types.NewRevertParamChangeProposal("title", "desc", 1)}
+	require.Nil(t, handleRevertParamChangeProposal(ctx, &keeper, proposal))
+	require.Equal(t, maxBlockHeight, keeper.GetParams(ctx).MaxBlockHeight)
+	_, found = keeper.GetParamChangeRecord(ctx, 1)
+	require.False(t, found)
+}
+
+func TestValidateParamChanges(t *testing.T) {
+	change := NewParamChange(DefaultParamspace, string(types.KeyMaxBlockHeight), `"200"`)
+	require.Nil(t, types.ValidateParamChanges([]ParamChange{change}))
+	require.NotNil(t, types.ValidateParamChanges(nil))
+	require.NotNil(t, types.ValidateParamChanges([]ParamChange{change, change}))
+
+	changes := make([]ParamChange, types.MaxParamChanges+1)
+	for i := range changes {
+		changes[i] = NewParamChange(DefaultParamspace, string(rune('a'+i)), `"1"`)
+	}
+	require.NotNil(t, types.ValidateParamChanges(changes))
+}
@@ -16,6 +16,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		switch path[0] {
 		case types.QueryParams:
 			return queryParams(ctx, req, keeper)
+		case types.QueryCheckParamChanges:
+			return queryCheckParamChanges(ctx, req, keeper)
+		case types.QueryParamChangeRecord:
+			return queryParamChangeRecord(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown params query endpoint")
 		}
@@ -29,3 +33,45 @@ func queryParams(ctx sdk.Context, _ abci.RequestQuery, keeper Keeper) ([]byte, s
 	}
 	return bz, nil
 }
+
+// queryCheckParamChanges runs the parameter changes of a proposal against the current state without writing them
+func queryCheckParamChanges(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckParamChangesParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if err := types.ValidateParamChanges(params.Changes); err != nil {
+		return nil, err
+	}
+	if sdk.HigherThanMercury(ctx.BlockHeight()) {
+		if err := checkDenom(params.Changes); err != nil {
+			return nil, err
+		}
+	}
+
+	checks, _ := dryRunParamChanges(ctx, &keeper, params.Changes)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, checks)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryParamChangeRecord(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryParamChangeRecordParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	record, found := keeper.GetParamChangeRecord(ctx, params.ProposalID)
+	if !found {
+		return nil, types.ErrUnknownParamChangeRecord(params.ProposalID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, record)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -9,15 +11,45 @@ import (
 // Param module codespace constants
 const (
 	DefaultCodespace string = "params"
-	BaseParamsError = 4001
+	BaseParamsError         = 4001
 
-	CodeInvalidMaxProposalNum uint32 = BaseParamsError+4
+	CodeInvalidMaxProposalNum    uint32 = BaseParamsError + 4
+	CodeDuplicateParamChange     uint32 = BaseParamsError + 5
+	CodeUnknownParamChangeRecord uint32 = BaseParamsError + 6
+	CodeParamChangeConflict      uint32 = BaseParamsError + 7
 )
 
 // ErrInvalidMaxProposalNum returns error when the number of params to change are out of limit
 var RegisteredErrInvalidParamsNum = sdkerrors.Register(params.ModuleName, CodeInvalidMaxProposalNum, "invalid param number")
 
+var (
+	errDuplicateParamChange = sdkerrors.Register(params.ModuleName, CodeDuplicateParamChange,
+		"duplicate param change")
+	errUnknownParamChangeRecord = sdkerrors.Register(params.ModuleName, CodeUnknownParamChangeRecord,
+		"unknown param change record")
+	errParamChangeConflict = sdkerrors.Register(params.ModuleName, CodeParamChangeConflict,
+		"param change conflict")
+)
+
 // ErrInvalidParamsNum returns error when the number of params to change are out of limit
 func ErrInvalidParamsNum(codespace string, msg string) sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{sdkerrors.Wrap(RegisteredErrInvalidParamsNum, msg)}
 }
+
+// ErrDuplicateParamChange returns an error when a parameter is changed more than once by a proposal
+func ErrDuplicateParamChange(subspace, key string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errDuplicateParamChange, "%s/%s", subspace, key)}
+}
+
+// ErrUnknownParamChangeRecord returns an error when the previous values changed by a proposal are not recorded
+func ErrUnknownParamChangeRecord(proposalID uint64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrap(errUnknownParamChangeRecord,
+		fmt.Sprintf("no previous values are recorded for the proposal %d", proposalID))}
+}
+
+// ErrParamChangeConflict returns an error when a parameter changed by a proposal has been changed again since then, so
+// reverting the proposal would override the later change
+func ErrParamChangeConflict(proposalID uint64, subspace, key string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errParamChangeConflict,
+		"%s/%s has been changed since the proposal %d", subspace, key, proposalID)}
+}
@@ -12,6 +12,9 @@ import (
 	sdkparams "github.com/cosmos/cosmos-sdk/x/params"
 )
 
+// MaxParamChanges is the maximum amount of parameters changed by a proposal
+const MaxParamChanges = 16
+
 // Assert ParameterChangeProposal implements govtypes.Content at compile-time
 var _ govtypes.Content = ParameterChangeProposal{}
 
@@ -56,9 +59,25 @@ func (pcp ParameterChangeProposal) ValidateBasic() sdk.Error {
 		return govtypes.ErrInvalidProposalType(pcp.ProposalType())
 	}
 
-	if len(pcp.Changes) != 1 {
-		return ErrInvalidParamsNum(DefaultCodespace, fmt.Sprintf("one proposal can only change one pair of parameter"))
+	return ValidateParamChanges(pcp.Changes)
+}
+
+// ValidateParamChanges validates the number of the changes carried by a proposal and each one of them. A parameter
+// can only be changed once by a proposal, as all the changes are applied at once.
+func ValidateParamChanges(changes []types.ParamChange) sdk.Error {
+	if len(changes) == 0 || len(changes) > MaxParamChanges {
+		return ErrInvalidParamsNum(DefaultCodespace,
+			fmt.Sprintf("one proposal can only change 1 to %d parameters", MaxParamChanges))
+	}
+
+	changed := make(map[string]struct{}, len(changes))
+	for _, c := range changes {
+		key := fmt.Sprintf("%s/%s", c.Subspace, c.Key)
+		if _, ok := changed[key]; ok {
+			return ErrDuplicateParamChange(c.Subspace, c.Key)
+		}
+		changed[key] = struct{}{}
 	}
 
-	return sdkparams.ValidateChanges(pcp.Changes)
+	return sdkparams.ValidateChanges(changes)
 }
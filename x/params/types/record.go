@@ -0,0 +1,148 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// query endpoints and keys of the parameter change records
+const (
+	QueryCheckParamChanges = "checkParamChanges"
+	QueryParamChangeRecord = "paramChangeRecord"
+)
+
+// ParamChangeRecordKeyPrefix is the key prefix of the parameter change records in the params store, which never
+// collides with the subspaces named in letters
+var ParamChangeRecordKeyPrefix = []byte{0x01}
+
+// GetParamChangeRecordKey gets the key of the parameter change record of a proposal
+func GetParamChangeRecordKey(proposalID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, proposalID)
+	return append(ParamChangeRecordKeyPrefix, bz...)
+}
+
+// PreviousParam is the value of a parameter before it was changed by a proposal. Absent is true if the parameter had
+// never been set, in which case reverting the change deletes the parameter instead of restoring Value. NewValue is the
+// value set by the proposal, or Removed is true if the proposal deleted the parameter, which the parameter must still
+// have for the change to be reverted
+type PreviousParam struct {
+	Subspace string `json:"subspace" yaml:"subspace"`
+	Key      string `json:"key" yaml:"key"`
+	Value    string `json:"value" yaml:"value"`
+	Absent   bool   `json:"absent" yaml:"absent"`
+	NewValue string `json:"new_value" yaml:"new_value"`
+	Removed  bool   `json:"removed" yaml:"removed"`
+}
+
+// ParamChangeRecord records the values of the parameters before they were changed by a proposal, which a revert
+// parameter change proposal restores
+type ParamChangeRecord struct {
+	ProposalID uint64          `json:"proposal_id" yaml:"proposal_id"`
+	Height     int64           `json:"height" yaml:"height"`
+	Changes    []PreviousParam `json:"changes" yaml:"changes"`
+}
+
+// NewParamChangeRecord creates a new instance of ParamChangeRecord
+func NewParamChangeRecord(proposalID uint64, height int64, changes []PreviousParam) ParamChangeRecord {
+	return ParamChangeRecord{
+		ProposalID: proposalID,
+		Height:     height,
+		Changes:    changes,
+	}
+}
+
+// RevertChanges returns the changes restoring the previous values which were set, and the removals of the
+// parameters which were absent
+func (pcr ParamChangeRecord) RevertChanges() (changes, removals []ParamChange) {
+	for _, c := range pcr.Changes {
+		if c.Absent {
+			removals = append(removals, NewParamChange(c.Subspace, c.Key, ""))
+		} else {
+			changes = append(changes, NewParamChange(c.Subspace, c.Key, c.Value))
+		}
+	}
+	return
+}
+
+// String returns a human readable string representation of ParamChangeRecord
+func (pcr ParamChangeRecord) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(`Param Change Record:
+  Proposal ID: %d
+  Height:      %d
+  Previous Values:`, pcr.ProposalID, pcr.Height))
+	for _, c := range pcr.Changes {
+		value, newValue := c.Value, c.NewValue
+		if c.Absent {
+			value = "<absent>"
+		}
+		if c.Removed {
+			newValue = "<absent>"
+		}
+		sb.WriteString(fmt.Sprintf("\n    %s/%s: %s -> %s", c.Subspace, c.Key, value, newValue))
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// ParamChangeRecords is the collection of ParamChangeRecord
+type ParamChangeRecords []ParamChangeRecord
+
+// QueryCheckParamChangesParams defines the params for the dry run of parameter changes
+type QueryCheckParamChangesParams struct {
+	Changes []ParamChange `json:"changes" yaml:"changes"`
+}
+
+// NewQueryCheckParamChangesParams creates a new instance of QueryCheckParamChangesParams
+func NewQueryCheckParamChangesParams(changes []ParamChange) QueryCheckParamChangesParams {
+	return QueryCheckParamChangesParams{changes}
+}
+
+// QueryParamChangeRecordParams defines the params for the query of the parameter change record of a proposal
+type QueryParamChangeRecordParams struct {
+	ProposalID uint64 `json:"proposal_id" yaml:"proposal_id"`
+}
+
+// NewQueryParamChangeRecordParams creates a new instance of QueryParamChangeRecordParams
+func NewQueryParamChangeRecordParams(proposalID uint64) QueryParamChangeRecordParams {
+	return QueryParamChangeRecordParams{proposalID}
+}
+
+// ParamChangeCheck is the result of the dry run of a parameter change against the current state
+type ParamChangeCheck struct {
+	Subspace     string `json:"subspace" yaml:"subspace"`
+	Key          string `json:"key" yaml:"key"`
+	Value        string `json:"value" yaml:"value"`
+	CurrentValue string `json:"current_value" yaml:"current_value"`
+	Error        string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// ParamChangeChecks is the collection of ParamChangeCheck
+type ParamChangeChecks []ParamChangeCheck
+
+// Passed returns a boolean determining if all the parameter changes passed the dry run
+func (pccs ParamChangeChecks) Passed() bool {
+	for _, c := range pccs {
+		if len(c.Error) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// String returns a human readable string representation of ParamChangeChecks
+func (pccs ParamChangeChecks) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Param Change Checks (passed: %t):", pccs.Passed()))
+	for _, c := range pccs {
+		result := "ok"
+		if len(c.Error) != 0 {
+			result = c.Error
+		}
+		sb.WriteString(fmt.Sprintf("\n  %s/%s: %s -> %s\n    %s", c.Subspace, c.Key, c.CurrentValue, c.Value, result))
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkparams "github.com/cosmos/cosmos-sdk/x/params"
+
+	govtypes "github.com/okex/okexchain/x/gov/types"
+)
+
+// ProposalTypeRevertChange defines the type for a RevertParamChangeProposal
+const ProposalTypeRevertChange = "RevertParameterChange"
+
+// Assert RevertParamChangeProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = RevertParamChangeProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeRevertChange)
+	govtypes.RegisterProposalTypeCodec(RevertParamChangeProposal{}, "okexchain/params/RevertParamChangeProposal")
+}
+
+// RevertParamChangeProposal is the struct of the proposal restoring the parameters changed by a passed parameter change
+// proposal to their previous values
+type RevertParamChangeProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	ProposalID  uint64 `json:"proposal_id" yaml:"proposal_id"`
+}
+
+// NewRevertParamChangeProposal creates a new instance of RevertParamChangeProposal
+func NewRevertParamChangeProposal(title, description string, proposalID uint64) RevertParamChangeProposal {
+	return RevertParamChangeProposal{
+		Title:       title,
+		Description: description,
+		ProposalID:  proposalID,
+	}
+}
+
+// nolint
+func (rpcp RevertParamChangeProposal) GetTitle() string       { return rpcp.Title }
+func (rpcp RevertParamChangeProposal) GetDescription() string { return rpcp.Description }
+func (rpcp RevertParamChangeProposal) ProposalRoute() string  { return sdkparams.RouterKey }
+func (rpcp RevertParamChangeProposal) ProposalType() string   { return ProposalTypeRevertChange }
+
+// ValidateBasic validates the revert parameter change proposal
+func (rpcp RevertParamChangeProposal) ValidateBasic() sdk.Error {
+	if err := govtypes.ValidateAbstract(DefaultCodespace, rpcp); err != nil {
+		return err
+	}
+
+	if rpcp.ProposalID == 0 {
+		return govtypes.ErrInvalidProposalContent("proposal id of the reverted proposal is required")
+	}
+
+	return nil
+}
+
+// String returns a human readable string representation of RevertParamChangeProposal
+func (rpcp RevertParamChangeProposal) String() string {
+	return fmt.Sprintf(`Revert Param Change Proposal:
+  Title:       %s
+  Description: %s
+  Proposal ID: %d
+`, rpcp.Title, rpcp.Description, rpcp.ProposalID)
+}
@@ -18,6 +18,11 @@ const (
 	QueryParameters             = types.QueryParameters
 	QuerySigningInfo            = types.QuerySigningInfo
 	QuerySigningInfos           = types.QuerySigningInfos
+	QueryOffences               = types.QueryOffences
+	DefaultOffenceWindow        = types.DefaultOffenceWindow
+	DefaultMaxDowntimeJail      = types.DefaultMaxDowntimeJail
+	OffenceTypeDowntime         = types.OffenceTypeDowntime
+	OffenceTypeDoubleSign       = types.OffenceTypeDoubleSign
 
 	EventTypeSlash                 = types.EventTypeSlash
 	EventTypeLiveness              = types.EventTypeLiveness
@@ -27,6 +32,7 @@ const (
 	AttributeKeyReason             = types.AttributeKeyReason
 	AttributeKeyJailed             = types.AttributeKeyJailed
 	AttributeKeyMissedBlocks       = types.AttributeKeyMissedBlocks
+	AttributeKeyJailedUntil        = types.AttributeKeyJailedUntil
 	AttributeValueDoubleSign       = types.AttributeValueDoubleSign
 	AttributeValueMissingSignature = types.AttributeValueMissingSignature
 	AttributeValueCategory         = types.AttributeValueCategory
@@ -60,6 +66,7 @@ var (
 	NewQuerySigningInfoParams                = types.NewQuerySigningInfoParams
 	NewQuerySigningInfosParams               = types.NewQuerySigningInfosParams
 	NewValidatorSigningInfo                  = types.NewValidatorSigningInfo
+	NewOffence                               = types.NewOffence
 
 	// variable aliases
 	ModuleCdc                       = types.ModuleCdc
@@ -74,6 +81,10 @@ var (
 	KeyDowntimeJailDuration         = types.KeyDowntimeJailDuration
 	KeySlashFractionDoubleSign      = types.KeySlashFractionDoubleSign
 	KeySlashFractionDowntime        = types.KeySlashFractionDowntime
+	KeyOffenceWindow                = types.KeyOffenceWindow
+	KeyJailEscalationFactor         = types.KeyJailEscalationFactor
+	KeyMaxDowntimeJailDuration      = types.KeyMaxDowntimeJailDuration
+	DefaultJailEscalationFactor     = types.DefaultJailEscalationFactor
 )
 
 type (
@@ -86,4 +97,6 @@ type (
 	QuerySigningInfoParams  = types.QuerySigningInfoParams
 	QuerySigningInfosParams = types.QuerySigningInfosParams
 	ValidatorSigningInfo    = types.ValidatorSigningInfo
+	Offence                 = types.Offence
+	Offences                = types.Offences
 )
@@ -29,6 +29,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	slashingQueryCmd.AddCommand(
 		flags.GetCommands(
 			GetCmdQuerySigningInfo(queryRoute, cdc),
+			GetCmdQueryOffences(cdc),
 			GetCmdQueryParams(cdc),
 		)...,
 	)
@@ -74,6 +75,42 @@ $ <appcli> query slashing signing-info okexchainvalconspub1zcjduepqfhvwcmt7p06fv
 	}
 }
 
+// GetCmdQueryOffences implements the command to query the offence history of a validator.
+func GetCmdQueryOffences(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "offences [validator-conspub]",
+		Short: "Query the offence history of a validator",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to find the downtime and double sign offences committed by that validator:
+
+$ <appcli> query slashing offences okexchainvalconspub1zcjduepqfhvwcmt7p06fvdgexxhmz0l8c7sgswl7ulv7aulk364x4g5xsw7sr0k2g5
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			pk, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQuerySigningInfoParams(sdk.ConsAddress(pk.Address())))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryOffences)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var offences types.Offences
+			cdc.MustUnmarshalJSON(res, &offences)
+			return cliCtx.PrintOutput(offences)
+		},
+	}
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
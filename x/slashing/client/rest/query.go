@@ -18,6 +18,11 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		signingInfoHandlerFn(cliCtx),
 	).Methods("GET")
 
+	r.HandleFunc(
+		"/slashing/validators/{validatorPubKey}/offences",
+		offencesHandlerFn(cliCtx),
+	).Methods("GET")
+
 	r.HandleFunc(
 		"/slashing/signing_infos",
 		signingInfoHandlerListFn(cliCtx),
@@ -64,6 +69,41 @@ func signingInfoHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// http request handler to query the offence history of a validator
+func offencesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		pk, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, vars["validatorPubKey"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		params := types.NewQuerySigningInfoParams(sdk.ConsAddress(pk.Address()))
+
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryOffences)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // http request handler to query signing info
 func signingInfoHandlerListFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -80,7 +80,13 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 			// Note that this *can* result in a negative "distributionHeight" up to -ValidatorUpdateDelay-1,
 			// i.e. at the end of the pre-genesis block (none) = at the beginning of the genesis block.
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
-			//distributionHeight := height - sdk.ValidatorUpdateDelay - 1
+			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
+
+			// the jail duration escalates with the downtime offences committed inside the offence window
+			params := k.GetParams(ctx)
+			blockTime := ctx.BlockHeader().Time
+			offences := signInfo.CountDowntimeOffencesSince(blockTime.Add(-params.OffenceWindow)) + 1
+			signInfo.JailedUntil = blockTime.Add(params.EscalatedJailDuration(offences))
 
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
@@ -89,14 +95,17 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 					sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
 					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
 					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyJailedUntil, signInfo.JailedUntil.String()),
 				),
 			)
-			//don't slashing tokens, just jail the validator
-			//k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
+			k.sk.Slash(ctx, consAddr, distributionHeight, power, params.SlashFractionDowntime)
 			k.sk.Jail(ctx, consAddr)
 			k.GetStakingKeeper().AppendAbandonedValidatorAddrs(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			signInfo.Offences = append(signInfo.Offences, types.NewOffence(types.OffenceTypeDowntime, height, blockTime,
+				params.SlashFractionDowntime, signInfo.JailedUntil))
+			logger.Info(fmt.Sprintf("Validator %s jailed until %s for downtime offence %d inside the offence window",
+				consAddr, signInfo.JailedUntil, offences))
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
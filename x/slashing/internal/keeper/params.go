@@ -42,9 +42,39 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
-// GetParams returns the total set of slashing parameters.
+// OffenceWindow - window inside which downtime offences escalate the jail duration, DefaultOffenceWindow if unset
+func (k Keeper) OffenceWindow(ctx sdk.Context) (res time.Duration) {
+	res = types.DefaultOffenceWindow
+	k.paramspace.GetIfExists(ctx, types.KeyOffenceWindow, &res)
+	return
+}
+
+// JailEscalationFactor - multiplier of the jail duration for every repeat downtime offence, DefaultJailEscalationFactor
+// if unset
+func (k Keeper) JailEscalationFactor(ctx sdk.Context) (res sdk.Dec) {
+	res = types.DefaultJailEscalationFactor
+	k.paramspace.GetIfExists(ctx, types.KeyJailEscalationFactor, &res)
+	return
+}
+
+// MaxDowntimeJailDuration - upper bound of the escalated downtime jail duration, DefaultMaxDowntimeJail if unset
+func (k Keeper) MaxDowntimeJailDuration(ctx sdk.Context) (res time.Duration) {
+	res = types.DefaultMaxDowntimeJail
+	k.paramspace.GetIfExists(ctx, types.KeyMaxDowntimeJailDuration, &res)
+	return
+}
+
+// GetParams returns the total set of slashing parameters. The escalation params are added after the chain started,
+// so they fall back to their defaults until they are set
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramspace.GetParamSet(ctx, &params)
+	k.paramspace.Get(ctx, types.KeySignedBlocksWindow, &params.SignedBlocksWindow)
+	k.paramspace.Get(ctx, types.KeyMinSignedPerWindow, &params.MinSignedPerWindow)
+	k.paramspace.Get(ctx, types.KeyDowntimeJailDuration, &params.DowntimeJailDuration)
+	k.paramspace.Get(ctx, types.KeySlashFractionDoubleSign, &params.SlashFractionDoubleSign)
+	k.paramspace.Get(ctx, types.KeySlashFractionDowntime, &params.SlashFractionDowntime)
+	params.OffenceWindow = k.OffenceWindow(ctx)
+	params.JailEscalationFactor = k.JailEscalationFactor(ctx)
+	params.MaxDowntimeJailDuration = k.MaxDowntimeJailDuration(ctx)
 	return params
 }
 
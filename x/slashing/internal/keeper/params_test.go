@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/params"
+	"github.com/okex/okexchain/x/slashing/internal/types"
+)
+
+func createParamsTestInput(t *testing.T) (sdk.Context, params.Subspace, Keeper) {
+	keySlashing := sdk.NewKVStoreKey(types.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keySlashing, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := createTestCodec()
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Unix(0, 0)}, false, log.NewNopLogger())
+	paramstore := params.NewKeeper(cdc, keyParams, tkeyParams).Subspace(types.DefaultParamspace)
+	return ctx, paramstore, NewKeeper(cdc, keySlashing, nil, paramstore)
+}
+
+func TestGetParamsBeforeEscalation(t *testing.T) {
+	ctx, paramstore, keeper := createParamsTestInput(t)
+
+	// a chain started before the escalation params were added only has the original params set
+	expected := TestParams()
+	paramstore.Set(ctx, types.KeySignedBlocksWindow, expected.SignedBlocksWindow)
+	paramstore.Set(ctx, types.KeyMinSignedPerWindow, expected.MinSignedPerWindow)
+	paramstore.Set(ctx, types.KeyDowntimeJailDuration, expected.DowntimeJailDuration)
+	paramstore.Set(ctx, types.KeySlashFractionDoubleSign, expected.SlashFractionDoubleSign)
+	paramstore.Set(ctx, types.KeySlashFractionDowntime, expected.SlashFractionDowntime)
+
+	require.Equal(t, expected, keeper.GetParams(ctx))
+	require.Equal(t, types.DefaultOffenceWindow, keeper.OffenceWindow(ctx))
+	require.Equal(t, types.DefaultJailEscalationFactor, keeper.JailEscalationFactor(ctx))
+	require.Equal(t, types.DefaultMaxDowntimeJail, keeper.MaxDowntimeJailDuration(ctx))
+
+	// the escalation params set later override the defaults
+	expected.OffenceWindow = time.Hour
+	expected.JailEscalationFactor = sdk.NewDec(3)
+	expected.MaxDowntimeJailDuration = 2 * time.Hour
+	keeper.SetParams(ctx, expected)
+	require.Equal(t, expected, keeper.GetParams(ctx))
+}
+
+func TestRecordOffenceKeepsHistory(t *testing.T) {
+	ctx, _, keeper := createParamsTestInput(t)
+	keeper.SetParams(ctx, TestParams())
+
+	consAddr := sdk.ConsAddress(Pks[0].Address())
+	now := time.Unix(1000, 0)
+	keeper.SetValidatorSigningInfo(ctx, consAddr, types.NewValidatorSigningInfo(consAddr, 0, 0, now, false, 0,
+		types.Created))
+
+	window := keeper.OffenceWindow(ctx)
+	keeper.RecordOffence(ctx, consAddr, types.OffenceTypeDowntime, 1, now.Add(-2*window), sdk.ZeroDec())
+	keeper.RecordDoubleSign(ctx, consAddr, 2, now.Add(-2*window), sdk.ZeroDec())
+	keeper.RecordOffence(ctx, consAddr, types.OffenceTypeDowntime, 3, now.Add(-window/2), sdk.ZeroDec())
+
+	// the offences out of the window are kept in the history but don't count as repeat offences
+	signInfo, found := keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Len(t, signInfo.Offences, 3)
+	for i, offence := range signInfo.Offences {
+		require.Equal(t, int64(i+1), offence.Height)
+	}
+	require.Equal(t, int64(1), signInfo.CountDowntimeOffencesSince(now.Add(-window)))
+}
@@ -23,6 +23,9 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case types.QuerySigningInfos:
 			return querySigningInfos(ctx, req, k)
 
+		case types.QueryOffences:
+			return queryOffences(ctx, req, k)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -90,3 +93,29 @@ func querySigningInfos(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte
 
 	return res, nil
 }
+
+func queryOffences(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QuerySigningInfoParams
+
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, params.ConsAddress)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrNoSigningInfoFound, params.ConsAddress.String())
+	}
+
+	offences := signingInfo.Offences
+	if offences == nil {
+		offences = []types.Offence{}
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, offences)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
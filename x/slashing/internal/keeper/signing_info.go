@@ -114,6 +114,42 @@ func (k Keeper) Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
 }
 
+// ExtendJail attempts to postpone a validator's JailedUntil attribute to jailTime. A later JailedUntil is kept. It
+// will panic if the signing info does not exist for the validator.
+func (k Keeper) ExtendJail(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time) {
+	signInfo, ok := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !ok {
+		panic("cannot jail validator that does not have any signing information")
+	}
+
+	if signInfo.JailedUntil.Before(jailTime) {
+		signInfo.JailedUntil = jailTime
+		k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+	}
+}
+
+// RecordDoubleSign appends a double sign offence to the history in the signing info of a validator. It will panic
+// if the signing info does not exist for the validator.
+func (k Keeper) RecordDoubleSign(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64,
+	infractionTime time.Time, slashFraction sdk.Dec) {
+	k.RecordOffence(ctx, consAddr, types.OffenceTypeDoubleSign, infractionHeight, infractionTime, slashFraction)
+}
+
+// RecordOffence appends an offence of the given type to the history in the signing info of a validator. The offence
+// is recorded with the current JailedUntil of the validator. It will panic if the signing info does not exist for
+// the validator.
+func (k Keeper) RecordOffence(ctx sdk.Context, consAddr sdk.ConsAddress, offenceType string, infractionHeight int64,
+	infractionTime time.Time, slashFraction sdk.Dec) {
+	signInfo, ok := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !ok {
+		panic("cannot record offence of validator that does not have any signing information")
+	}
+
+	signInfo.Offences = append(signInfo.Offences, types.NewOffence(offenceType, infractionHeight,
+		infractionTime, slashFraction, signInfo.JailedUntil))
+	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+}
+
 // IsTombstoned returns if a given validator by consensus address is tombstoned.
 func (k Keeper) IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
 	signInfo, ok := k.GetValidatorSigningInfo(ctx, consAddr)
@@ -71,6 +71,20 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("downtime unblond duration must be at least 1 minute, is %s", downtimeJail.String())
 	}
 
+	if err := validateOffenceWindow(data.Params.OffenceWindow); err != nil {
+		return err
+	}
+
+	if err := validateJailEscalationFactor(data.Params.JailEscalationFactor); err != nil {
+		return err
+	}
+
+	maxDowntimeJail := data.Params.MaxDowntimeJailDuration
+	if maxDowntimeJail < downtimeJail {
+		return fmt.Errorf("max downtime jail duration must not be less than the downtime jail duration %s, is %s",
+			downtimeJail.String(), maxDowntimeJail.String())
+	}
+
 	signedWindow := data.Params.SignedBlocksWindow
 	if signedWindow < 10 {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
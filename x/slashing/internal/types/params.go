@@ -13,14 +13,15 @@ const (
 	DefaultParamspace           = ModuleName
 	DefaultSignedBlocksWindow   = int64(10000)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultOffenceWindow        = 60 * 60 * 24 * 7 * time.Second
+	DefaultMaxDowntimeJail      = 60 * 60 * 24 * 7 * time.Second
 )
 
 var (
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 2)
-	DefaultSlashFractionDoubleSign = sdk.ZeroDec()
-	DefaultSlashFractionDowntime   = sdk.ZeroDec()
-	//DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
-	//DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
+	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+	DefaultJailEscalationFactor    = sdk.NewDec(2)
 )
 
 // Parameter store keys
@@ -30,6 +31,9 @@ var (
 	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+	KeyOffenceWindow           = []byte("OffenceWindow")
+	KeyJailEscalationFactor    = []byte("JailEscalationFactor")
+	KeyMaxDowntimeJailDuration = []byte("MaxDowntimeJailDuration")
 )
 
 // ParamKeyTable for slashing module
@@ -44,12 +48,18 @@ type Params struct {
 	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
 	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"`
 	SlashFractionDowntime   sdk.Dec       `json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+	// downtime offences committed inside this window count as repeat offences
+	OffenceWindow time.Duration `json:"offence_window" yaml:"offence_window"`
+	// each repeat downtime offence multiplies the previous jail duration by this factor
+	JailEscalationFactor    sdk.Dec       `json:"jail_escalation_factor" yaml:"jail_escalation_factor"`
+	MaxDowntimeJailDuration time.Duration `json:"max_downtime_jail_duration" yaml:"max_downtime_jail_duration"`
 }
 
 // NewParams creates a new Params object
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
-	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	slashFractionDoubleSign, slashFractionDowntime sdk.Dec, offenceWindow time.Duration,
+	jailEscalationFactor sdk.Dec, maxDowntimeJailDuration time.Duration,
 ) Params {
 
 	return Params{
@@ -58,9 +68,28 @@ func NewParams(
 		DowntimeJailDuration:    downtimeJailDuration,
 		SlashFractionDoubleSign: slashFractionDoubleSign,
 		SlashFractionDowntime:   slashFractionDowntime,
+		OffenceWindow:           offenceWindow,
+		JailEscalationFactor:    jailEscalationFactor,
+		MaxDowntimeJailDuration: maxDowntimeJailDuration,
 	}
 }
 
+// EscalatedJailDuration returns the jail duration of the offences-th downtime offence committed inside the offence
+// window. The base duration is multiplied by the escalation factor for every repeat offence and capped by
+// MaxDowntimeJailDuration
+func (p Params) EscalatedJailDuration(offences int64) time.Duration {
+	duration := sdk.NewDec(int64(p.DowntimeJailDuration))
+	maxDuration := sdk.NewDec(int64(p.MaxDowntimeJailDuration))
+	for i := int64(1); i < offences && duration.LT(maxDuration); i++ {
+		duration = duration.Mul(p.JailEscalationFactor)
+	}
+
+	if duration.GT(maxDuration) {
+		duration = maxDuration
+	}
+	return time.Duration(duration.TruncateInt64())
+}
+
 // String implements the stringer interface for Params
 func (p Params) String() string {
 	return fmt.Sprintf(`Slashing Params:
@@ -68,10 +97,14 @@ func (p Params) String() string {
   MinSignedPerWindow:      %s
   DowntimeJailDuration:    %s
   SlashFractionDoubleSign: %s
-  SlashFractionDowntime:   %s`,
+  SlashFractionDowntime:   %s
+  OffenceWindow:           %s
+  JailEscalationFactor:    %s
+  MaxDowntimeJailDuration: %s`,
 		p.SignedBlocksWindow, p.MinSignedPerWindow,
 		p.DowntimeJailDuration, p.SlashFractionDoubleSign,
-		p.SlashFractionDowntime)
+		p.SlashFractionDowntime, p.OffenceWindow,
+		p.JailEscalationFactor, p.MaxDowntimeJailDuration)
 }
 
 // ParamSetPairs - Implements params.ParamSet
@@ -82,6 +115,9 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 		params.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
 		params.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		params.NewParamSetPair(KeyOffenceWindow, &p.OffenceWindow, validateOffenceWindow),
+		params.NewParamSetPair(KeyJailEscalationFactor, &p.JailEscalationFactor, validateJailEscalationFactor),
+		params.NewParamSetPair(KeyMaxDowntimeJailDuration, &p.MaxDowntimeJailDuration, validateMaxDowntimeJailDuration),
 	}
 }
 
@@ -89,7 +125,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
-		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime, DefaultOffenceWindow,
+		DefaultJailEscalationFactor, DefaultMaxDowntimeJail,
 	)
 }
 
@@ -166,3 +203,42 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateOffenceWindow(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("offence window cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateJailEscalationFactor(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.LT(sdk.OneDec()) {
+		return fmt.Errorf("jail escalation factor must be at least one: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxDowntimeJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max downtime jail duration must be positive: %s", v)
+	}
+
+	return nil
+}
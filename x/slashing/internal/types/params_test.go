@@ -0,0 +1,66 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscalatedJailDuration(t *testing.T) {
+	params := DefaultParams()
+	params.DowntimeJailDuration = 10 * time.Minute
+	params.JailEscalationFactor = sdk.NewDec(2)
+	params.MaxDowntimeJailDuration = time.Hour
+
+	tests := []struct {
+		offences int64
+		expected time.Duration
+	}{
+		{0, 10 * time.Minute},
+		{1, 10 * time.Minute},
+		{2, 20 * time.Minute},
+		{3, 40 * time.Minute},
+		{4, time.Hour},
+		{100, time.Hour},
+	}
+	for _, tc := range tests {
+		require.Equal(t, tc.expected, params.EscalatedJailDuration(tc.offences), "offences: %d", tc.offences)
+	}
+
+	// a factor of one disables the escalation
+	params.JailEscalationFactor = sdk.OneDec()
+	require.Equal(t, 10*time.Minute, params.EscalatedJailDuration(5))
+}
+
+func TestValidateGenesisEscalationParams(t *testing.T) {
+	genesis := DefaultGenesisState()
+	require.NoError(t, ValidateGenesis(genesis))
+
+	genesis.Params.JailEscalationFactor = sdk.NewDecWithPrec(5, 1)
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis = DefaultGenesisState()
+	genesis.Params.OffenceWindow = -time.Second
+	require.Error(t, ValidateGenesis(genesis))
+
+	genesis = DefaultGenesisState()
+	genesis.Params.MaxDowntimeJailDuration = genesis.Params.DowntimeJailDuration - time.Second
+	require.Error(t, ValidateGenesis(genesis))
+}
+
+func TestCountDowntimeOffencesSince(t *testing.T) {
+	now := time.Unix(1000, 0)
+	info := ValidatorSigningInfo{
+		Offences: []Offence{
+			NewOffence(OffenceTypeDowntime, 1, now.Add(-2*time.Hour), sdk.ZeroDec(), now),
+			NewOffence(OffenceTypeDowntime, 2, now.Add(-30*time.Minute), sdk.ZeroDec(), now),
+			NewOffence(OffenceTypeDoubleSign, 3, now.Add(-10*time.Minute), sdk.ZeroDec(), now),
+		},
+	}
+
+	require.Equal(t, int64(1), info.CountDowntimeOffencesSince(now.Add(-time.Hour)))
+	require.Equal(t, int64(2), info.CountDowntimeOffencesSince(now.Add(-3*time.Hour)))
+	require.Equal(t, int64(0), info.CountDowntimeOffencesSince(now))
+}
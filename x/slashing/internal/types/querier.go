@@ -11,10 +11,12 @@ const (
 	QueryParameters   = "parameters"
 	QuerySigningInfo  = "signingInfo"
 	QuerySigningInfos = "signingInfos"
+	QueryOffences     = "offences"
 )
 
 // QuerySigningInfoParams defines the params for the following queries:
 // - 'custom/slashing/signingInfo'
+// - 'custom/slashing/offences'
 type QuerySigningInfoParams struct {
 	ConsAddress sdk.ConsAddress
 }
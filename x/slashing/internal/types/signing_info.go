@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,6 +29,7 @@ type ValidatorSigningInfo struct {
 	Tombstoned          bool            `json:"tombstoned" yaml:"tombstoned"`                       // whether or not a validator has been tombstoned (killed out of validator set)
 	MissedBlocksCounter int64           `json:"missed_blocks_counter" yaml:"missed_blocks_counter"` // missed blocks counter (to avoid scanning the array every time)
 	ValidatorStatus     ValStatus       `json:"validator_status" yaml:"validator_status"`
+	Offences            []Offence       `json:"offences" yaml:"offences"` // history of the offences committed by the validator
 }
 
 // NewValidatorSigningInfo creates a new ValidatorSigningInfo instance
@@ -47,6 +49,16 @@ func NewValidatorSigningInfo(
 	}
 }
 
+// CountDowntimeOffencesSince returns the number of downtime offences committed after the given time
+func (i ValidatorSigningInfo) CountDowntimeOffencesSince(since time.Time) (count int64) {
+	for _, offence := range i.Offences {
+		if offence.Type == OffenceTypeDowntime && offence.Time.After(since) {
+			count++
+		}
+	}
+	return
+}
+
 // String implements the stringer interface for ValidatorSigningInfo
 func (i ValidatorSigningInfo) String() string {
 	return fmt.Sprintf(`Validator Signing Info:
@@ -55,7 +67,59 @@ func (i ValidatorSigningInfo) String() string {
   Index Offset:          %d
   Jailed Until:          %v
   Tombstoned:            %t
-  Missed Blocks Counter: %d`,
+  Missed Blocks Counter: %d
+  Offences:              %d`,
 		i.Address, i.StartHeight, i.IndexOffset, i.JailedUntil,
-		i.Tombstoned, i.MissedBlocksCounter)
+		i.Tombstoned, i.MissedBlocksCounter, len(i.Offences))
+}
+
+// Offence types
+const (
+	OffenceTypeDowntime   = "downtime"
+	OffenceTypeDoubleSign = "double_sign"
+)
+
+// Offence records a punished misbehavior of a validator
+type Offence struct {
+	Type          string    `json:"type" yaml:"type"`
+	Height        int64     `json:"height" yaml:"height"`
+	Time          time.Time `json:"time" yaml:"time"`
+	SlashFraction sdk.Dec   `json:"slash_fraction" yaml:"slash_fraction"`
+	JailedUntil   time.Time `json:"jailed_until" yaml:"jailed_until"`
+}
+
+// NewOffence creates a new Offence instance
+func NewOffence(offenceType string, height int64, offenceTime time.Time, slashFraction sdk.Dec,
+	jailedUntil time.Time) Offence {
+	return Offence{
+		Type:          offenceType,
+		Height:        height,
+		Time:          offenceTime,
+		SlashFraction: slashFraction,
+		JailedUntil:   jailedUntil,
+	}
+}
+
+// String implements the stringer interface for Offence
+func (o Offence) String() string {
+	return fmt.Sprintf(`Offence:
+  Type:           %s
+  Height:         %d
+  Time:           %v
+  Slash Fraction: %s
+  Jailed Until:   %v`,
+		o.Type, o.Height, o.Time, o.SlashFraction, o.JailedUntil)
+}
+
+// Offences is a collection of Offence
+type Offences []Offence
+
+// String implements the stringer interface for Offences
+func (os Offences) String() string {
+	var b strings.Builder
+	for _, o := range os {
+		b.WriteString(o.String())
+		b.WriteString("\n")
+	}
+	return strings.TrimSpace(b.String())
 }
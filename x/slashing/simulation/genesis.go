@@ -22,6 +22,9 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+	OffenceWindow           = "offence_window"
+	JailEscalationFactor    = "jail_escalation_factor"
+	MaxDowntimeJailDuration = "max_downtime_jail_duration"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -49,6 +52,21 @@ func GenSlashFractionDowntime(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenOffenceWindow randomized OffenceWindow
+func GenOffenceWindow(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 60*60, 60*60*24*30)) * time.Second
+}
+
+// GenJailEscalationFactor randomized JailEscalationFactor
+func GenJailEscalationFactor(r *rand.Rand) sdk.Dec {
+	return sdk.OneDec().Add(sdk.NewDecWithPrec(int64(r.Intn(40)), 1))
+}
+
+// GenMaxDowntimeJailDuration randomized MaxDowntimeJailDuration which is never shorter than downtimeJailDuration
+func GenMaxDowntimeJailDuration(r *rand.Rand, downtimeJailDuration time.Duration) time.Duration {
+	return downtimeJailDuration * time.Duration(simulation.RandIntBetween(r, 1, 100))
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -81,9 +99,28 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var offenceWindow time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, OffenceWindow, &offenceWindow, simState.Rand,
+		func(r *rand.Rand) { offenceWindow = GenOffenceWindow(r) },
+	)
+
+	var jailEscalationFactor sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, JailEscalationFactor, &jailEscalationFactor, simState.Rand,
+		func(r *rand.Rand) { jailEscalationFactor = GenJailEscalationFactor(r) },
+	)
+
+	var maxDowntimeJailDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxDowntimeJailDuration, &maxDowntimeJailDuration, simState.Rand,
+		func(r *rand.Rand) { maxDowntimeJailDuration = GenMaxDowntimeJailDuration(r, downtimeJailDuration) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
-		slashFractionDoubleSign, slashFractionDowntime,
+		slashFractionDoubleSign, slashFractionDowntime, offenceWindow,
+		jailEscalationFactor, maxDowntimeJailDuration,
 	)
 
 	slashingGenesis := types.NewGenesisState(params, nil, nil)
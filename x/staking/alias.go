@@ -30,6 +30,7 @@ var (
 	NewQuerier                         = keeper.NewQuerier
 	RegisterCodec                      = types.RegisterCodec
 	NewCommission                      = types.NewCommission
+	NewCommissionRates                 = types.NewCommissionRates
 	ErrNoValidatorFound                = types.ErrNoValidatorFound
 	ErrValidatorOwnerExists            = types.ErrValidatorOwnerExists
 	ErrValidatorPubKeyExists           = types.ErrValidatorPubKeyExists
@@ -46,6 +47,11 @@ var (
 	NewValidator                       = types.NewValidator
 	NewDescription                     = types.NewDescription
 	NewMsgAddShares                    = types.NewMsgAddShares
+	NewMsgAddSharesWeighted            = types.NewMsgAddSharesWeighted
+	NewMsgRegProxy                     = types.NewMsgRegProxy
+	NewMsgBindProxy                    = types.NewMsgBindProxy
+	NewMsgEditProxy                    = types.NewMsgEditProxy
+	NewProxyProfile                    = types.NewProxyProfile
 	NewGenesisState                    = types.NewGenesisState
 	DelegatorAddSharesInvariant        = keeper.DelegatorAddSharesInvariant
 
@@ -66,4 +72,7 @@ type (
 	UndelegationInfo          = types.UndelegationInfo
 	ProxyDelegatorKeyExported = types.ProxyDelegatorKeyExported
 	SharesResponses           = types.SharesResponses
+	ProxyProfile              = types.ProxyProfile
+	ProxyRanks                = types.ProxyRanks
+	ValidatorSetPreview       = types.ValidatorSetPreview
 )
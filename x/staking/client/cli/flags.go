@@ -15,12 +15,16 @@ const (
 	FlagWebsite  = "website"
 	FlagDetails  = "details"
 
-	//FlagCommissionRate          = "commission-rate"
-	//FlagCommissionMaxRate       = "commission-max-rate"
-	//FlagCommissionMaxChangeRate = "commission-max-change-rate"
+	FlagCommissionRate          = "commission-rate"
+	FlagCommissionMaxRate       = "commission-max-rate"
+	FlagCommissionMaxChangeRate = "commission-max-change-rate"
 
 	//FlagMinSelfDelegation = "min-self-delegation"
 
+	FlagProxyFeeRate = "fee-rate"
+	FlagVotingPolicy = "voting-policy"
+	FlagSortBy       = "sort-by"
+
 	FlagNodeID = "node-id"
 	FlagIP     = "ip"
 )
@@ -29,10 +33,11 @@ const (
 var (
 	FsPk                = flag.NewFlagSet("", flag.ContinueOnError)
 	fsDescriptionCreate = flag.NewFlagSet("", flag.ContinueOnError)
-	//FsCommissionCreate  = flag.NewFlagSet("", flag.ContinueOnError)
-	//fsCommissionUpdate  = flag.NewFlagSet("", flag.ContinueOnError)
+	FsCommissionCreate  = flag.NewFlagSet("", flag.ContinueOnError)
+	fsCommissionUpdate  = flag.NewFlagSet("", flag.ContinueOnError)
 	//FsMinSelfDelegation = flag.NewFlagSet("", flag.ContinueOnError)
 	fsDescriptionEdit = flag.NewFlagSet("", flag.ContinueOnError)
+	fsProxyEdit       = flag.NewFlagSet("", flag.ContinueOnError)
 )
 
 func init() {
@@ -41,10 +46,10 @@ func init() {
 	fsDescriptionCreate.String(FlagIdentity, "", "The optional identity signature (ex. UPort or Keybase)")
 	fsDescriptionCreate.String(FlagWebsite, "", "The validator's (optional) website")
 	fsDescriptionCreate.String(FlagDetails, "", "The validator's (optional) details")
-	//fsCommissionUpdate.String(FlagCommissionRate, "", "The new commission rate percentage")
-	//FsCommissionCreate.String(FlagCommissionRate, "", "The initial commission rate percentage")
-	//FsCommissionCreate.String(FlagCommissionMaxRate, "", "The maximum commission rate percentage")
-	//FsCommissionCreate.String(FlagCommissionMaxChangeRate, "", "The maximum commission change rate percentage (per day)")
+	fsCommissionUpdate.String(FlagCommissionRate, "", "The new commission rate percentage")
+	FsCommissionCreate.String(FlagCommissionRate, "", "The initial commission rate percentage")
+	FsCommissionCreate.String(FlagCommissionMaxRate, "", "The maximum commission rate percentage")
+	FsCommissionCreate.String(FlagCommissionMaxChangeRate, "", "The maximum commission change rate percentage (per day)")
 	//FsMinSelfDelegation.String(FlagMinSelfDelegation, fmt.Sprintf("0.001%s", sdk.DefaultBondDenom),
 	//	"The minimum self delegation required on the validator")
 	fsDescriptionEdit.String(FlagMoniker, types.DoNotModifyDesc, "The validator's name")
@@ -52,4 +57,10 @@ func init() {
 		"The (optional) identity signature (ex. UPort or Keybase)")
 	fsDescriptionEdit.String(FlagWebsite, types.DoNotModifyDesc, "The validator's (optional) website")
 	fsDescriptionEdit.String(FlagDetails, types.DoNotModifyDesc, "The validator's (optional) details")
+	fsProxyEdit.String(FlagMoniker, types.DoNotModifyDesc, "The proxy's name")
+	fsProxyEdit.String(FlagIdentity, types.DoNotModifyDesc, "The (optional) identity signature (ex. UPort or Keybase)")
+	fsProxyEdit.String(FlagWebsite, types.DoNotModifyDesc, "The proxy's (optional) website")
+	fsProxyEdit.String(FlagDetails, types.DoNotModifyDesc, "The proxy's (optional) details")
+	fsProxyEdit.String(FlagProxyFeeRate, "", "The new fee rate taken from the rewards of the bound delegators")
+	fsProxyEdit.String(FlagVotingPolicy, "", "The new voting policy of the proxy")
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -32,7 +33,10 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryValidator(queryRoute, cdc),
 		GetCmdQueryValidators(queryRoute, cdc),
 		GetCmdQueryProxy(queryRoute, cdc),
+		GetCmdQueryProxyProfile(queryRoute, cdc),
+		GetCmdQueryProxyRanks(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
+		GetCmdQueryValidatorSetPreview(queryRoute, cdc),
 		GetCmdQueryPool(queryRoute, cdc))...)
 
 	return stakingQueryCmd
@@ -175,6 +179,38 @@ $ %s query staking params
 	}
 }
 
+// GetCmdQueryValidatorSetPreview gets the validator set preview query command.
+func GetCmdQueryValidatorSetPreview(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validator-set-preview",
+		Args:  cobra.NoArgs,
+		Short: "query the validator set changes at the end of the current epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Simulate the election at the end of the current epoch from the current shares, and show the
+validators entering and leaving the validator set and the power changes.
+
+Example:
+$ %s query staking validator-set-preview
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryValidatorSetPreview)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var preview types.ValidatorSetPreview
+			cdc.MustUnmarshalJSON(bz, &preview)
+			return cliCtx.PrintOutput(preview)
+		},
+	}
+}
+
 // GetCmdQueryProxy gets command for querying the delegators by a specific proxy
 func GetCmdQueryProxy(storeName string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -218,6 +254,99 @@ $ %s query staking proxy okexchain1hw4r48aww06ldrfeuq2v438ujnl6alsz0685a0
 	}
 }
 
+// GetCmdQueryProxyProfile gets command for querying the profile of a proxy
+func GetCmdQueryProxyProfile(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "proxy-profile [address]",
+		Short: "query the description, fee rate, voting policy and vote participation of a proxy",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the description, fee rate, voting policy and vote participation of a specific proxy
+
+Example:
+$ %s query staking proxy-profile okexchain1hw4r48aww06ldrfeuq2v438ujnl6alsz0685a0
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			proxyAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid address：%s", args[0])
+			}
+
+			bytes, err := cdc.MarshalJSON(types.NewQueryDelegatorParams(proxyAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryProxyProfile)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var profile types.ProxyProfile
+			if err := cdc.UnmarshalJSON(resp, &profile); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(profile)
+		},
+	}
+}
+
+// GetCmdQueryProxyRanks gets command for querying the proxies ranked by the total delegated tokens or the vote
+// participation
+func GetCmdQueryProxyRanks(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proxy-ranks",
+		Short: "query the proxies ranked by the total delegated tokens or the vote participation",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the proxies ranked by the total tokens delegated by the bound delegators (%s) or by the
+fraction of the ended proposals they voted on (%s).
+
+Example:
+$ %s query staking proxy-ranks --sort-by %s --page 1 --limit 10
+`,
+				types.ProxyRankByDelegatedTokens, types.ProxyRankByParticipation, version.ClientName,
+				types.ProxyRankByParticipation,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryProxyRanksParams(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit),
+				viper.GetString(FlagSortBy))
+			bytes, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryProxyRanks)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var ranks types.ProxyRanks
+			if err := cdc.UnmarshalJSON(resp, &ranks); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(ranks)
+		},
+	}
+
+	cmd.Flags().String(FlagSortBy, types.ProxyRankByDelegatedTokens,
+		fmt.Sprintf("rank by %s or %s", types.ProxyRankByDelegatedTokens, types.ProxyRankByParticipation))
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of proxies to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of proxies to query for")
+	return cmd
+}
+
 // Delegators is a type alias of sdk.AccAddress slice
 type Delegators []sdk.AccAddress
 
@@ -286,6 +415,7 @@ type DelegatorResponse struct {
 	DelegatorAddress     sdk.AccAddress   `json:"delegator_address" yaml:"delegator_address"`
 	ValidatorAddresses   []sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
 	Shares               sdk.Dec          `json:"shares" yaml:"shares"`
+	ValidatorShares      []sdk.Dec        `json:"validator_shares" yaml:"validator_shares"`
 	Tokens               sdk.Dec          `json:"tokens" yaml:"tokens"`
 	UnbondedTokens       sdk.Dec          `json:"unbonded_tokens" yaml:"unbonded_tokens"`
 	CompletionTime       time.Time        `json:"completion_time" yaml:"completion_time"`
@@ -297,10 +427,15 @@ type DelegatorResponse struct {
 // String returns a human readable string representation of DelegatorResponse
 func (dr DelegatorResponse) String() (output string) {
 	n := len(dr.ValidatorAddresses)
-	if n > 0 {
-		output = fmt.Sprintf("%s\n", dr.ValidatorAddresses[0].String())
-		for i := 1; i < n; i++ {
-			output = fmt.Sprintf("%s						%s\n", output, dr.ValidatorAddresses[i].String())
+	for i := 0; i < n; i++ {
+		valAddr := dr.ValidatorAddresses[i].String()
+		if i < len(dr.ValidatorShares) {
+			valAddr = fmt.Sprintf("%s (shares: %s)", valAddr, dr.ValidatorShares[i])
+		}
+		if i == 0 {
+			output = fmt.Sprintf("%s\n", valAddr)
+		} else {
+			output = fmt.Sprintf("%s						%s\n", output, valAddr)
 		}
 	}
 
@@ -334,6 +469,7 @@ func convertToDelegatorResp(delegator types.Delegator, undelegation types.Undele
 		delegator.DelegatorAddress,
 		delegator.ValidatorAddresses,
 		delegator.Shares,
+		delegator.ValidatorShares,
 		delegator.Tokens,
 		undelegation.Quantity,
 		undelegation.CompletionTime,
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/okex/okexchain/x/staking/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // GetCmdDestroyValidator gets command for destroying a validator and unbonding the min-self-delegation
@@ -144,6 +145,37 @@ func GetCmdAddShares(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdAddSharesWeighted gets command for multi voting with the shares allocated by weights
+func GetCmdAddSharesWeighted(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "add-shares-weighted [validator-addr1:weight1, validator-addr2:weight2, ... validator-addrN:weightN] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: fmt.Sprintf("add shares to one or more validators by all deposited %s allocated by weights", sdk.DefaultBondDenom),
+		Long: strings.TrimSpace(
+			fmt.Sprintf("Add shares to one or more validators by all deposited %s, which are allocated to the validators by "+
+				"the weights adding up to 1. The shares added to the validators sum up to the total shares of the delegator, "+
+				"while add-shares adds the full shares to each validator.\n\nExample:\n$ %s tx staking add-shares-weighted "+
+				"okexchainvaloper1alq9na49n9yycysh889rl90g9nhe58lcqkfpfg:0.7,"+
+				"okexchainvaloper1svzxp4ts5le2s4zugx34ajt6shz2hg42dnwst5:0.3 --from mykey\n",
+				sdk.DefaultBondDenom, version.ClientName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			delAddr := cliCtx.GetFromAddress()
+			valAddrs, weights, err := getWeightedValsSet(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddSharesWeighted(delAddr, valAddrs, weights)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdProxy gets subcommands for proxy voting
 func GetCmdProxy(cdc *codec.Codec) *cobra.Command {
 
@@ -156,6 +188,7 @@ func GetCmdProxy(cdc *codec.Codec) *cobra.Command {
 		flags.PostCommands(
 			GetCmdRegProxy(cdc),
 			GetCmdUnregProxy(cdc),
+			GetCmdEditProxy(cdc),
 			GetCmdBindProxy(cdc),
 			GetCmdUnbindProxy(cdc),
 		)...)
@@ -217,6 +250,58 @@ $ %s tx staking proxy unreg --from mykey
 	}
 }
 
+// GetCmdEditProxy gets command for editing the profile of a proxy
+func GetCmdEditProxy(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "edit the description, fee rate and voting policy of the proxy",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Edit the description, the fee rate taken from the rewards of the bound delegators and the
+voting policy of the proxy. The fee rate can be changed at most once in 24 hours.
+
+Example:
+$ %s tx staking proxy edit --moniker "my proxy" --fee-rate 0.05 --voting-policy "vote yes on upgrades" --from mykey
+`,
+				version.ClientName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(auth.DefaultTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proxyAddr := cliCtx.GetFromAddress()
+			description := types.Description{
+				Moniker:  viper.GetString(FlagMoniker),
+				Identity: viper.GetString(FlagIdentity),
+				Website:  viper.GetString(FlagWebsite),
+				Details:  viper.GetString(FlagDetails),
+			}
+
+			var feeRate *sdk.Dec
+			if feeRateStr := viper.GetString(FlagProxyFeeRate); feeRateStr != "" {
+				rate, err := sdk.NewDecFromStr(feeRateStr)
+				if err != nil {
+					return fmt.Errorf("invalid new fee rate: %v", err)
+				}
+				feeRate = &rate
+			}
+
+			var votingPolicy *string
+			if cmd.Flags().Changed(FlagVotingPolicy) {
+				policy := viper.GetString(FlagVotingPolicy)
+				votingPolicy = &policy
+			}
+
+			msg := types.NewMsgEditProxy(proxyAddr, description, feeRate, votingPolicy)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().AddFlagSet(fsProxyEdit)
+	return cmd
+}
+
 // GetCmdBindProxy gets command for binding proxy
 func GetCmdBindProxy(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -288,3 +373,24 @@ func getValsSet(address string) (valAddrs []sdk.ValAddress, err error) {
 	}
 	return
 }
+
+func getWeightedValsSet(arg string) (valAddrs []sdk.ValAddress, weights []sdk.Dec, err error) {
+	pairs := strings.Split(strings.TrimSpace(arg), ",")
+	lenVals := len(pairs)
+	valAddrs, weights = make([]sdk.ValAddress, lenVals), make([]sdk.Dec, lenVals)
+	for i := 0; i < lenVals; i++ {
+		pair := strings.Split(pairs[i], ":")
+		if len(pair) != 2 {
+			return nil, nil, fmt.Errorf("invalid target validator with weight: %s", pairs[i])
+		}
+		valAddrs[i], err = sdk.ValAddressFromBech32(strings.TrimSpace(pair[0]))
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid target validator address: %s", pair[0])
+		}
+		weights[i], err = sdk.NewDecFromStr(strings.TrimSpace(pair[1]))
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid weight of validator %s: %s", pair[0], pair[1])
+		}
+	}
+	return
+}
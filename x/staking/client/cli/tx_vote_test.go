@@ -41,6 +41,21 @@ func TestGetValsSet(t *testing.T) {
 	require.Equal(t, expectedValAddrs, valAddrs)
 }
 
+func TestGetWeightedValsSet(t *testing.T) {
+	valAddr1 := sdk.ValAddress(newPubKey("0000000000000000000000000000000000000000000000000000000000000000").Address())
+	valAddr2 := sdk.ValAddress(newPubKey("1111111111111111111111111111111111111111111111111111111111111111").Address())
+
+	valAddrs, weights, err := getWeightedValsSet(valAddr1.String() + ":0.7," + valAddr2.String() + ":0.3")
+	require.NoError(t, err)
+	require.Equal(t, []sdk.ValAddress{valAddr1, valAddr2}, valAddrs)
+	require.Equal(t, []sdk.Dec{sdk.NewDecWithPrec(7, 1), sdk.NewDecWithPrec(3, 1)}, weights)
+
+	_, _, err = getWeightedValsSet(valAddr1.String())
+	require.Error(t, err)
+	_, _, err = getWeightedValsSet(valAddr1.String() + ":weight")
+	require.Error(t, err)
+}
+
 func newPubKey(pubKey string) (res crypto.PubKey) {
 	pubKeyBytes, err := hex.DecodeString(pubKey)
 	if err != nil {
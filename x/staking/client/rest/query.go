@@ -32,6 +32,18 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		delegatorProxyHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// query the profile of a proxy
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/proxy_profile",
+		proxyProfileHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// get all proxies ranked by the total delegated tokens or the vote participation
+	r.HandleFunc(
+		"/staking/proxies",
+		proxyRanksHandlerFn(cliCtx),
+	).Methods("GET")
+
 	// query the all shares on a validator
 	r.HandleFunc(
 		"/staking/validators/{validatorAddr}/shares",
@@ -62,6 +74,12 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		paramsHandlerFn(cliCtx),
 	).Methods("GET")
 
+	// preview the validator set changes at the end of the current epoch
+	r.HandleFunc(
+		"/staking/validator_set_preview",
+		validatorSetPreviewHandlerFn(cliCtx),
+	).Methods("GET")
+
 	// get the current staking address values
 	r.HandleFunc(
 		"/staking/address",
@@ -87,6 +105,49 @@ func delegatorProxyHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return queryDelegator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProxy))
 }
 
+// HTTP request handler to query the profile of a proxy
+func proxyProfileHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return queryDelegator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProxyProfile))
+}
+
+// HTTP request handler to query the proxies ranked by the total delegated tokens or the vote participation
+func proxyRanksHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeArgsWithLimit, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		sortBy := r.FormValue("sort_by")
+		if sortBy == "" {
+			sortBy = types.ProxyRankByDelegatedTokens
+		}
+
+		params := types.NewQueryProxyRanksParams(page, limit, sortBy)
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeMarshalJSONFailed, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProxyRanks)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, uint32(common.ErrorABCIQueryFails), err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 // HTTP request handler to query the info of delegator's unbonding delegation
 func delegatorUnbondingDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return queryDelegator(cliCtx, fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryUnbondingDelegation))
@@ -183,6 +244,26 @@ func paramsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// HTTP request handler to preview the validator set changes at the end of the current epoch
+func validatorSetPreviewHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSetPreview)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			common.HandleErrorResponseV2(w, http.StatusInternalServerError, common.ErrorABCIQueryFails)
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func addressHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
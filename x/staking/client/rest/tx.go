@@ -22,6 +22,18 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/staking/delegators/{delegatorAddr}/unbonding_delegations",
 		postUnbondingDelegationsHandlerFn(cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		"/staking/validators",
+		postCreateValidatorHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/staking/validators/{validatorAddr}",
+		putEditValidatorHandlerFn(cliCtx),
+	).Methods("PUT")
+	r.HandleFunc(
+		"/staking/delegators/{delegatorAddr}/proxy_profile",
+		putEditProxyHandlerFn(cliCtx),
+	).Methods("PUT")
 }
 
 type (
@@ -40,8 +52,114 @@ type (
 		ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"` // in bech32
 		Amount           sdk.SysCoin    `json:"amount" yaml:"amount"`
 	}
+
+	// CreateValidatorRequest defines the properties of a create-validator request's body.
+	CreateValidatorRequest struct {
+		BaseReq          rest.BaseReq          `json:"base_req" yaml:"base_req"`
+		ValidatorAddress sdk.ValAddress        `json:"validator_address" yaml:"validator_address"` // in bech32
+		PubKey           string                `json:"pubkey" yaml:"pubkey"`                       // in bech32
+		Description      types.Description     `json:"description" yaml:"description"`
+		Commission       types.CommissionRates `json:"commission" yaml:"commission"`
+	}
+
+	// EditValidatorRequest defines the properties of an edit-validator request's body.
+	EditValidatorRequest struct {
+		BaseReq        rest.BaseReq      `json:"base_req" yaml:"base_req"`
+		Description    types.Description `json:"description" yaml:"description"`
+		CommissionRate *sdk.Dec          `json:"commission_rate" yaml:"commission_rate"`
+	}
+
+	// EditProxyRequest defines the properties of an edit-proxy request's body.
+	EditProxyRequest struct {
+		BaseReq      rest.BaseReq      `json:"base_req" yaml:"base_req"`
+		Description  types.Description `json:"description" yaml:"description"`
+		FeeRate      *sdk.Dec          `json:"fee_rate" yaml:"fee_rate"`
+		VotingPolicy *string           `json:"voting_policy" yaml:"voting_policy"`
+	}
 )
 
+func postCreateValidatorHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateValidatorRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		pubKey, err := types.GetConsPubKeyBech32(req.PubKey)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, types.CodeGetConsPubKeyBech32Failed, err.Error())
+			return
+		}
+
+		minSelfDelegation := sdk.NewDecCoinFromDec(common.NativeToken, types.DefaultMinSelfDelegation)
+		msg := types.NewMsgCreateValidator(req.ValidatorAddress, pubKey, req.Description, req.Commission,
+			minSelfDelegation)
+		if err := msg.ValidateBasic(); err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeInvalidParam, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeCreateAddrFromBech32Failed, err.Error())
+			return
+		}
+
+		if !bytes.Equal(fromAddr, req.ValidatorAddress) {
+			common.HandleErrorMsg(w, cliCtx, types.CodeAddressNotEqual, "must use own validator address")
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func putEditValidatorHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req EditValidatorRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(mux.Vars(r)["validatorAddr"])
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeCreateAddrFromBech32Failed, err.Error())
+			return
+		}
+
+		msg := types.NewMsgEditValidator(valAddr, req.Description, req.CommissionRate)
+		if err := msg.ValidateBasic(); err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeInvalidParam, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeCreateAddrFromBech32Failed, err.Error())
+			return
+		}
+
+		if !bytes.Equal(fromAddr, valAddr) {
+			common.HandleErrorMsg(w, cliCtx, types.CodeAddressNotEqual, "must use own validator address")
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
 func postDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req DelegateRequest
@@ -109,3 +227,43 @@ func postUnbondingDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFu
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func putEditProxyHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req EditProxyRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		proxyAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["delegatorAddr"])
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeCreateAddrFromBech32Failed, err.Error())
+			return
+		}
+
+		msg := types.NewMsgEditProxy(proxyAddr, req.Description, req.FeeRate, req.VotingPolicy)
+		if err := msg.ValidateBasic(); err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeInvalidParam, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, common.CodeCreateAddrFromBech32Failed, err.Error())
+			return
+		}
+
+		if !bytes.Equal(fromAddr, proxyAddr) {
+			common.HandleErrorMsg(w, cliCtx, types.CodeAddressNotEqual, "must use own proxy address")
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
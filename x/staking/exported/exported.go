@@ -9,6 +9,7 @@ import (
 type DelegatorI interface {
 	GetShareAddedValidatorAddresses() []sdk.ValAddress
 	GetLastAddedShares() sdk.Dec
+	GetSharesAddedTo(valAddr sdk.ValAddress) sdk.Dec
 }
 
 // ValidatorI expected validator functions
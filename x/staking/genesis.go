@@ -35,6 +35,8 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 	for _, delegator := range data.Delegators {
 		initDelegator(ctx, delegator, keeper, &bondedTokens)
 	}
+	// the proxies are indexed as the delegators are set, so the index is marked as built for good
+	keeper.BuildProxyIndex(ctx)
 
 	for _, ubd := range data.UnbondingDelegations {
 		initUnbondingDelegation(ctx, ubd, keeper, &notBondedTokens)
@@ -45,6 +47,9 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 	for _, proxyDelegatorKeyExported := range data.ProxyDelegatorKeys {
 		keeper.SetProxyBinding(ctx, proxyDelegatorKeyExported.ProxyAddr, proxyDelegatorKeyExported.DelAddr, false)
 	}
+	for _, profile := range data.ProxyProfiles {
+		keeper.SetProxyProfile(ctx, profile)
+	}
 
 	checkPools(ctx, keeper, sdk.NewDecCoinFromDec(data.Params.BondDenom, bondedTokens),
 		sdk.NewDecCoinFromDec(data.Params.BondDenom, notBondedTokens), data.Exported)
@@ -164,6 +169,12 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		return false
 	})
 
+	var proxyProfiles []types.ProxyProfile
+	keeper.IterateProxyProfiles(ctx, func(_ int64, profile types.ProxyProfile) (stop bool) {
+		proxyProfiles = append(proxyProfiles, profile)
+		return false
+	})
+
 	return types.GenesisState{
 		Params:               params,
 		LastTotalPower:       lastTotalPower,
@@ -173,6 +184,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		UnbondingDelegations: undelegationInfos,
 		AllShares:            sharesExportedSlice,
 		ProxyDelegatorKeys:   proxyDelegatorKeys,
+		ProxyProfiles:        proxyProfiles,
 		Exported:             true,
 	}
 }
@@ -199,9 +211,27 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err != nil {
 		return err
 	}
+	if err = validateGenesisStateProxyProfiles(data.ProxyProfiles); err != nil {
+		return err
+	}
 	return data.Params.Validate()
 }
 
+func validateGenesisStateProxyProfiles(profiles []types.ProxyProfile) error {
+	for _, profile := range profiles {
+		if profile.ProxyAddress.Empty() {
+			return fmt.Errorf("empty proxy address in proxy profile")
+		}
+		if err := types.ValidateProxyFeeRate(profile.FeeRate); err != nil {
+			return fmt.Errorf("invalid fee rate of proxy %s: %s", profile.ProxyAddress, err)
+		}
+		if len(profile.VotingPolicy) > types.MaxVotingPolicyLength {
+			return fmt.Errorf("voting policy of proxy %s is too long", profile.ProxyAddress)
+		}
+	}
+	return nil
+}
+
 func validateGenesisStateValidators(valsExported []types.ValidatorExported) (err error) {
 	valsLen := len(valsExported)
 	addrMap := make(map[string]bool, valsLen)
@@ -2,6 +2,7 @@ package staking
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/okex/okexchain/x/staking/keeper"
@@ -26,12 +27,16 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgWithdraw(ctx, msg, k)
 		case types.MsgAddShares:
 			return handleMsgAddShares(ctx, msg, k)
+		case types.MsgAddSharesWeighted:
+			return handleMsgAddSharesWeighted(ctx, msg, k)
 		case types.MsgBindProxy:
 			return handleMsgBindProxy(ctx, msg, k)
 		case types.MsgUnbindProxy:
 			return handleMsgUnbindProxy(ctx, msg, k)
 		case types.MsgRegProxy:
 			return handleRegProxy(ctx, msg, k)
+		case types.MsgEditProxy:
+			return handleMsgEditProxy(ctx, msg, k)
 		case types.MsgDestroyValidator:
 			return handleMsgDestroyValidator(ctx, msg, k)
 		default:
@@ -54,15 +59,43 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 		//ctx.Logger().Debug("validatorUpdates epoch", "old", oldEpoch, "new", newEpoch)
 		//ctx.Logger().Debug(fmt.Sprintf("old epoch end blockHeight: %d", lastEpochEndHeight))
 
+		// the preview right before the election shows exactly the validators entering and leaving
+		preview := k.PreviewValidatorSetUpdates(ctx)
 		validatorUpdates = k.ApplyAndReturnValidatorSetUpdates(ctx)
 		// dont forget to delete in case that some validator need to kick out when an epoch ends
 		k.DeleteAbandonedValidatorAddrs(ctx)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeNewEpoch,
+				sdk.NewAttribute(types.AttributeKeyOldEpoch, fmt.Sprintf("%d", oldEpoch)),
+				sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprintf("%d", newEpoch)),
+				sdk.NewAttribute(types.AttributeKeyEndOfEpoch, fmt.Sprintf("%d", k.GetTheEndOfEpoch(ctx))),
+				sdk.NewAttribute(types.AttributeKeyValidatorUpdates, fmt.Sprintf("%d", len(validatorUpdates))),
+				sdk.NewAttribute(types.AttributeKeyEnteringValidators, joinOperators(preview.Entering())),
+				sdk.NewAttribute(types.AttributeKeyLeavingValidators, joinOperators(preview.Leaving())),
+			),
+		)
 	} else if k.IsKickedOut(ctx) {
 		// if there are some validators to kick out in an epoch
 		validatorUpdates = k.KickOutAndReturnValidatorSetUpdates(ctx)
 		k.DeleteAbandonedValidatorAddrs(ctx)
 	}
 
+	// warn the monitors in advance of the validator set changes at the end of the epoch
+	if k.GetTheEndOfEpoch(ctx)-ctx.BlockHeight() == types.EpochNoticeBlocks {
+		preview := k.PreviewValidatorSetUpdates(ctx)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeEpochNotice,
+				sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprintf("%d", preview.Epoch)),
+				sdk.NewAttribute(types.AttributeKeyEndOfEpoch, fmt.Sprintf("%d", preview.EndOfEpoch)),
+				sdk.NewAttribute(types.AttributeKeyEnteringValidators, joinOperators(preview.Entering())),
+				sdk.NewAttribute(types.AttributeKeyLeavingValidators, joinOperators(preview.Leaving())),
+			),
+		)
+	}
+
 	// Unbond all mature validators from the unbonding queue.
 	k.UnbondAllMatureValidatorQueue(ctx)
 
@@ -89,6 +122,15 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	return validatorUpdates
 }
 
+// joinOperators joins the operator addresses of the validator power changes with commas
+func joinOperators(changes []types.ValidatorPowerChange) string {
+	operators := make([]string, len(changes))
+	for i, change := range changes {
+		operators[i] = change.OperatorAddress.String()
+	}
+	return strings.Join(operators, ",")
+}
+
 // StringInSlice returns true if a is found the list.
 func StringInSlice(a string, list []string) bool {
 	for _, b := range list {
@@ -124,7 +166,8 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 
 	minSelfDelegation := k.ParamsMinSelfDelegation(ctx)
 	validator := NewValidator(msg.ValidatorAddress, msg.PubKey, msg.Description, minSelfDelegation)
-	commission := NewCommission(sdk.NewDec(1), sdk.NewDec(1), sdk.NewDec(0))
+	commission := types.NewCommissionWithTime(msg.Commission.Rate, msg.Commission.MaxRate,
+		msg.Commission.MaxChangeRate, ctx.BlockHeader().Time)
 	validator, err := validator.SetInitialCommission(commission)
 	if err != nil {
 		return nil, err
@@ -156,18 +199,33 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 		return nil, ErrNoValidatorFound(msg.ValidatorAddress.String())
 	}
 
-	// replace all editable fields (clients should autofill existing values)
-	description, err := validator.Description.UpdateDescription(msg.Description)
-	if err != nil {
-		return nil, err
+	// replace all editable fields (clients should autofill existing values), and the description is kept when only
+	// the commission rate is edited
+	if msg.Description != (types.Description{}) {
+		description, err := validator.Description.UpdateDescription(msg.Description)
+		if err != nil {
+			return nil, err
+		}
+
+		validator.Description = description
 	}
 
-	validator.Description = description
+	if msg.CommissionRate != nil {
+		commission, err := k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
+		if err != nil {
+			return nil, err
+		}
+
+		// call the before-modification hook since we're about to update the commission
+		k.BeforeValidatorModified(ctx, msg.ValidatorAddress)
+		validator.Commission = commission
+	}
 
 	k.SetValidator(ctx, validator)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(types.EventTypeEditValidator,
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, validator.Commission.Rate.String()),
 			sdk.NewAttribute(types.AttributeKeyMinSelfDelegation, validator.MinSelfDelegation.String()),
 		),
 		sdk.NewEvent(sdk.EventTypeMessage,
@@ -116,6 +116,7 @@ func regProxy(ctx sdk.Context, proxyAddr sdk.AccAddress, k keeper.Keeper) (*sdk.
 
 	proxy.RegProxy(true)
 	k.SetDelegator(ctx, proxy)
+	k.SetProxyProfile(ctx, types.NewProxyProfile(proxyAddr))
 
 	if k.UpdateShares(ctx, proxy.DelegatorAddress, proxy.Tokens) != nil {
 		return types.ErrInvalidDelegation(proxy.DelegatorAddress.String()).Result()
@@ -141,6 +142,7 @@ func unregProxy(ctx sdk.Context, proxyAddr sdk.AccAddress, k keeper.Keeper) (*sd
 	proxy.TotalDelegatedTokens = sdk.ZeroDec()
 	k.ClearProxy(ctx, proxy.DelegatorAddress)
 	k.SetDelegator(ctx, proxy)
+	k.DeleteProxyProfile(ctx, proxy.DelegatorAddress)
 
 	if k.UpdateShares(ctx, proxy.DelegatorAddress, proxy.Tokens) != nil {
 		return types.ErrInvalidDelegation(proxy.DelegatorAddress.String()).Result()
@@ -158,18 +160,69 @@ func handleRegProxy(ctx sdk.Context, msg types.MsgRegProxy, k keeper.Keeper) (*s
 	return unregProxy(ctx, msg.ProxyAddress, k)
 }
 
+func handleMsgEditProxy(ctx sdk.Context, msg types.MsgEditProxy, k keeper.Keeper) (*sdk.Result, error) {
+	// proxy must already be registered
+	proxy, found := k.GetDelegator(ctx, msg.ProxyAddress)
+	if !found || !proxy.IsProxy {
+		return types.ErrProxyNotFound(msg.ProxyAddress.String()).Result()
+	}
+
+	profile := k.GetProxyProfileOrDefault(ctx, msg.ProxyAddress)
+	if msg.Description != (types.Description{}) {
+		description, err := profile.Description.UpdateDescription(msg.Description)
+		if err != nil {
+			return nil, err
+		}
+
+		profile.Description = description
+	}
+
+	if msg.FeeRate != nil {
+		var err error
+		if profile, err = k.UpdateProxyFeeRate(ctx, profile, *msg.FeeRate); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.VotingPolicy != nil {
+		profile.VotingPolicy = *msg.VotingPolicy
+	}
+
+	k.SetProxyProfile(ctx, profile)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeEditProxy,
+			sdk.NewAttribute(types.AttributeKeyProxy, msg.ProxyAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyProxyFeeRate, profile.FeeRate.String()),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func handleMsgAddShares(ctx sdk.Context, msg types.MsgAddShares, k keeper.Keeper) (*sdk.Result, error) {
+	return addShares(ctx, msg.DelAddr, msg.ValAddrs, nil, k)
+}
+
+func handleMsgAddSharesWeighted(ctx sdk.Context, msg types.MsgAddSharesWeighted, k keeper.Keeper) (*sdk.Result,
+	error) {
+	return addShares(ctx, msg.DelAddr, msg.ValAddrs, msg.Weights, k)
+}
+
+// addShares replaces the shares added last time with the shares allocated to the validators by the weights, and the
+// full shares are added to each validator when the weights are empty
+func addShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddrs []sdk.ValAddress, weights []sdk.Dec,
+	k keeper.Keeper) (*sdk.Result, error) {
 	maxValsToAddShares := int(k.ParamsMaxValsToAddShares(ctx))
-	if len(msg.ValAddrs) == 0 {
+	if len(valAddrs) == 0 {
 		return nil, types.ErrEmptyValidators()
-	} else if len(msg.ValAddrs) > maxValsToAddShares {
+	} else if len(valAddrs) > maxValsToAddShares {
 		return types.ErrExceedValidatorAddrs(maxValsToAddShares).Result()
 	}
 
 	// 0. check whether the delegator has delegation
-	delegator, found := k.GetDelegator(ctx, msg.DelAddr)
+	delegator, found := k.GetDelegator(ctx, delAddr)
 	if !found || delegator.Tokens.IsZero() {
-		return types.ErrNoDelegationToAddShares(msg.DelAddr.String()).Result()
+		return types.ErrNoDelegationToAddShares(delAddr.String()).Result()
 	}
 	if delegator.HasProxy() {
 		return types.ErrAddSharesDuringProxy(delegator.DelegatorAddress.String(),
@@ -177,13 +230,13 @@ func handleMsgAddShares(ctx sdk.Context, msg types.MsgAddShares, k keeper.Keeper
 	}
 
 	// 1. get last validators which were added shares to and existing in the store
-	lastVals, lastShares := k.GetLastValsAddedSharesExisted(ctx, msg.DelAddr)
+	lastVals, lastShares := k.GetLastValsAddedSharesExisted(ctx, delAddr)
 
 	// 2. withdraw the shares last time
-	k.WithdrawLastShares(ctx, msg.DelAddr, lastVals, lastShares)
+	k.WithdrawLastShares(ctx, delAddr, lastVals, lastShares)
 
 	// 3. get validators to add shares this time (if the validator doesn't exist, return error)
-	vals, sdkErr := k.GetValidatorsToAddShares(ctx, msg.ValAddrs)
+	vals, sdkErr := k.GetValidatorsToAddShares(ctx, valAddrs)
 	if sdkErr != nil {
 		return nil, sdkErr
 	}
@@ -195,14 +248,15 @@ func handleMsgAddShares(ctx sdk.Context, msg types.MsgAddShares, k keeper.Keeper
 	totalTokens := delegator.Tokens.Add(delegator.TotalDelegatedTokens)
 
 	// 5. add shares to the vals this time
-	shares, sdkErr := k.AddSharesToValidators(ctx, msg.DelAddr, vals, totalTokens)
+	shares, sdkErr := k.AddWeightedSharesToValidators(ctx, delAddr, vals, weights, totalTokens)
 	if sdkErr != nil {
 		return nil, sdkErr
 	}
 
 	// 6. update the delegator entity for this time
 	delegator.ValidatorAddresses = getValsAddrs(vals)
-	delegator.Shares = shares
+	delegator.ShareWeights = weights
+	delegator.SetShares(shares)
 	k.SetDelegator(ctx, delegator)
 
 	ctx.EventManager().EmitEvent(buildEventForHandlerAddShares(delegator))
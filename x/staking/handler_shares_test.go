@@ -2,8 +2,10 @@ package staking
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	keep "github.com/okex/okexchain/x/staking/keeper"
 	"github.com/okex/okexchain/x/staking/types"
 	"github.com/stretchr/testify/require"
 )
@@ -82,3 +84,169 @@ func TestHandlerBadMessage(t *testing.T) {
 	r, err := handler(ctx, msg)
 	require.NotNil(t, err, r)
 }
+
+func TestHandlerAddSharesWeighted(t *testing.T) {
+	ctx, _, mockKeeper := CreateTestInput(t, false, SufficientInitPower)
+	keeper := mockKeeper.Keeper
+	handler := NewHandler(keeper)
+	valAddrs := []sdk.ValAddress{sdk.ValAddress(Addrs[0]), sdk.ValAddress(Addrs[1])}
+	for i, valAddr := range valAddrs {
+		_, err := handler(ctx, NewTestMsgCreateValidator(valAddr, PKs[i], DefaultMSD))
+		require.Nil(t, err)
+	}
+	delAddr, proxiedAddr := Addrs[2], Addrs[3]
+	_, err := handler(ctx, keep.NewTestMsgDeposit(delAddr, nil, sdk.NewDec(1000)))
+	require.Nil(t, err)
+
+	// the shares of each validator must be allocated by the weights after any update
+	weights := []sdk.Dec{sdk.NewDecWithPrec(7, 1), sdk.NewDecWithPrec(3, 1)}
+	checkAllocation := func() types.Delegator {
+		delegator, found := keeper.GetDelegator(ctx, delAddr)
+		require.True(t, found)
+		require.Len(t, delegator.ValidatorShares, len(valAddrs))
+		sum := sdk.ZeroDec()
+		for i, valAddr := range valAddrs {
+			valShares, found := keeper.GetShares(ctx, delAddr, valAddr)
+			require.True(t, found)
+			require.True(t, valShares.Equal(delegator.Shares.Mul(weights[i])), valShares)
+			require.True(t, valShares.Equal(delegator.GetSharesAddedTo(valAddr)))
+			sum = sum.Add(valShares)
+		}
+		// the weighted shares added to the validators sum up to the total shares of the delegator
+		require.True(t, sum.Equal(delegator.Shares), sum)
+		_, broken := keep.DelegatorAddSharesInvariant(keeper)(ctx)
+		require.False(t, broken)
+		return delegator
+	}
+
+	msg := types.NewMsgAddSharesWeighted(delAddr, valAddrs, weights)
+	require.Nil(t, msg.ValidateBasic())
+	_, err = handler(ctx, msg)
+	require.Nil(t, err)
+	delegator := checkAllocation()
+	require.Equal(t, weights, delegator.ShareWeights)
+
+	// deposit more
+	_, err = handler(ctx, keep.NewTestMsgDeposit(delAddr, nil, sdk.NewDec(1000)))
+	require.Nil(t, err)
+	lastShares := checkAllocation().Shares
+	require.True(t, lastShares.GT(delegator.Shares))
+
+	// the delegator becomes a proxy which is bound by another delegator
+	_, err = handler(ctx, types.NewMsgRegProxy(delAddr, true))
+	require.Nil(t, err)
+	_, err = handler(ctx, keep.NewTestMsgDeposit(proxiedAddr, nil, sdk.NewDec(1000)))
+	require.Nil(t, err)
+	_, err = handler(ctx, types.NewMsgBindProxy(proxiedAddr, delAddr))
+	require.Nil(t, err)
+	require.True(t, checkAllocation().Shares.GT(lastShares))
+
+	// withdraw part of the tokens
+	_, err = handler(ctx, types.NewMsgWithdraw(delAddr, sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(500))))
+	require.Nil(t, err)
+	checkAllocation()
+
+	// the full shares are added to each validator again without weights
+	_, err = handler(ctx, types.NewMsgAddShares(delAddr, valAddrs))
+	require.Nil(t, err)
+	delegator, found := keeper.GetDelegator(ctx, delAddr)
+	require.True(t, found)
+	require.Nil(t, delegator.ShareWeights)
+	for _, valAddr := range valAddrs {
+		valShares, found := keeper.GetShares(ctx, delAddr, valAddr)
+		require.True(t, found)
+		require.True(t, valShares.Equal(delegator.Shares))
+	}
+	_, broken := keep.DelegatorAddSharesInvariant(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestHandlerEditProxy(t *testing.T) {
+	ctx, _, mockKeeper := CreateTestInput(t, false, SufficientInitPower)
+	keeper := mockKeeper.Keeper
+	handler := NewHandler(keeper)
+	proxyAddr, otherProxyAddr, delAddr := Addrs[0], Addrs[1], Addrs[2]
+	for _, addr := range []sdk.AccAddress{proxyAddr, otherProxyAddr, delAddr} {
+		_, err := handler(ctx, keep.NewTestMsgDeposit(addr, nil, sdk.NewDec(1000)))
+		require.Nil(t, err)
+	}
+
+	feeRate, policy := sdk.NewDecWithPrec(5, 2), "vote yes on upgrades"
+	editMsg := types.NewMsgEditProxy(proxyAddr, types.NewDescription("proxy", "", "", ""), &feeRate, &policy)
+	require.Nil(t, editMsg.ValidateBasic())
+
+	// only a registered proxy is able to edit its profile
+	_, err := handler(ctx, editMsg)
+	require.NotNil(t, err)
+
+	for _, addr := range []sdk.AccAddress{proxyAddr, otherProxyAddr} {
+		_, err = handler(ctx, types.NewMsgRegProxy(addr, true))
+		require.Nil(t, err)
+	}
+	profile, found := keeper.GetProxyProfile(ctx, proxyAddr)
+	require.True(t, found)
+	require.True(t, profile.FeeRate.IsZero())
+
+	_, err = handler(ctx, editMsg)
+	require.Nil(t, err)
+	profile, found = keeper.GetProxyProfile(ctx, proxyAddr)
+	require.True(t, found)
+	require.Equal(t, "proxy", profile.Description.Moniker)
+	require.True(t, profile.FeeRate.Equal(feeRate))
+	require.Equal(t, policy, profile.VotingPolicy)
+
+	// the fee rate can't be changed again within 24 hours, while the rest of the profile can
+	newRate := sdk.NewDecWithPrec(1, 1)
+	_, err = handler(ctx, types.NewMsgEditProxy(proxyAddr, types.Description{}, &newRate, nil))
+	require.NotNil(t, err)
+	newPolicy := "abstain on everything"
+	_, err = handler(ctx, types.NewMsgEditProxy(proxyAddr, types.Description{}, nil, &newPolicy))
+	require.Nil(t, err)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	_, err = handler(ctx, types.NewMsgEditProxy(proxyAddr, types.Description{}, &newRate, nil))
+	require.Nil(t, err)
+	profile = keeper.GetProxyProfileOrDefault(ctx, proxyAddr)
+	require.True(t, profile.FeeRate.Equal(newRate))
+	require.Equal(t, newPolicy, profile.VotingPolicy)
+	require.Equal(t, "proxy", profile.Description.Moniker)
+
+	// the fee rate is taken from the delegators bound to the proxy
+	_, _, found = keeper.ProxyFeeRate(ctx, delAddr)
+	require.False(t, found)
+	_, err = handler(ctx, types.NewMsgBindProxy(delAddr, proxyAddr))
+	require.Nil(t, err)
+	boundProxy, rate, found := keeper.ProxyFeeRate(ctx, delAddr)
+	require.True(t, found)
+	require.Equal(t, proxyAddr, boundProxy)
+	require.True(t, rate.Equal(newRate))
+
+	// the proxies are ranked by the bound tokens and by the vote participation
+	keeper.RecordProxyParticipation(ctx, []sdk.AccAddress{otherProxyAddr})
+	keeper.RecordProxyParticipation(ctx, []sdk.AccAddress{otherProxyAddr, proxyAddr})
+	ranks, err := keeper.GetProxyRanks(ctx, types.ProxyRankByDelegatedTokens)
+	require.Nil(t, err)
+	require.Len(t, ranks, 2)
+	require.Equal(t, proxyAddr, ranks[0].Profile.ProxyAddress)
+	require.True(t, ranks[0].TotalDelegatedTokens.Equal(sdk.NewDec(1000)))
+	ranks, err = keeper.GetProxyRanks(ctx, types.ProxyRankByParticipation)
+	require.Nil(t, err)
+	require.Equal(t, otherProxyAddr, ranks[0].Profile.ProxyAddress)
+	require.True(t, ranks[0].Participation.Equal(sdk.OneDec()))
+	require.True(t, ranks[1].Participation.Equal(sdk.NewDecWithPrec(5, 1)))
+	_, err = keeper.GetProxyRanks(ctx, "fee")
+	require.NotNil(t, err)
+
+	// the profile is dropped once the proxy unregisters
+	_, err = handler(ctx, types.NewMsgRegProxy(proxyAddr, false))
+	require.Nil(t, err)
+	_, found = keeper.GetProxyProfile(ctx, proxyAddr)
+	require.False(t, found)
+	_, _, found = keeper.ProxyFeeRate(ctx, delAddr)
+	require.False(t, found)
+
+	// and the proxy index only keeps the registered proxies
+	ranks, err = keeper.GetProxyRanks(ctx, types.ProxyRankByDelegatedTokens)
+	require.Nil(t, err)
+	require.Len(t, ranks, 1)
+	require.Equal(t, otherProxyAddr, ranks[0].Profile.ProxyAddress)
+}
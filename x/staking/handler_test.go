@@ -2,6 +2,7 @@ package staking
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -67,6 +68,53 @@ func TestValidatorByPowerIndex(t *testing.T) {
 
 }
 
+func TestEndBlockerEpochEvents(t *testing.T) {
+	ctx, _, mockKeeper := CreateTestInput(t, false, SufficientInitPower)
+	keeper := mockKeeper.Keeper
+	handler := NewHandler(keeper)
+	params := keeper.GetParams(ctx)
+	params.Epoch = 20
+	keeper.SetParams(ctx, params)
+	keeper.SetEpoch(ctx, params.Epoch)
+
+	valAddr := sdk.ValAddress(Addrs[0])
+	_, err := handler(ctx, NewTestMsgCreateValidator(valAddr, PKs[0], DefaultMSD))
+	require.Nil(t, err)
+
+	findEvent := func(events sdk.Events, eventType string) (map[string]string, bool) {
+		for _, event := range events {
+			if event.Type == eventType {
+				attrs := make(map[string]string)
+				for _, attr := range event.Attributes {
+					attrs[string(attr.Key)] = string(attr.Value)
+				}
+				return attrs, true
+			}
+		}
+		return nil, false
+	}
+
+	// the upcoming epoch is noticed ahead
+	ctx = ctx.WithBlockHeight(20 - types.EpochNoticeBlocks).WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, keeper)
+	event, found := findEvent(ctx.EventManager().Events(), types.EventTypeEpochNotice)
+	require.True(t, found)
+	require.Equal(t, valAddr.String(), event[types.AttributeKeyEnteringValidators])
+	require.Equal(t, "20", event[types.AttributeKeyEndOfEpoch])
+	_, found = findEvent(ctx.EventManager().Events(), types.EventTypeNewEpoch)
+	require.False(t, found)
+
+	// the validator enters the validator set when the epoch ends
+	ctx = ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	updates := EndBlocker(ctx, keeper)
+	require.Len(t, updates, 1)
+	event, found = findEvent(ctx.EventManager().Events(), types.EventTypeNewEpoch)
+	require.True(t, found)
+	require.Equal(t, valAddr.String(), event[types.AttributeKeyEnteringValidators])
+	require.Equal(t, "1", event[types.AttributeKeyValidatorUpdates])
+	require.Equal(t, int64(40), keeper.GetTheEndOfEpoch(ctx))
+}
+
 func TestDuplicatesMsgCreateValidator(t *testing.T) {
 
 	initPower := int64(1000000)
@@ -175,7 +223,7 @@ func TestEditValidatorDecreaseMinSelfDelegation(t *testing.T) {
 		SharesFromDefaultMSD, false)
 
 	// edit validator
-	msgEditValidator := NewMsgEditValidator(validatorAddr, Description{Moniker: "moniker"})
+	msgEditValidator := NewMsgEditValidator(validatorAddr, Description{Moniker: "moniker"}, nil)
 	require.Nil(t, msgEditValidator.ValidateBasic())
 
 	// no one could change msd
@@ -184,3 +232,74 @@ func TestEditValidatorDecreaseMinSelfDelegation(t *testing.T) {
 	SimpleCheckValidator(t, ctx, keeper, validatorAddr, DefaultMSD, sdk.Bonded,
 		SharesFromDefaultMSD, false)
 }
+
+func TestEditValidatorCommission(t *testing.T) {
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+	ctx, _, mKeeper := CreateTestInput(t, false, SufficientInitPower)
+	keeper := mKeeper.Keeper
+	handler := NewHandler(keeper)
+	createTime := ctx.BlockHeader().Time
+
+	// create validator with the commission rates given
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], DefaultMSD)
+	msgCreateValidator.Commission = NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1),
+		sdk.NewDecWithPrec(1, 1))
+	require.Nil(t, msgCreateValidator.ValidateBasic())
+	_, err := handler(ctx, msgCreateValidator)
+	require.Nil(t, err)
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.True(t, validator.Commission.Rate.Equal(msgCreateValidator.Commission.Rate))
+	require.True(t, validator.Commission.MaxRate.Equal(msgCreateValidator.Commission.MaxRate))
+	require.True(t, validator.Commission.MaxChangeRate.Equal(msgCreateValidator.Commission.MaxChangeRate))
+	require.True(t, validator.Commission.UpdateTime.Equal(createTime))
+
+	newRate := func(rate string) *sdk.Dec {
+		dec := sdk.MustNewDecFromStr(rate)
+		return &dec
+	}
+	oneDayLater := createTime.Add(time.Hour * types.DefaultValidateRateUpdateInterval)
+	tests := []struct {
+		name       string
+		blockTime  time.Time
+		newRate    *sdk.Dec
+		expectPass bool
+		expRate    sdk.Dec
+	}{
+		{"changed within 24h after creation", createTime.Add(time.Hour), newRate("0.15"), false,
+			sdk.NewDecWithPrec(1, 1)},
+		{"increased more than the max change rate", oneDayLater, newRate("0.25"), false, sdk.NewDecWithPrec(1, 1)},
+		{"greater than the max rate", oneDayLater, newRate("0.6"), false, sdk.NewDecWithPrec(1, 1)},
+		{"basic good", oneDayLater, newRate("0.2"), true, sdk.NewDecWithPrec(2, 1)},
+		{"description only", oneDayLater.Add(time.Hour), nil, true, sdk.NewDecWithPrec(2, 1)},
+		{"changed within 24h after the last change", oneDayLater.Add(time.Hour), newRate("0.1"), false,
+			sdk.NewDecWithPrec(2, 1)},
+		{"decreased after 24h", oneDayLater.Add(time.Hour * types.DefaultValidateRateUpdateInterval), newRate("0"), true,
+			sdk.ZeroDec()},
+	}
+
+	expMoniker := msgCreateValidator.Description.Moniker
+	for _, tc := range tests {
+		ctx = ctx.WithBlockTime(tc.blockTime)
+		msgEditValidator := NewMsgEditValidator(validatorAddr, Description{}, tc.newRate)
+		if tc.newRate == nil {
+			// the description is kept when only the commission rate is edited
+			msgEditValidator.Description = Description{Moniker: "moniker"}
+			expMoniker = "moniker"
+		}
+		_, err := handler(ctx, msgEditValidator)
+		if tc.expectPass {
+			require.Nil(t, err, tc.name)
+		} else {
+			require.NotNil(t, err, tc.name)
+		}
+
+		validator, found := keeper.GetValidator(ctx, validatorAddr)
+		require.True(t, found)
+		require.True(t, validator.Commission.Rate.Equal(tc.expRate), tc.name)
+		require.Equal(t, expMoniker, validator.Description.Moniker, tc.name)
+		if tc.expectPass && tc.newRate != nil {
+			require.True(t, validator.Commission.UpdateTime.Equal(tc.blockTime), tc.name)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/okex/okexchain/x/staking/types"
 )
@@ -18,14 +20,60 @@ func (k Keeper) GetDelegator(ctx sdk.Context, delAddr sdk.AccAddress) (delegator
 
 // SetDelegator sets Delegator info to store
 func (k Keeper) SetDelegator(ctx sdk.Context, delegator types.Delegator) {
+	store := ctx.KVStore(k.storeKey)
 	key := types.GetDelegatorKey(delegator.DelegatorAddress)
 	bytes := k.cdc.MustMarshalBinaryLengthPrefixed(delegator)
-	ctx.KVStore(k.storeKey).Set(key, bytes)
+	store.Set(key, bytes)
+
+	// keep the index of the proxies up to date
+	if delegator.IsProxy {
+		store.Set(types.GetProxyIndexKey(delegator.DelegatorAddress), []byte{})
+	} else {
+		store.Delete(types.GetProxyIndexKey(delegator.DelegatorAddress))
+	}
 }
 
 // DeleteDelegator deletes Delegator info from store
 func (k Keeper) DeleteDelegator(ctx sdk.Context, delAddr sdk.AccAddress) {
-	ctx.KVStore(k.storeKey).Delete(types.GetDelegatorKey(delAddr))
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDelegatorKey(delAddr))
+	store.Delete(types.GetProxyIndexKey(delAddr))
+}
+
+// IterateProxies iterates through the registered proxies by the proxy index, without visiting the other delegators
+func (k Keeper) IterateProxies(ctx sdk.Context, fn func(proxy types.Delegator) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ProxyIndexKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		proxyAddr := sdk.AccAddress(iterator.Key()[len(types.ProxyIndexKey):])
+		proxy, found := k.GetDelegator(ctx, proxyAddr)
+		if !found {
+			k.Logger(ctx).Error(fmt.Sprintf("proxy %s in the index is not found, skipped", proxyAddr))
+			continue
+		}
+		if stop := fn(proxy); stop {
+			break
+		}
+	}
+}
+
+// BuildProxyIndex builds the index of the proxies registered before the index was introduced. It only iterates
+// through the delegators once, and does nothing after the index has been built
+func (k Keeper) BuildProxyIndex(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.ProxyIndexBuiltKey) {
+		return
+	}
+
+	k.IterateDelegator(ctx, func(_ int64, delegator types.Delegator) (stop bool) {
+		if delegator.IsProxy {
+			store.Set(types.GetProxyIndexKey(delegator.DelegatorAddress), []byte{})
+		}
+		return false
+	})
+	store.Set(types.ProxyIndexBuiltKey, []byte{})
 }
 
 // IterateDelegator iterates through all of the delegators info from the store
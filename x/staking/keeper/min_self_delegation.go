@@ -59,6 +59,22 @@ func (k Keeper) WithdrawMinSelfDelegation(ctx sdk.Context, delAddr sdk.AccAddres
 	return
 }
 
+// removeMinSelfDelegation removes the amount of the msd from the validator whose tokens have been moved out of the bonded
+// pool. The shares of the msd are removed with the last of it, and the validator left without any shares is removed
+func (k Keeper) removeMinSelfDelegation(ctx sdk.Context, validator types.Validator, amount sdk.Dec) {
+	k.DeleteValidatorByPowerIndex(ctx, validator)
+	validator.MinSelfDelegation = validator.MinSelfDelegation.Sub(amount)
+	if validator.MinSelfDelegation.IsZero() {
+		validator.DelegatorShares = validator.GetDelegatorShares().Sub(k.getSharesFromDefaultMinSelfDelegation())
+		if validator.IsUnbonded() && validator.GetDelegatorShares().IsZero() {
+			k.RemoveValidator(ctx, validator.OperatorAddress)
+			return
+		}
+	}
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByPowerIndex(ctx, validator)
+}
+
 // AddSharesAsMinSelfDelegation adds shares of equal value of default msd (0.001okt) to validator itself during the creation
 func (k Keeper) AddSharesAsMinSelfDelegation(ctx sdk.Context, delAddr sdk.AccAddress, validator *types.Validator,
 	defaultMSDToken sdk.SysCoin) (err error) {
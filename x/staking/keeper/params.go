@@ -84,6 +84,13 @@ func (k Keeper) IsEndOfEpoch(ctx sdk.Context) bool {
 	return blockInterval%int64(k.GetEpoch(ctx)) == 0
 }
 
+// GetTheEndOfEpoch returns the height at which the current epoch ends, the next height IsEndOfEpoch holds after the
+// current block
+func (k Keeper) GetTheEndOfEpoch(ctx sdk.Context) int64 {
+	epoch := int64(k.GetEpoch(ctx))
+	return ctx.BlockHeight() + epoch - (ctx.BlockHeight()-k.GetTheEndOfLastEpoch(ctx))%epoch
+}
+
 // GetTheEndOfLastEpoch returns the deadline of the current epoch
 func (k Keeper) GetTheEndOfLastEpoch(ctx sdk.Context) (height int64) {
 	store := ctx.KVStore(k.storeKey)
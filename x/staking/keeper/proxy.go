@@ -77,17 +77,18 @@ func (k Keeper) UpdateShares(ctx sdk.Context, delAddr sdk.AccAddress, tokens sdk
 		// 1.delete related store
 		k.DeleteValidatorByPowerIndex(ctx, vals[i])
 
-		// 2.update shares
-		k.SetShares(ctx, delAddr, vals[i].OperatorAddress, shares)
+		// 2.update shares by the allocation of the delegator
+		valShares := delegator.AllocateShares(vals[i].OperatorAddress, shares)
+		k.SetShares(ctx, delAddr, vals[i].OperatorAddress, valShares)
 
 		// 3.update validator
-		vals[i].DelegatorShares = vals[i].DelegatorShares.Sub(lastShares).Add(shares)
+		vals[i].DelegatorShares = vals[i].DelegatorShares.Sub(lastShares[i]).Add(valShares)
 		k.SetValidator(ctx, vals[i])
 		k.SetValidatorByPowerIndex(ctx, vals[i])
 	}
 
 	// update the delegator struct
-	delegator.Shares = shares
+	delegator.SetShares(shares)
 	k.SetDelegator(ctx, delegator)
 
 	return nil
@@ -96,23 +97,39 @@ func (k Keeper) UpdateShares(ctx sdk.Context, delAddr sdk.AccAddress, tokens sdk
 // AddSharesToValidators adds shares to validators and return the amount of the shares
 func (k Keeper) AddSharesToValidators(ctx sdk.Context, delAddr sdk.AccAddress, vals types.Validators, tokens sdk.Dec) (
 	shares types.Shares, sdkErr error) {
+	return k.AddWeightedSharesToValidators(ctx, delAddr, vals, nil, tokens)
+}
+
+// AddWeightedSharesToValidators adds shares to validators by the allocation weights and return the total amount of the
+// shares. The full shares are added to each validator when the weights are empty, otherwise the weighted shares sum up
+// to the total shares, see types.WeightShares
+func (k Keeper) AddWeightedSharesToValidators(ctx sdk.Context, delAddr sdk.AccAddress, vals types.Validators,
+	weights []sdk.Dec, tokens sdk.Dec) (shares types.Shares, sdkErr error) {
 	lenVals := len(vals)
+	if len(weights) != 0 && len(weights) != lenVals {
+		return shares, types.ErrInvalidShareWeights()
+	}
+
 	shares, sdkErr = calculateWeight(ctx.BlockTime().Unix(), tokens)
 	if sdkErr != nil {
 		return
 	}
 	for i := 0; i < lenVals; i++ {
-		k.addShares(ctx, delAddr, vals[i], shares)
+		valShares := shares
+		if len(weights) != 0 {
+			valShares = types.WeightShares(shares, weights, i)
+		}
+		k.addShares(ctx, delAddr, vals[i], valShares)
 	}
 	return
 }
 
 // WithdrawLastShares withdraws the shares last time from the validators
 func (k Keeper) WithdrawLastShares(ctx sdk.Context, delAddr sdk.AccAddress, lastValsAddedSharesTo types.Validators,
-	lastShares types.Shares) {
+	lastShares []types.Shares) {
 	lenLastVals := len(lastValsAddedSharesTo)
 	for i := 0; i < lenLastVals; i++ {
-		k.withdrawShares(ctx, delAddr, lastValsAddedSharesTo[i], lastShares)
+		k.withdrawShares(ctx, delAddr, lastValsAddedSharesTo[i], lastShares[i])
 	}
 }
 
@@ -147,28 +164,32 @@ func (k Keeper) addShares(ctx sdk.Context, delAddr sdk.AccAddress, val types.Val
 	k.SetValidatorByPowerIndex(ctx, val)
 }
 
-// GetLastValsAddedSharesExisted gets last validators that the delegator added shares to last time
-func (k Keeper) GetLastValsAddedSharesExisted(ctx sdk.Context, delAddr sdk.AccAddress) (types.Validators, types.Shares) {
+// GetLastValsAddedSharesExisted gets last validators that the delegator added shares to last time, and the shares
+// added to each of them
+func (k Keeper) GetLastValsAddedSharesExisted(ctx sdk.Context, delAddr sdk.AccAddress) (types.Validators,
+	[]types.Shares) {
 	// 1.get delegator entity
 	delegator, found := k.GetDelegator(ctx, delAddr)
 
 	// if not found
 	if !found {
-		return nil, sdk.ZeroDec()
+		return nil, nil
 	}
 
 	// 2.get validators that were added shares to and existing in the store
 	lenVals := len(delegator.ValidatorAddresses)
 	var vals types.Validators
+	var shares []types.Shares
 	for i := 0; i < lenVals; i++ {
 		val, found := k.GetValidator(ctx, delegator.ValidatorAddresses[i])
 		if found {
 			// the validator that were added shares to hasn't been removed
 			vals = append(vals, val)
+			shares = append(shares, delegator.GetSharesAddedTo(val.OperatorAddress))
 		}
 	}
 
-	return vals, delegator.Shares
+	return vals, shares
 }
 
 // GetValidatorsToAddShares gets the validators from their validator addresses
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/staking/types"
+)
+
+// GetProxyProfile gets the profile of a proxy from store
+func (k Keeper) GetProxyProfile(ctx sdk.Context, proxyAddr sdk.AccAddress) (profile types.ProxyProfile, found bool) {
+	bytes := ctx.KVStore(k.storeKey).Get(types.GetProxyProfileKey(proxyAddr))
+	if bytes == nil {
+		return profile, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bytes, &profile)
+	return profile, true
+}
+
+// SetProxyProfile sets the profile of a proxy to store
+func (k Keeper) SetProxyProfile(ctx sdk.Context, profile types.ProxyProfile) {
+	bytes := k.cdc.MustMarshalBinaryLengthPrefixed(profile)
+	ctx.KVStore(k.storeKey).Set(types.GetProxyProfileKey(profile.ProxyAddress), bytes)
+}
+
+// DeleteProxyProfile deletes the profile of a proxy from store
+func (k Keeper) DeleteProxyProfile(ctx sdk.Context, proxyAddr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetProxyProfileKey(proxyAddr))
+}
+
+// IterateProxyProfiles iterates through all of the proxy profiles from the store
+func (k Keeper) IterateProxyProfiles(ctx sdk.Context, fn func(index int64, profile types.ProxyProfile) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ProxyProfileKey)
+	defer iterator.Close()
+
+	for i := int64(0); iterator.Valid(); iterator.Next() {
+		var profile types.ProxyProfile
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &profile)
+		if stop := fn(i, profile); stop {
+			break
+		}
+		i++
+	}
+}
+
+// GetProxyProfileOrDefault gets the profile of a proxy, and the proxies registered before the profiles were introduced
+// get an empty profile without fee
+func (k Keeper) GetProxyProfileOrDefault(ctx sdk.Context, proxyAddr sdk.AccAddress) types.ProxyProfile {
+	profile, found := k.GetProxyProfile(ctx, proxyAddr)
+	if !found {
+		return types.NewProxyProfile(proxyAddr)
+	}
+	return profile
+}
+
+// UpdateProxyFeeRate attempts to update the fee rate of a proxy. An error is returned if the new fee rate is invalid
+// or it was changed within the last 24 hours
+func (k Keeper) UpdateProxyFeeRate(ctx sdk.Context, profile types.ProxyProfile, newRate sdk.Dec) (
+	types.ProxyProfile, error) {
+	blockTime := ctx.BlockHeader().Time
+	if err := profile.ValidateNewFeeRate(newRate, blockTime); err != nil {
+		return profile, err
+	}
+
+	profile.FeeRate = newRate
+	profile.FeeRateUpdateTime = blockTime
+	return profile, nil
+}
+
+// ProxyFeeRate returns the proxy bound by a delegator and the fee rate the proxy takes from the rewards of the
+// delegator
+func (k Keeper) ProxyFeeRate(ctx sdk.Context, delAddr sdk.AccAddress) (proxyAddr sdk.AccAddress, feeRate sdk.Dec,
+	found bool) {
+	delegator, found := k.GetDelegator(ctx, delAddr)
+	if !found || !delegator.HasProxy() {
+		return nil, sdk.ZeroDec(), false
+	}
+
+	profile := k.GetProxyProfileOrDefault(ctx, delegator.ProxyAddress)
+	return delegator.ProxyAddress, profile.FeeRate, true
+}
+
+// RecordProxyParticipation counts an ended proposal on all of the proxies, and counts a vote on the proxies among the
+// voters of the proposal
+func (k Keeper) RecordProxyParticipation(ctx sdk.Context, voters []sdk.AccAddress) {
+	voted := make(map[string]bool, len(voters))
+	for _, voter := range voters {
+		voted[voter.String()] = true
+	}
+
+	k.IterateProxies(ctx, func(proxy types.Delegator) (stop bool) {
+		profile := k.GetProxyProfileOrDefault(ctx, proxy.DelegatorAddress)
+		profile.EndedProposals++
+		if voted[proxy.DelegatorAddress.String()] {
+			profile.VotedProposals++
+		}
+		k.SetProxyProfile(ctx, profile)
+		return false
+	})
+}
+
+// GetProxyRanks returns all of the proxies ranked by the total delegated tokens bound to them or their vote
+// participation
+func (k Keeper) GetProxyRanks(ctx sdk.Context, sortBy string) (types.ProxyRanks, error) {
+	ranks := types.ProxyRanks{}
+	k.IterateProxies(ctx, func(proxy types.Delegator) (stop bool) {
+		profile := k.GetProxyProfileOrDefault(ctx, proxy.DelegatorAddress)
+		ranks = append(ranks, types.NewProxyRank(profile, proxy.TotalDelegatedTokens))
+		return false
+	})
+
+	if err := ranks.Sort(sortBy); err != nil {
+		return nil, err
+	}
+	return ranks, nil
+}
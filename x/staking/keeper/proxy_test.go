@@ -17,7 +17,7 @@ func TestAddSharesToValidatorsAndWithdraw(t *testing.T) {
 	dlgAddr := addrDels[0]
 	lastVals, lastShares := keeper.GetLastValsAddedSharesExisted(ctx, dlgAddr)
 	require.Nil(t, lastVals)
-	require.True(t, lastShares.IsZero())
+	require.Nil(t, lastShares)
 
 	// withdraw the shares last time
 	keeper.WithdrawLastShares(ctx, dlgAddr, lastVals, lastShares)
@@ -36,7 +36,7 @@ func TestAddSharesToValidatorsAndWithdraw(t *testing.T) {
 		// check shares
 		shares, found := keeper.GetShares(ctx, dlgAddr, valsNew[i].OperatorAddress)
 		require.True(t, found)
-		require.True(t, shares.GT(lastShares), shares)
+		require.True(t, shares.IsPositive(), shares)
 	}
 
 	// standardize
@@ -47,6 +47,33 @@ func TestAddSharesToValidatorsAndWithdraw(t *testing.T) {
 	require.Contains(t, r, "Operator Address")
 }
 
+func TestAddWeightedSharesToValidators(t *testing.T) {
+	ctx, _, mkeeper := CreateTestInput(t, false, 0)
+	keeper := mkeeper.Keeper
+	valsOld := createVals(ctx, 2, keeper)
+	dlgAddr := addrDels[0]
+
+	// the weights must match the validators
+	_, e := keeper.AddWeightedSharesToValidators(ctx, dlgAddr, valsOld, []sdk.Dec{sdk.OneDec()}, sdk.NewDec(10000))
+	require.NotNil(t, e)
+
+	weights := []sdk.Dec{sdk.NewDecWithPrec(7, 1), sdk.NewDecWithPrec(3, 1)}
+	shares, e := keeper.AddWeightedSharesToValidators(ctx, dlgAddr, valsOld, weights, sdk.NewDec(10000))
+	require.Nil(t, e)
+
+	valsNew := getVals(ctx, valsOld, keeper, t)
+	sum := sdk.ZeroDec()
+	for i := 0; i < 2; i++ {
+		valShares, found := keeper.GetShares(ctx, dlgAddr, valsNew[i].OperatorAddress)
+		require.True(t, found)
+		require.True(t, valShares.Equal(shares.Mul(weights[i])), valShares)
+		require.True(t, valsNew[i].DelegatorShares.Sub(valsOld[i].DelegatorShares).Equal(valShares))
+		sum = sum.Add(valShares)
+	}
+	// the weighted shares sum up to the unweighted shares
+	require.True(t, sum.Equal(shares), sum)
+}
+
 func createVals(ctx sdk.Context, num int, keeper Keeper) types.Validators {
 	vals := make(types.Validators, num)
 	for i := 0; i < num; i++ {
@@ -67,3 +94,39 @@ func getVals(ctx sdk.Context, valOld types.Validators, keeper Keeper, t *testing
 	}
 	return gotVals
 }
+
+func TestBuildProxyIndex(t *testing.T) {
+	ctx, _, mkeeper := CreateTestInput(t, false, 0)
+	keeper := mkeeper.Keeper
+	store := ctx.KVStore(mkeeper.StoreKey)
+	proxyAddr, delAddr := addrDels[0], addrDels[1]
+
+	proxy := types.NewDelegator(proxyAddr)
+	proxy.IsProxy = true
+	keeper.SetDelegator(ctx, proxy)
+	keeper.SetDelegator(ctx, types.NewDelegator(delAddr))
+
+	// a proxy registered before the index was introduced is not indexed
+	store.Delete(types.GetProxyIndexKey(proxyAddr))
+	iterateProxyAddrs := func() (addrs []sdk.AccAddress) {
+		keeper.IterateProxies(ctx, func(proxy types.Delegator) (stop bool) {
+			addrs = append(addrs, proxy.DelegatorAddress)
+			return false
+		})
+		return
+	}
+	require.Empty(t, iterateProxyAddrs())
+
+	keeper.BuildProxyIndex(ctx)
+	require.Equal(t, []sdk.AccAddress{proxyAddr}, iterateProxyAddrs())
+
+	// the index is built only once
+	store.Delete(types.GetProxyIndexKey(proxyAddr))
+	keeper.BuildProxyIndex(ctx)
+	require.Empty(t, iterateProxyAddrs())
+
+	// an index without its proxy is skipped
+	keeper.SetDelegator(ctx, proxy)
+	store.Set(types.GetProxyIndexKey(addrDels[2]), []byte{})
+	require.Equal(t, []sdk.AccAddress{proxyAddr}, iterateProxyAddrs())
+}
@@ -40,6 +40,12 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryProxy(ctx, req, k)
 		case types.QueryDelegator:
 			return queryDelegator(ctx, req, k)
+		case types.QueryProxyProfile:
+			return queryProxyProfile(ctx, req, k)
+		case types.QueryProxyRanks:
+			return queryProxyRanks(ctx, req, k)
+		case types.QueryValidatorSetPreview:
+			return queryValidatorSetPreview(ctx, k)
 		default:
 			return nil, types.ErrUnknownStakingQueryType()
 		}
@@ -155,6 +161,15 @@ func queryParameters(ctx sdk.Context, k Keeper) ([]byte, error) {
 	return res, nil
 }
 
+func queryValidatorSetPreview(ctx sdk.Context, k Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.PreviewValidatorSetUpdates(ctx))
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return res, nil
+}
+
 func queryProxy(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	var params types.QueryDelegatorParams
 	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
@@ -170,6 +185,51 @@ func queryProxy(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error
 	return resp, nil
 }
 
+func queryProxyProfile(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDelegatorParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	proxy, found := k.GetDelegator(ctx, params.DelegatorAddr)
+	if !found || !proxy.IsProxy {
+		return nil, types.ErrProxyNotFound(params.DelegatorAddr.String())
+	}
+
+	resp, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetProxyProfileOrDefault(ctx, params.DelegatorAddr))
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return resp, nil
+}
+
+func queryProxyRanks(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryProxyRanksParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, common.ErrUnMarshalJSONFailed(err.Error())
+	}
+
+	ranks, err := k.GetProxyRanks(ctx, params.SortBy)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := client.Paginate(len(ranks), params.Page, params.Limit, len(ranks))
+	if start < 0 || end < 0 {
+		ranks = types.ProxyRanks{}
+	} else {
+		ranks = ranks[start:end]
+	}
+
+	resp, err := codec.MarshalJSONIndent(types.ModuleCdc, ranks)
+	if err != nil {
+		return nil, common.ErrMarshalJSONFailed(err.Error())
+	}
+
+	return resp, nil
+}
+
 func queryValidatorAllShares(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	var params types.QueryValidatorParams
 
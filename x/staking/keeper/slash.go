@@ -4,10 +4,71 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/staking/types"
 )
 
+// Slash burns the slashFactor fraction of the min self delegation bonded by the validator, and removes the shares of the
+// msd once it is burned out. Shares added by delegators are left untouched because they are spread across all the
+// validators that a delegator has voted for
 func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec) {
+	k.slash(ctx, consAddr, infractionHeight, slashFactor, nil, sdk.ZeroDec())
+}
+
+// SlashWithReward slashes the validator as Slash does, but pays the rewardFraction fraction of the slashed tokens to
+// the rewardee instead of burning them. It returns the amount of the reward paid
+func (k Keeper) SlashWithReward(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64,
+	slashFactor sdk.Dec, rewardee sdk.AccAddress, rewardFraction sdk.Dec) sdk.Dec {
+	if rewardFraction.IsNegative() || rewardFraction.GT(sdk.OneDec()) {
+		panic(fmt.Errorf("attempted to reward with an invalid reward fraction: %s", rewardFraction))
+	}
+	return k.slash(ctx, consAddr, infractionHeight, slashFactor, rewardee, rewardFraction)
+}
+
+func (k Keeper) slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, slashFactor sdk.Dec,
+	rewardee sdk.AccAddress, rewardFraction sdk.Dec) (reward sdk.Dec) {
+	logger := k.Logger(ctx)
+	reward = sdk.ZeroDec()
+	if slashFactor.IsNegative() || slashFactor.GT(sdk.OneDec()) {
+		panic(fmt.Errorf("attempted to slash with an invalid slash factor: %s", slashFactor))
+	}
+
+	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
+	if !found {
+		// the validator might have been destroyed and removed already
+		logger.Error(fmt.Sprintf("WARNING: ignored attempt to slash a nonexistent validator %s at height %d",
+			consAddr, infractionHeight))
+		return
+	}
+
+	// the msd of a destroying validator has already been cleared and moved out of the bonded pool
+	slashAmount := validator.MinSelfDelegation.Mul(slashFactor)
+	if !slashAmount.IsPositive() {
+		return
+	}
+
+	if !rewardee.Empty() {
+		reward = slashAmount.Mul(rewardFraction)
+	}
+	if reward.IsPositive() {
+		rewardCoins := sdk.NewDecCoinsFromDec(k.BondDenom(ctx), reward)
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.BondedPoolName, rewardee, rewardCoins); err != nil {
+			panic(err)
+		}
+	}
+
+	burnedAmount := slashAmount.Sub(reward)
+	if burnedAmount.IsPositive() {
+		burnedCoins := sdk.NewDecCoinsFromDec(k.BondDenom(ctx), burnedAmount)
+		if err := k.supplyKeeper.BurnCoins(ctx, types.BondedPoolName, burnedCoins); err != nil {
+			panic(err)
+		}
+	}
+
+	k.removeMinSelfDelegation(ctx, validator, slashAmount)
 
+	logger.Info(fmt.Sprintf("validator %s slashed by fraction %s at height %d, %s%s burned, %s%s rewarded",
+		consAddr, slashFactor, infractionHeight, burnedAmount, k.BondDenom(ctx), reward, k.BondDenom(ctx)))
+	return
 }
 
 // Jail sents a validator to jail
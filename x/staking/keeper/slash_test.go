@@ -1 +1,93 @@
 package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/staking/types"
+)
+
+func TestSlash(t *testing.T) {
+	ctx, _, mKeeper := CreateTestInput(t, false, 1000000)
+	k := mKeeper.Keeper
+	vAddr, vPk := sdk.ValAddress(Addrs[0]), PKs[0]
+	rewardee := Addrs[1]
+	msd := sdk.NewDec(10000)
+
+	msgCreateValidator := NewTestMsgCreateValidator(vAddr, vPk, msd)
+	validator := types.NewValidator(msgCreateValidator.ValidatorAddress, msgCreateValidator.PubKey,
+		msgCreateValidator.Description, msgCreateValidator.MinSelfDelegation.Amount)
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByConsAddr(ctx, validator)
+	k.SetNewValidatorByPowerIndex(ctx, validator)
+	msdToken := sdk.NewDecCoinFromDec(k.BondDenom(ctx), validator.MinSelfDelegation)
+	require.NoError(t, k.AddSharesAsMinSelfDelegation(ctx, msgCreateValidator.DelegatorAddress, &validator, msdToken))
+
+	consAddr := validator.GetConsAddr()
+	bondedPool := mKeeper.SupplyKeeper.GetModuleAccount(ctx, types.BondedPoolName)
+	require.Equal(t, msd, bondedPool.GetCoins().AmountOf(k.BondDenom(ctx)))
+
+	// a part of the msd is burned while its shares are kept
+	k.Slash(ctx, consAddr, ctx.BlockHeight(), 0, sdk.NewDecWithPrec(1, 1))
+	validator = *SimpleCheckValidator(t, ctx, k, vAddr, sdk.NewDec(9000), sdk.Unbonded, sdk.OneDec(), false)
+	bondedPool = mKeeper.SupplyKeeper.GetModuleAccount(ctx, types.BondedPoolName)
+	require.Equal(t, sdk.NewDec(9000), bondedPool.GetCoins().AmountOf(k.BondDenom(ctx)))
+	require.True(t, ValidatorByPowerIndexExists(ctx, mKeeper, types.GetValidatorsByPowerIndexKey(validator)))
+
+	// the rest of the msd is slashed with a half of it paid to the rewardee, and the validator without any shares left
+	// is removed
+	reward := k.SlashWithReward(ctx, consAddr, ctx.BlockHeight(), 0, sdk.OneDec(), rewardee, sdk.NewDecWithPrec(5, 1))
+	require.Equal(t, sdk.NewDec(4500), reward)
+	bondedPool = mKeeper.SupplyKeeper.GetModuleAccount(ctx, types.BondedPoolName)
+	require.True(t, bondedPool.GetCoins().AmountOf(k.BondDenom(ctx)).IsZero())
+	_, found := k.GetValidator(ctx, vAddr)
+	require.False(t, found)
+	require.False(t, ValidatorByPowerIndexExists(ctx, mKeeper, types.GetValidatorsByPowerIndexKey(validator)))
+
+	_, broken := ModuleAccountInvariantsCustom(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestSlashKeepsShares(t *testing.T) {
+	ctx, _, mKeeper := CreateTestInput(t, false, 1000000)
+	k := mKeeper.Keeper
+	dAddr := Addrs[0]
+	vAddr, vPk := sdk.ValAddress(Addrs[1]), PKs[1]
+
+	msgCreateValidator := NewTestMsgCreateValidator(vAddr, vPk, types.DefaultMinSelfDelegation)
+	validator := types.NewValidator(msgCreateValidator.ValidatorAddress, msgCreateValidator.PubKey,
+		msgCreateValidator.Description, msgCreateValidator.MinSelfDelegation.Amount)
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByConsAddr(ctx, validator)
+	k.SetNewValidatorByPowerIndex(ctx, validator)
+	msdToken := sdk.NewDecCoinFromDec(k.BondDenom(ctx), validator.MinSelfDelegation)
+	require.NoError(t, k.AddSharesAsMinSelfDelegation(ctx, msgCreateValidator.DelegatorAddress, &validator, msdToken))
+
+	// a delegator adds shares to the validator
+	require.NoError(t, k.Delegate(ctx, dAddr, sdk.NewDecCoinFromDec(k.BondDenom(ctx), sdk.NewDec(100))))
+	vals, err := k.GetValidatorsToAddShares(ctx, []sdk.ValAddress{vAddr})
+	require.Nil(t, err)
+	delegator, found := k.GetDelegator(ctx, dAddr)
+	require.True(t, found)
+	shares, err := k.AddSharesToValidators(ctx, dAddr, vals, delegator.Tokens)
+	require.Nil(t, err)
+	delegator.ValidatorAddresses = []sdk.ValAddress{vAddr}
+	delegator.Shares = shares
+	k.SetDelegator(ctx, delegator)
+
+	// the msd is burned out along with its shares, while the shares of the delegator are kept on the jailed validator
+	validator, found = k.GetValidator(ctx, vAddr)
+	require.True(t, found)
+	k.Slash(ctx, validator.GetConsAddr(), ctx.BlockHeight(), 0, sdk.OneDec())
+	k.Jail(ctx, validator.GetConsAddr())
+	SimpleCheckValidator(t, ctx, k, vAddr, sdk.ZeroDec(), sdk.Unbonded, shares, true)
+
+	for _, invariant := range []func(Keeper) sdk.Invariant{
+		DelegatorAddSharesInvariant, ModuleAccountInvariantsCustom, NonNegativePowerInvariantCustom,
+	} {
+		msg, broken := invariant(k)(ctx)
+		require.False(t, broken, msg)
+	}
+}
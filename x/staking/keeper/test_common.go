@@ -90,6 +90,7 @@ func MakeTestCodec() *codec.Codec {
 	cdc.RegisterConcrete(types.MsgEditValidator{}, "test/staking/EditValidator", nil)
 	cdc.RegisterConcrete(types.MsgWithdraw{}, "test/staking/MsgWithdraw", nil)
 	cdc.RegisterConcrete(types.MsgAddShares{}, "test/staking/MsgAddShares", nil)
+	cdc.RegisterConcrete(types.MsgAddSharesWeighted{}, "test/staking/MsgAddSharesWeighted", nil)
 
 	// Register AppAccount
 	cdc.RegisterInterface((*exported.Account)(nil), nil)
@@ -281,7 +282,8 @@ func NewTestMsgCreateValidator(address sdk.ValAddress, pubKey crypto.PubKey, msd
 	msd := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, msdAmt)
 
 	return types.NewMsgCreateValidator(address, pubKey,
-		types.NewDescription("my moniker", "my identity", "my website", "my details"), msd,
+		types.NewDescription("my moniker", "my identity", "my website", "my details"),
+		types.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), msd,
 	)
 }
 
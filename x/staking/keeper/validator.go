@@ -152,6 +152,23 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	k.AfterValidatorRemoved(ctx, validator.ConsAddress(), validator.OperatorAddress)
 }
 
+// UpdateValidatorCommission attempts to update a validator's commission rate. An error is returned if the new
+// commission rate is invalid or it was changed within the last 24 hours
+func (k Keeper) UpdateValidatorCommission(ctx sdk.Context, validator types.Validator, newRate sdk.Dec) (
+	types.Commission, error) {
+	commission := validator.Commission
+	blockTime := ctx.BlockHeader().Time
+
+	if err := commission.ValidateNewRate(newRate, blockTime); err != nil {
+		return commission, err
+	}
+
+	commission.Rate = newRate
+	commission.UpdateTime = blockTime
+
+	return commission, nil
+}
+
 // get groups of validators
 
 // GetAllValidators gets the set of all validators with no limits, used during genesis dump
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/staking/types"
+)
+
+// PreviewValidatorSetUpdates simulates the election of ApplyAndReturnValidatorSetUpdates at the end of the current
+// epoch from the current shares without any state change
+func (k Keeper) PreviewValidatorSetUpdates(ctx sdk.Context) types.ValidatorSetPreview {
+	endOfEpoch := k.GetTheEndOfEpoch(ctx)
+	preview := types.ValidatorSetPreview{
+		Height:         ctx.BlockHeight(),
+		Epoch:          k.GetEpoch(ctx),
+		NextEpoch:      k.ParamsEpoch(ctx),
+		EndOfLastEpoch: k.GetTheEndOfLastEpoch(ctx),
+		EndOfEpoch:     endOfEpoch,
+		BlocksToEnd:    endOfEpoch - ctx.BlockHeight(),
+		LastTotalPower: k.GetLastTotalPower(ctx).Int64(),
+		Changes:        []types.ValidatorPowerChange{},
+	}
+
+	last := k.getLastValidatorsByAddr(ctx)
+	maxValidators := k.GetParams(ctx).MaxValidators
+	iterator := sdk.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), types.ValidatorsByPowerIndexKey)
+	defer iterator.Close()
+	for count := 0; iterator.Valid() && count < int(maxValidators); iterator.Next() {
+		validator := k.mustGetValidator(ctx, iterator.Value())
+		newPower := validator.PotentialConsensusPowerByShares()
+		if validator.Jailed || newPower == 0 {
+			break
+		}
+
+		valAddrBytes := getLastValidatorsMapKey(validator.OperatorAddress)
+		if _, found := last[valAddrBytes]; !found {
+			preview.Changes = append(preview.Changes, types.NewValidatorPowerChange(validator.OperatorAddress,
+				validator.Description.Moniker, types.ValidatorSetChangeEntering, 0, newPower))
+		} else if oldPower := k.GetLastValidatorPower(ctx, validator.OperatorAddress); oldPower != newPower {
+			preview.Changes = append(preview.Changes, types.NewValidatorPowerChange(validator.OperatorAddress,
+				validator.Description.Moniker, types.ValidatorSetChangePower, oldPower, newPower))
+		}
+
+		delete(last, valAddrBytes)
+		count++
+		preview.NewTotalPower += newPower
+	}
+
+	for _, valAddrBytes := range sortNoLongerBonded(last) {
+		valAddr := sdk.ValAddress(valAddrBytes)
+		validator := k.mustGetValidator(ctx, valAddr)
+		preview.Changes = append(preview.Changes, types.NewValidatorPowerChange(valAddr,
+			validator.Description.Moniker, types.ValidatorSetChangeLeaving, k.GetLastValidatorPower(ctx, valAddr), 0))
+	}
+
+	return preview
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreviewValidatorSetUpdates(t *testing.T) {
+	ctx, _, mkeeper := CreateTestInput(t, false, 0)
+	keeper := mkeeper.Keeper
+	params := keeper.GetParams(ctx)
+	params.MaxValidators = 2
+	keeper.SetParams(ctx, params)
+	vals := createVals(ctx, 3, keeper)
+
+	_, err := keeper.AddSharesToValidators(ctx, addrDels[0], vals[:1], sdk.NewDec(20000))
+	require.Nil(t, err)
+	_, err = keeper.AddSharesToValidators(ctx, addrDels[1], vals[1:2], sdk.NewDec(10000))
+	require.Nil(t, err)
+
+	// the first two validators enter the validator set
+	preview := keeper.PreviewValidatorSetUpdates(ctx)
+	require.Equal(t, ctx.BlockHeight(), preview.Height)
+	require.Equal(t, keeper.GetTheEndOfEpoch(ctx), preview.EndOfEpoch)
+	require.Equal(t, preview.EndOfEpoch-ctx.BlockHeight(), preview.BlocksToEnd)
+	require.Len(t, preview.Entering(), 2)
+	require.Len(t, preview.Leaving(), 0)
+	require.Equal(t, vals[0].OperatorAddress, preview.Changes[0].OperatorAddress)
+	require.Equal(t, vals[1].OperatorAddress, preview.Changes[1].OperatorAddress)
+	require.True(t, preview.Changes[0].PowerDelta > preview.Changes[1].PowerDelta)
+
+	// the preview changes nothing in the store
+	require.Equal(t, int64(0), keeper.GetLastValidatorPower(ctx, vals[0].OperatorAddress))
+	updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Len(t, updates, 2)
+	require.Equal(t, preview.NewTotalPower, keeper.GetLastTotalPower(ctx).Int64())
+
+	// nothing changes without new shares
+	preview = keeper.PreviewValidatorSetUpdates(ctx)
+	require.Len(t, preview.Changes, 0)
+	require.Equal(t, preview.LastTotalPower, preview.NewTotalPower)
+
+	// the third validator overtakes the second one
+	_, err = keeper.AddSharesToValidators(ctx, addrDels[2], vals[2:], sdk.NewDec(15000))
+	require.Nil(t, err)
+	preview = keeper.PreviewValidatorSetUpdates(ctx)
+	require.Len(t, preview.Changes, 2)
+	entering, leaving := preview.Entering(), preview.Leaving()
+	require.Len(t, entering, 1)
+	require.Equal(t, vals[2].OperatorAddress, entering[0].OperatorAddress)
+	require.Equal(t, entering[0].NewPower, entering[0].PowerDelta)
+	require.Len(t, leaving, 1)
+	require.Equal(t, vals[1].OperatorAddress, leaving[0].OperatorAddress)
+	require.Equal(t, types.ValidatorSetChangeLeaving, leaving[0].Type)
+	require.Equal(t, -leaving[0].OldPower, leaving[0].PowerDelta)
+
+	updates = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Len(t, updates, len(preview.Changes))
+}
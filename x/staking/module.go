@@ -156,7 +156,10 @@ func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
 }
 
 // BeginBlock is invoked on the beginning of each block
-func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	// the proxies registered before the proxy index was introduced are indexed in the first block after the upgrade
+	am.keeper.BuildProxyIndex(ctx)
+}
 
 // EndBlock is invoked on the end of each block
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
@@ -17,6 +17,10 @@ type Delegator struct {
 	IsProxy              bool             `json:"is_proxy" yaml:"is_proxy"`
 	TotalDelegatedTokens sdk.Dec          `json:"total_delegated_tokens" yaml:"total_delegated_tokens"` // total tokens delegated by other delegators
 	ProxyAddress         sdk.AccAddress   `json:"proxy_address" yaml:"proxy_address"`
+	// allocation weights of the shares to ValidatorAddresses, which is empty when the full shares are added to each
+	ShareWeights []sdk.Dec `json:"share_weights" yaml:"share_weights"`
+	// shares added to each of ValidatorAddresses
+	ValidatorShares []sdk.Dec `json:"validator_shares" yaml:"validator_shares"`
 }
 
 // NewDelegator creates a new Delegator object
@@ -29,6 +33,8 @@ func NewDelegator(delAddr sdk.AccAddress) Delegator {
 		false,
 		sdk.ZeroDec(),
 		nil,
+		nil,
+		nil,
 	}
 }
 
@@ -42,6 +48,57 @@ func (d Delegator) GetLastAddedShares() sdk.Dec {
 	return d.Shares
 }
 
+// GetSharesAddedTo gets the shares added to a validator of a delegator for other module
+func (d Delegator) GetSharesAddedTo(valAddr sdk.ValAddress) sdk.Dec {
+	i := d.indexOf(valAddr)
+	if i < 0 {
+		return sdk.ZeroDec()
+	}
+	// the delegators which added shares before the shares of each validator were recorded added the full shares
+	if len(d.ValidatorShares) != len(d.ValidatorAddresses) {
+		return d.Shares
+	}
+
+	return d.ValidatorShares[i]
+}
+
+// AllocateShares returns the shares allocated to a validator from the total shares of a delegator
+func (d Delegator) AllocateShares(valAddr sdk.ValAddress, shares Shares) Shares {
+	i := d.indexOf(valAddr)
+	if i < 0 {
+		return sdk.ZeroDec()
+	}
+	if len(d.ShareWeights) != len(d.ValidatorAddresses) {
+		return shares
+	}
+
+	return WeightShares(shares, d.ShareWeights, i)
+}
+
+// WeightShares returns the shares allocated to the i-th of the validators by the weights summing up to 1, so the shares
+// allocated to all the validators sum up to the total shares and never exceed them
+func WeightShares(shares Shares, weights []sdk.Dec, i int) Shares {
+	return shares.Mul(weights[i])
+}
+
+// SetShares sets the total shares of a delegator and the shares allocated to each validator from it
+func (d *Delegator) SetShares(shares Shares) {
+	d.Shares = shares
+	d.ValidatorShares = make([]sdk.Dec, len(d.ValidatorAddresses))
+	for i, valAddr := range d.ValidatorAddresses {
+		d.ValidatorShares[i] = d.AllocateShares(valAddr, shares)
+	}
+}
+
+func (d Delegator) indexOf(valAddr sdk.ValAddress) int {
+	for i, addr := range d.ValidatorAddresses {
+		if addr.Equals(valAddr) {
+			return i
+		}
+	}
+	return -1
+}
+
 // RegProxy registers or deregisters the identity of proxy
 func (d *Delegator) RegProxy(reg bool) {
 	d.IsProxy = reg
@@ -58,6 +58,13 @@ const (
 	CodeNoDelegatorExisted              uint32 = 67044
 	CodeTargetValsDuplicate             uint32 = 67045
 	CodeAlreadyBound                    uint32 = 67046
+	CodeEmptyCommission                 uint32 = 67047
+	CodeInvalidShareWeights             uint32 = 67048
+	CodeInvalidProxyFeeRate             uint32 = 67049
+	CodeProxyFeeRateUpdateTime          uint32 = 67050
+	CodeVotingPolicyTooLong             uint32 = 67051
+	CodeEmptyProxyEdit                  uint32 = 67052
+	CodeInvalidProxyRankCriterion       uint32 = 67053
 )
 
 // ErrNoValidatorFound returns an error when a validator doesn't exist
@@ -312,3 +319,45 @@ func ErrAlreadyBound(delAddr string) sdk.EnvelopedErr {
 		fmt.Sprintf("failed. %s has already bound a proxy. it's necessary to unbind before proxy register",
 			delAddr))}
 }
+
+// ErrEmptyCommission returns an error when the commission rates of a new validator are not given
+func ErrEmptyCommission() sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeEmptyCommission, "failed. empty commission rates")
+}
+
+// ErrInvalidShareWeights returns an error when the allocation weights of shares don't match the validators or don't
+// add up to 1
+func ErrInvalidShareWeights() sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeInvalidShareWeights,
+		"failed. share weights must be positive, match the validators one by one and add up to 1")
+}
+
+// ErrInvalidProxyFeeRate returns an error when the fee rate of a proxy is not a fraction between 0 and 1
+func ErrInvalidProxyFeeRate(rate string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeInvalidProxyFeeRate,
+		fmt.Sprintf("failed. proxy fee rate must be between 0 and 1, got %s", rate))
+}
+
+// ErrProxyFeeRateUpdateTime returns an error when the fee rate of a proxy is remodified within 24 hours
+func ErrProxyFeeRateUpdateTime() sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeProxyFeeRateUpdateTime,
+		"failed. proxy fee rate cannot be changed more than once in 24h")
+}
+
+// ErrVotingPolicyTooLong returns an error when the voting policy of a proxy exceeds the max length
+func ErrVotingPolicyTooLong(length, maxLength int) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeVotingPolicyTooLong,
+		fmt.Sprintf("failed. voting policy is too long: %d > %d", length, maxLength))
+}
+
+// ErrEmptyProxyEdit returns an error when a proxy edit doesn't modify anything of the proxy profile
+func ErrEmptyProxyEdit() sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeEmptyProxyEdit, "failed. nothing to edit on the proxy profile")
+}
+
+// ErrInvalidProxyRankCriterion returns an error when the proxies are ranked by an unknown criterion
+func ErrInvalidProxyRankCriterion(sortBy string) sdk.Error {
+	return sdkerrors.New(DefaultCodespace, CodeInvalidProxyRankCriterion,
+		fmt.Sprintf("failed. unknown proxy rank criterion %s, expected %s or %s", sortBy,
+			ProxyRankByDelegatedTokens, ProxyRankByParticipation))
+}
@@ -16,7 +16,23 @@ const (
 	AttributeValueCategory        = ModuleName
 
 	EventTypeAddShares = "add_shares"
+	EventTypeEditProxy = "edit_proxy"
+
+	AttributeKeyProxy        = "proxy"
+	AttributeKeyProxyFeeRate = "proxy_fee_rate"
+
+	// the epoch events are emitted in the end blocker and can be subscribed over the tendermint websocket with
+	// the query "tm.event='NewBlock' AND new_epoch.epoch EXISTS"
+	EventTypeNewEpoch    = "new_epoch"
+	EventTypeEpochNotice = "epoch_notice"
+
+	AttributeKeyEpoch              = "epoch"
+	AttributeKeyOldEpoch           = "old_epoch"
+	AttributeKeyEndOfEpoch         = "end_of_epoch"
+	AttributeKeyValidatorUpdates   = "validator_updates"
+	AttributeKeyEnteringValidators = "entering_validators"
+	AttributeKeyLeavingValidators  = "leaving_validators"
 
 	AttributeKeyValidatorToAddShares = "validator_to_add_shares"
-	AttributeKeyShares              = "shares"
+	AttributeKeyShares               = "shares"
 )
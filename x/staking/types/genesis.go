@@ -17,6 +17,7 @@ type GenesisState struct {
 	UnbondingDelegations []UndelegationInfo          `json:"unbonding_delegations" yaml:"unbonding_delegations"`
 	AllShares            []SharesExported            `json:"all_shares" yaml:"all_shares"`
 	ProxyDelegatorKeys   []ProxyDelegatorKeyExported `json:"proxy_delegator_keys" yaml:"proxy_delegator_keys"`
+	ProxyProfiles        []ProxyProfile              `json:"proxy_profiles" yaml:"proxy_profiles"`
 	Exported             bool                        `json:"exported" yaml:"exported"`
 }
 
@@ -61,15 +62,22 @@ type ValidatorExported struct {
 	UnbondingHeight         int64          `json:"unbonding_height"`
 	UnbondingCompletionTime time.Time      `json:"unbonding_time"`
 	MinSelfDelegation       sdk.Dec        `json:"min_self_delegation"`
+	Commission              Commission     `json:"commission"`
 }
 
-// Import converts validator exported format to inner one by filling the zero-value of Tokens and Commission
+// Import converts validator exported format to inner one by filling the zero-value of Tokens, and the commission
+// missing in the genesis exported before the validators could set it is filled with the previous fixed one
 func (ve ValidatorExported) Import() Validator {
 	consPk, err := GetConsPubKeyBech32(ve.ConsPubKey)
 	if err != nil {
 		panic(fmt.Sprintf("failed. consensus pubkey is parsed error: %s", err.Error()))
 	}
 
+	commission := ve.Commission
+	if commission.Rate.IsNil() || commission.MaxRate.IsNil() || commission.MaxChangeRate.IsNil() {
+		commission = NewCommission(sdk.NewDec(1), sdk.NewDec(1), sdk.NewDec(0))
+	}
+
 	return Validator{
 		ve.OperatorAddress,
 		consPk,
@@ -80,7 +88,7 @@ func (ve ValidatorExported) Import() Validator {
 		ve.Description,
 		ve.UnbondingHeight,
 		ve.UnbondingCompletionTime,
-		NewCommission(sdk.NewDec(1), sdk.NewDec(1), sdk.NewDec(0)),
+		commission,
 		ve.MinSelfDelegation,
 	}
 }
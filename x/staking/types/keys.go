@@ -48,6 +48,9 @@ var (
 	UnDelegationInfoKey = []byte{0x53}
 	UnDelegateQueueKey  = []byte{0x54}
 	ProxyKey            = []byte{0x55}
+	ProxyProfileKey     = []byte{0x56}
+	ProxyIndexKey       = []byte{0x57} // prefix for each key to a proxy index, by proxy address
+	ProxyIndexBuiltKey  = []byte{0x58} // key for the mark of the proxy index built for the existing proxies
 
 	// prefix key for vals info to enforce the update of validator-set
 	ValidatorAbandonedKey = []byte{0x60}
@@ -126,6 +129,18 @@ func GetProxyDelegatorKey(proxyAddr, delAddr sdk.AccAddress) []byte {
 	return append(append(ProxyKey, proxyAddr...), delAddr...)
 }
 
+// GetProxyProfileKey gets the key for the profile of a proxy
+// VALUE: staking/ProxyProfile
+func GetProxyProfileKey(proxyAddr sdk.AccAddress) []byte {
+	return append(ProxyProfileKey, proxyAddr.Bytes()...)
+}
+
+// GetProxyIndexKey gets the key for the index of a registered proxy
+// VALUE: none (key rearrangement used)
+func GetProxyIndexKey(proxyAddr sdk.AccAddress) []byte {
+	return append(ProxyIndexKey, proxyAddr.Bytes()...)
+}
+
 // GetSharesKey gets the whole key for an item of shares info
 func GetSharesKey(valAddr sdk.ValAddress, delAddr sdk.AccAddress) []byte {
 	return append(GetSharesToValidatorsKey(valAddr), delAddr.Bytes()...)
@@ -18,28 +18,28 @@ var (
 
 // MsgCreateValidator - struct for bonding transactions
 type MsgCreateValidator struct {
-	Description Description `json:"description" yaml:"description"`
-	//Commission        CommissionRates `json:"commission" yaml:"commission"`
-	MinSelfDelegation sdk.SysCoin    `json:"min_self_delegation" yaml:"min_self_delegation"`
-	DelegatorAddress  sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
-	ValidatorAddress  sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
-	PubKey            crypto.PubKey  `json:"pubkey" yaml:"pubkey"`
+	Description       Description     `json:"description" yaml:"description"`
+	MinSelfDelegation sdk.SysCoin     `json:"min_self_delegation" yaml:"min_self_delegation"`
+	DelegatorAddress  sdk.AccAddress  `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress  sdk.ValAddress  `json:"validator_address" yaml:"validator_address"`
+	PubKey            crypto.PubKey   `json:"pubkey" yaml:"pubkey"`
+	Commission        CommissionRates `json:"commission" yaml:"commission"`
 }
 
 type msgCreateValidatorJSON struct {
-	Description Description `json:"description" yaml:"description"`
-	//Commission        CommissionRates `json:"commission" yaml:"commission"`
-	MinSelfDelegation sdk.SysCoin    `json:"min_self_delegation" yaml:"min_self_delegation"`
-	DelegatorAddress  sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
-	ValidatorAddress  sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
-	PubKey            string         `json:"pubkey" yaml:"pubkey"`
+	Description       Description     `json:"description" yaml:"description"`
+	MinSelfDelegation sdk.SysCoin     `json:"min_self_delegation" yaml:"min_self_delegation"`
+	DelegatorAddress  sdk.AccAddress  `json:"delegator_address" yaml:"delegator_address"`
+	ValidatorAddress  sdk.ValAddress  `json:"validator_address" yaml:"validator_address"`
+	PubKey            string          `json:"pubkey" yaml:"pubkey"`
+	Commission        CommissionRates `json:"commission" yaml:"commission"`
 }
 
 // NewMsgCreateValidator creates a msg of create-validator
 // Delegator address and validator address are the same
 func NewMsgCreateValidator(
 	valAddr sdk.ValAddress, pubKey crypto.PubKey,
-	description Description, minSelfDelegation sdk.SysCoin,
+	description Description, commission CommissionRates, minSelfDelegation sdk.SysCoin,
 ) MsgCreateValidator {
 
 	return MsgCreateValidator{
@@ -48,6 +48,7 @@ func NewMsgCreateValidator(
 		ValidatorAddress:  valAddr,
 		PubKey:            pubKey,
 		MinSelfDelegation: minSelfDelegation,
+		Commission:        commission,
 	}
 }
 
@@ -77,6 +78,7 @@ func (msg MsgCreateValidator) MarshalJSON() ([]byte, error) {
 		ValidatorAddress:  msg.ValidatorAddress,
 		PubKey:            MustBech32ifyConsPub(msg.PubKey),
 		MinSelfDelegation: msg.MinSelfDelegation,
+		Commission:        msg.Commission,
 	})
 }
 
@@ -96,6 +98,7 @@ func (msg *MsgCreateValidator) UnmarshalJSON(bz []byte) error {
 		return ErrGetConsPubKeyBech32()
 	}
 	msg.MinSelfDelegation = msgCreateValJSON.MinSelfDelegation
+	msg.Commission = msgCreateValJSON.Commission
 
 	return nil
 }
@@ -124,21 +127,27 @@ func (msg MsgCreateValidator) ValidateBasic() error {
 	if msg.Description == (Description{}) {
 		return ErrDescriptionIsEmpty()
 	}
+	if msg.Commission.Rate.IsNil() || msg.Commission.MaxRate.IsNil() || msg.Commission.MaxChangeRate.IsNil() {
+		return ErrEmptyCommission()
+	}
 
-	return nil
+	return msg.Commission.Validate()
 }
 
 // MsgEditValidator - struct for editing a validator
 type MsgEditValidator struct {
 	Description
 	ValidatorAddress sdk.ValAddress `json:"address" yaml:"address"`
+	// the new commission rate, which is kept if it's nil
+	CommissionRate *sdk.Dec `json:"commission_rate" yaml:"commission_rate"`
 }
 
 // NewMsgEditValidator creates a msg of edit-validator
-func NewMsgEditValidator(valAddr sdk.ValAddress, description Description) MsgEditValidator {
+func NewMsgEditValidator(valAddr sdk.ValAddress, description Description, newRate *sdk.Dec) MsgEditValidator {
 	return MsgEditValidator{
 		Description:      description,
 		ValidatorAddress: valAddr,
+		CommissionRate:   newRate,
 	}
 }
 
@@ -161,9 +170,18 @@ func (msg MsgEditValidator) ValidateBasic() error {
 		return ErrNilValidatorAddr()
 	}
 
-	if msg.Description == (Description{}) {
+	if msg.Description == (Description{}) && msg.CommissionRate == nil {
 		return ErrNilValidatorAddr()
 	}
 
+	if msg.CommissionRate != nil {
+		if msg.CommissionRate.GT(sdk.OneDec()) {
+			return ErrCommissionHuge()
+		}
+		if msg.CommissionRate.LT(sdk.ZeroDec()) {
+			return ErrCommissionNegative()
+		}
+	}
+
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 // ensure Msg interface compliance at compile time
 var (
 	_ sdk.Msg = (*MsgAddShares)(nil)
+	_ sdk.Msg = (*MsgAddSharesWeighted)(nil)
 	_ sdk.Msg = (*MsgDestroyValidator)(nil)
 )
 
@@ -113,6 +114,62 @@ func (msg MsgRegProxy) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bytes)
 }
 
+// MsgEditProxy - struct for editing the profile of a proxy
+type MsgEditProxy struct {
+	ProxyAddress sdk.AccAddress `json:"proxy_address" yaml:"proxy_address"`
+	Description  Description    `json:"description" yaml:"description"`
+	// the new fee rate and voting policy, which are kept if they're nil
+	FeeRate      *sdk.Dec `json:"fee_rate" yaml:"fee_rate"`
+	VotingPolicy *string  `json:"voting_policy" yaml:"voting_policy"`
+}
+
+// NewMsgEditProxy creates a msg of editing the proxy profile
+func NewMsgEditProxy(proxyAddress sdk.AccAddress, description Description, feeRate *sdk.Dec,
+	votingPolicy *string) MsgEditProxy {
+	return MsgEditProxy{
+		ProxyAddress: proxyAddress,
+		Description:  description,
+		FeeRate:      feeRate,
+		VotingPolicy: votingPolicy,
+	}
+}
+
+// nolint
+func (MsgEditProxy) Route() string { return RouterKey }
+func (MsgEditProxy) Type() string  { return "edit_proxy" }
+func (msg MsgEditProxy) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.ProxyAddress}
+}
+
+// ValidateBasic gives a quick validity check
+func (msg MsgEditProxy) ValidateBasic() error {
+	if msg.ProxyAddress.Empty() {
+		return ErrNilDelegatorAddr()
+	}
+
+	if msg.Description == (Description{}) && msg.FeeRate == nil && msg.VotingPolicy == nil {
+		return ErrEmptyProxyEdit()
+	}
+
+	if msg.FeeRate != nil {
+		if err := ValidateProxyFeeRate(*msg.FeeRate); err != nil {
+			return err
+		}
+	}
+
+	if msg.VotingPolicy != nil && len(*msg.VotingPolicy) > MaxVotingPolicyLength {
+		return ErrVotingPolicyTooLong(len(*msg.VotingPolicy), MaxVotingPolicyLength)
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over
+func (msg MsgEditProxy) GetSignBytes() []byte {
+	bytes := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bytes)
+}
+
 // MsgBindProxy - structure for bind proxy relationship between the delegator and the proxy
 type MsgBindProxy struct {
 	DelAddr      sdk.AccAddress `json:"delegator_address" yaml:"delegator_address"`
@@ -153,7 +210,7 @@ func (msg MsgBindProxy) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bytes)
 }
 
-// MsgAddShares - struct for adding-shares transaction
+// MsgAddShares - struct for adding-shares transaction, which adds the full shares of the delegator to each validator
 type MsgAddShares struct {
 	DelAddr  sdk.AccAddress   `json:"delegator_address" yaml:"delegator_address"`
 	ValAddrs []sdk.ValAddress `json:"validator_addresses" yaml:"validator_addresses"`
@@ -197,6 +254,61 @@ func (msg MsgAddShares) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bytes)
 }
 
+// MsgAddSharesWeighted - struct for adding-shares transaction which allocates the shares to validators by weights.
+// The weights sum up to 1, so the shares allocated to the validators sum up to the total shares of the delegator
+type MsgAddSharesWeighted struct {
+	DelAddr  sdk.AccAddress   `json:"delegator_address" yaml:"delegator_address"`
+	ValAddrs []sdk.ValAddress `json:"validator_addresses" yaml:"validator_addresses"`
+	Weights  []sdk.Dec        `json:"weights" yaml:"weights"`
+}
+
+// NewMsgAddSharesWeighted creates a msg of adding shares to vals by the allocation weights
+func NewMsgAddSharesWeighted(delAddr sdk.AccAddress, valAddrs []sdk.ValAddress, weights []sdk.Dec,
+) MsgAddSharesWeighted {
+	return MsgAddSharesWeighted{
+		DelAddr:  delAddr,
+		ValAddrs: valAddrs,
+		Weights:  weights,
+	}
+}
+
+// nolint
+func (MsgAddSharesWeighted) Route() string { return RouterKey }
+func (MsgAddSharesWeighted) Type() string  { return "add_weighted_shares_to_validators" }
+func (msg MsgAddSharesWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelAddr}
+}
+
+// ValidateBasic gives a quick validity check
+func (msg MsgAddSharesWeighted) ValidateBasic() error {
+	if err := NewMsgAddShares(msg.DelAddr, msg.ValAddrs).ValidateBasic(); err != nil {
+		return err
+	}
+
+	if len(msg.Weights) != len(msg.ValAddrs) {
+		return ErrInvalidShareWeights()
+	}
+
+	totalWeight := sdk.ZeroDec()
+	for _, weight := range msg.Weights {
+		if weight.IsNil() || !weight.IsPositive() {
+			return ErrInvalidShareWeights()
+		}
+		totalWeight = totalWeight.Add(weight)
+	}
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return ErrInvalidShareWeights()
+	}
+
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over
+func (msg MsgAddSharesWeighted) GetSignBytes() []byte {
+	bytes := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bytes)
+}
+
 func isValsDuplicate(valAddrs []sdk.ValAddress) bool {
 	lenAddrs := len(valAddrs)
 	filter := make(map[string]struct{}, lenAddrs)
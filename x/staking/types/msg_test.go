@@ -1,16 +1,20 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto"
 )
 
 var (
-	coinPos  = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
-	coinZero = sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)
+	coinPos     = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	coinZero    = sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)
+	commission1 = NewCommissionRates(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
+	commission2 = NewCommissionRates(sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
 )
 
 // test ValidateBasic for MsgCreateValidator
@@ -18,6 +22,7 @@ func TestMsgCreateValidator(t *testing.T) {
 	addr1 := valAddr1
 	tests := []struct {
 		name, moniker, identity, website, details string
+		commission                                CommissionRates
 		minSelfDelegation                         sdk.Int
 		validatorAddr                             sdk.ValAddress
 		delegatorAddr                             sdk.AccAddress
@@ -25,15 +30,17 @@ func TestMsgCreateValidator(t *testing.T) {
 		bond                                      sdk.Coin
 		expectPass                                bool
 	}{
-		{"empty bond", "a", "b", "c", "d", sdk.OneInt(), addr1, dlgAddr1, pk1, coinZero, true},
-		{"zero min self delegation", "a", "b", "c", "d", sdk.ZeroInt(), addr1, dlgAddr1, pk1, coinPos, false},
-		{"basic good", "a", "b", "c", "d", sdk.OneInt(), addr1, dlgAddr1, pk1, coinPos, true},
-		{"partial description", "", "", "c", "", sdk.OneInt(), addr1, dlgAddr1, pk1, coinPos, true},
-		{"empty description", "", "", "", "", sdk.OneInt(), addr1, dlgAddr1, pk1, coinPos, false},
-		{"empty address1", "a", "b", "c", "d", sdk.OneInt(), emptyAddr, dlgAddr1, pk1, coinPos, false},
-		{"empty address2", "a", "b", "c", "d", sdk.OneInt(), nil, nil, pk1, coinPos, false},
-		{"valAddr dlgAddr not equals", "a", "b", "c", "d", sdk.OneInt(), addr1, dlgAddr2, pk1, coinPos, false},
-		{"empty pubkey", "a", "b", "c", "d", sdk.OneInt(), addr1, dlgAddr1, emptyPubkey, coinPos, true},
+		{"empty bond", "a", "b", "c", "d", commission1, sdk.OneInt(), addr1, dlgAddr1, pk1, coinZero, true},
+		{"zero min self delegation", "a", "b", "c", "d", commission1, sdk.ZeroInt(), addr1, dlgAddr1, pk1, coinPos, false},
+		{"basic good", "a", "b", "c", "d", commission1, sdk.OneInt(), addr1, dlgAddr1, pk1, coinPos, true},
+		{"partial description", "", "", "c", "", commission1, sdk.OneInt(), addr1, dlgAddr1, pk1, coinPos, true},
+		{"empty description", "", "", "", "", commission1, sdk.OneInt(), addr1, dlgAddr1, pk1, coinPos, false},
+		{"empty address1", "a", "b", "c", "d", commission1, sdk.OneInt(), emptyAddr, dlgAddr1, pk1, coinPos, false},
+		{"empty address2", "a", "b", "c", "d", commission1, sdk.OneInt(), nil, nil, pk1, coinPos, false},
+		{"valAddr dlgAddr not equals", "a", "b", "c", "d", commission1, sdk.OneInt(), addr1, dlgAddr2, pk1, coinPos, false},
+		{"empty pubkey", "a", "b", "c", "d", commission1, sdk.OneInt(), addr1, dlgAddr1, emptyPubkey, coinPos, true},
+		{"empty commission", "a", "b", "c", "d", CommissionRates{}, sdk.OneInt(), addr1, dlgAddr1, pk1, coinPos, false},
+		{"rate greater than max rate", "a", "b", "c", "d", commission2, sdk.OneInt(), addr1, dlgAddr1, pk1, coinPos, false},
 		//{"negative min self delegation", "a", "b", "c", "d", commission1, sdk.NewInt(-1), addr1, pk1, coinPos, false},
 		//{"delegation less than min self delegation", "a", "b", "c", "d", commission1, coinPos.Amount.Add(sdk.OneInt()), addr1, pk1, coinPos, false},
 	}
@@ -48,6 +55,7 @@ func TestMsgCreateValidator(t *testing.T) {
 			ValidatorAddress:  tc.validatorAddr,
 			PubKey:            tc.pubkey,
 			MinSelfDelegation: coin,
+			Commission:        tc.commission,
 		}
 
 		if tc.expectPass {
@@ -86,7 +94,7 @@ func TestMsgCreateValidator_Smoke(t *testing.T) {
 	msd := sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(2000))
 
 	msg := NewMsgCreateValidator(valAddr1, pk1,
-		NewDescription("my moniker", "my identity", "my website", "my details"), msd,
+		NewDescription("my moniker", "my identity", "my website", "my details"), commission1, msd,
 	)
 	require.Contains(t, msg.Route(), RouterKey)
 	require.Contains(t, msg.Type(), "create_validator")
@@ -104,22 +112,57 @@ func TestMsgCreateValidator_Smoke(t *testing.T) {
 	require.NotNil(t, err3)
 }
 
+// the msgs of the old layout without the commission are still decoded, since the commission is the last field in amino
+func TestMsgCreateValidator_AminoCompatibility(t *testing.T) {
+	type legacyMsgCreateValidator struct {
+		Description       Description
+		MinSelfDelegation sdk.SysCoin
+		DelegatorAddress  sdk.AccAddress
+		ValidatorAddress  sdk.ValAddress
+		PubKey            crypto.PubKey
+	}
+	legacyCdc := codec.New()
+	codec.RegisterCrypto(legacyCdc)
+	legacyCdc.RegisterConcrete(legacyMsgCreateValidator{}, "okexchain/staking/MsgCreateValidator", nil)
+
+	legacyMsg := legacyMsgCreateValidator{
+		Description:       NewDescription("my moniker", "my identity", "my website", "my details"),
+		MinSelfDelegation: sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDec(2000)),
+		DelegatorAddress:  sdk.AccAddress(valAddr1),
+		ValidatorAddress:  valAddr1,
+		PubKey:            pk1,
+	}
+	var msg MsgCreateValidator
+	require.NoError(t, ModuleCdc.UnmarshalBinaryBare(legacyCdc.MustMarshalBinaryBare(legacyMsg), &msg))
+	require.Equal(t, legacyMsg.Description, msg.Description)
+	require.Equal(t, legacyMsg.MinSelfDelegation, msg.MinSelfDelegation)
+	require.Equal(t, legacyMsg.DelegatorAddress, msg.DelegatorAddress)
+	require.Equal(t, legacyMsg.ValidatorAddress, msg.ValidatorAddress)
+	require.Equal(t, legacyMsg.PubKey, msg.PubKey)
+	require.Equal(t, CommissionRates{}, msg.Commission)
+}
+
 // test ValidateBasic for MsgEditValidator
 func TestMsgEditValidator(t *testing.T) {
+	negativeRate, hugeRate := sdk.NewDec(-1), sdk.NewDec(2)
 	tests := []struct {
 		name, moniker, identity, website, details string
 		validatorAddr                             sdk.ValAddress
+		newRate                                   *sdk.Dec
 		expectPass                                bool
 	}{
-		{"basic good", "a", "b", "c", "d", valAddr1, true},
-		{"partial description", "", "", "c", "", valAddr1, true},
-		{"empty description", "", "", "", "", valAddr1, false},
-		{"empty address", "a", "b", "c", "d", emptyAddr, false},
+		{"basic good", "a", "b", "c", "d", valAddr1, nil, true},
+		{"partial description", "", "", "c", "", valAddr1, nil, true},
+		{"empty description", "", "", "", "", valAddr1, nil, false},
+		{"empty address", "a", "b", "c", "d", emptyAddr, nil, false},
+		{"commission rate only", "", "", "", "", valAddr1, &commission1.Rate, true},
+		{"negative commission rate", "a", "b", "c", "d", valAddr1, &negativeRate, false},
+		{"commission rate greater than 1", "a", "b", "c", "d", valAddr1, &hugeRate, false},
 	}
 
 	for _, tc := range tests {
 		description := NewDescription(tc.moniker, tc.identity, tc.website, tc.details)
-		msg := NewMsgEditValidator(tc.validatorAddr, description)
+		msg := NewMsgEditValidator(tc.validatorAddr, description, tc.newRate)
 		if tc.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
 			checkMsg(t, msg, "edit_validator")
@@ -265,6 +308,38 @@ func TestMsgRegProxy(t *testing.T) {
 
 }
 
+func TestMsgEditProxy(t *testing.T) {
+	rate, negativeRate, hugeRate := sdk.NewDecWithPrec(5, 2), sdk.NewDec(-1), sdk.NewDec(2)
+	policy, longPolicy := "vote yes on upgrades", strings.Repeat("x", MaxVotingPolicyLength+1)
+
+	tests := []struct {
+		name         string
+		proxyAddr    sdk.AccAddress
+		description  Description
+		feeRate      *sdk.Dec
+		votingPolicy *string
+		expectPass   bool
+	}{
+		{"basic good", dlgAddr1, NewDescription("proxy", "", "", ""), &rate, &policy, true},
+		{"only fee rate", dlgAddr1, Description{}, &rate, nil, true},
+		{"empty proxy", sdk.AccAddress(emptyAddr), Description{}, &rate, nil, false},
+		{"nothing to edit", dlgAddr1, Description{}, nil, nil, false},
+		{"negative fee rate", dlgAddr1, Description{}, &negativeRate, nil, false},
+		{"fee rate greater than 1", dlgAddr1, Description{}, &hugeRate, nil, false},
+		{"voting policy too long", dlgAddr1, Description{}, nil, &longPolicy, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgEditProxy(tc.proxyAddr, tc.description, tc.feeRate, tc.votingPolicy)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+			checkMsg(t, msg, "edit_proxy")
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
 func TestMsgAddShares(t *testing.T) {
 
 	tests := []struct {
@@ -292,6 +367,38 @@ func TestMsgAddShares(t *testing.T) {
 
 }
 
+func TestMsgAddSharesWeighted(t *testing.T) {
+	weight := func(s string) sdk.Dec { return sdk.MustNewDecFromStr(s) }
+	tests := []struct {
+		name       string
+		dlgAddr    sdk.AccAddress
+		valAddrs   []sdk.ValAddress
+		weights    []sdk.Dec
+		expectPass bool
+	}{
+		{"basic good", dlgAddr1, []sdk.ValAddress{valAddr1}, []sdk.Dec{weight("1")}, true},
+		{"basic good2", dlgAddr1, []sdk.ValAddress{valAddr1, valAddr2}, []sdk.Dec{weight("0.7"), weight("0.3")}, true},
+		{"duplicate", dlgAddr1, []sdk.ValAddress{valAddr1, valAddr1}, []sdk.Dec{weight("0.5"), weight("0.5")}, false},
+		{"empty delegator", nil, []sdk.ValAddress{valAddr1}, []sdk.Dec{weight("1")}, false},
+		{"weights mismatched", dlgAddr1, []sdk.ValAddress{valAddr1, valAddr2}, []sdk.Dec{weight("1")}, false},
+		{"zero weight", dlgAddr1, []sdk.ValAddress{valAddr1, valAddr2}, []sdk.Dec{weight("1"), weight("0")}, false},
+		{"negative weight", dlgAddr1, []sdk.ValAddress{valAddr1, valAddr2}, []sdk.Dec{weight("1.5"), weight("-0.5")},
+			false},
+		{"weights less than 1", dlgAddr1, []sdk.ValAddress{valAddr1, valAddr2}, []sdk.Dec{weight("0.5"), weight("0.3")},
+			false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgAddSharesWeighted(tc.dlgAddr, tc.valAddrs, tc.weights)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
+			checkMsg(t, msg, "add_weighted_shares_to_validators")
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
+		}
+	}
+}
+
 //// test ValidateBasic for MsgUnbond
 //func TestMsgBeginRedelegate(t *testing.T) {
 //	tests := []struct {
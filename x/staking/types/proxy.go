@@ -0,0 +1,143 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	MaxVotingPolicyLength = 280
+
+	ProxyRankByDelegatedTokens = "delegated_tokens"
+	ProxyRankByParticipation   = "participation"
+)
+
+// ProxyProfile is the public profile of a proxy for the delegators to compare the proxies before binding
+type ProxyProfile struct {
+	ProxyAddress sdk.AccAddress `json:"proxy_address" yaml:"proxy_address"`
+	Description  Description    `json:"description" yaml:"description"`
+	// the fraction of the rewards withdrawn by the bound delegators taken by the proxy
+	FeeRate sdk.Dec `json:"fee_rate" yaml:"fee_rate"`
+	// the last time the fee rate was changed
+	FeeRateUpdateTime time.Time `json:"fee_rate_update_time" yaml:"fee_rate_update_time"`
+	VotingPolicy      string    `json:"voting_policy" yaml:"voting_policy"`
+	// the amount of the ended proposals the proxy voted on and could vote on since its registration
+	VotedProposals uint64 `json:"voted_proposals" yaml:"voted_proposals"`
+	EndedProposals uint64 `json:"ended_proposals" yaml:"ended_proposals"`
+}
+
+// NewProxyProfile creates a new profile without fee of a proxy
+func NewProxyProfile(proxyAddr sdk.AccAddress) ProxyProfile {
+	return ProxyProfile{
+		ProxyAddress:      proxyAddr,
+		FeeRate:           sdk.ZeroDec(),
+		FeeRateUpdateTime: time.Unix(0, 0).UTC(),
+	}
+}
+
+// Participation returns the fraction of the ended proposals that the proxy voted on
+func (p ProxyProfile) Participation() sdk.Dec {
+	if p.EndedProposals == 0 {
+		return sdk.ZeroDec()
+	}
+
+	return sdk.NewDec(int64(p.VotedProposals)).QuoInt64(int64(p.EndedProposals))
+}
+
+// ValidateNewFeeRate performs basic sanity validation checks of a new fee rate of the proxy
+func (p ProxyProfile) ValidateNewFeeRate(newRate sdk.Dec, blockTime time.Time) error {
+	if blockTime.Sub(p.FeeRateUpdateTime).Hours() < DefaultValidateRateUpdateInterval {
+		// new rate cannot be changed more than once within 24 hours
+		return ErrProxyFeeRateUpdateTime()
+	}
+
+	return ValidateProxyFeeRate(newRate)
+}
+
+// String returns a human readable string representation of a proxy profile
+func (p ProxyProfile) String() string {
+	return fmt.Sprintf(`Proxy Profile
+  Proxy Address:          %s
+  Moniker:                %s
+  Identity:               %s
+  Website:                %s
+  Details:                %s
+  Fee Rate:               %s
+  Fee Rate Update Time:   %s
+  Voting Policy:          %s
+  Voted Proposals:        %d
+  Ended Proposals:        %d`,
+		p.ProxyAddress, p.Description.Moniker, p.Description.Identity, p.Description.Website,
+		p.Description.Details, p.FeeRate, p.FeeRateUpdateTime, p.VotingPolicy, p.VotedProposals,
+		p.EndedProposals)
+}
+
+// ValidateProxyFeeRate checks whether the fee rate of a proxy is a valid fraction
+func ValidateProxyFeeRate(rate sdk.Dec) error {
+	if rate.IsNil() || rate.IsNegative() || rate.GT(sdk.OneDec()) {
+		return ErrInvalidProxyFeeRate(rate.String())
+	}
+
+	return nil
+}
+
+// ProxyRank is the ranking entry of a proxy with its profile, the tokens bound to it and its vote participation
+type ProxyRank struct {
+	Profile              ProxyProfile `json:"profile" yaml:"profile"`
+	TotalDelegatedTokens sdk.Dec      `json:"total_delegated_tokens" yaml:"total_delegated_tokens"`
+	Participation        sdk.Dec      `json:"participation" yaml:"participation"`
+}
+
+// NewProxyRank creates a new ranking entry of a proxy
+func NewProxyRank(profile ProxyProfile, totalDelegatedTokens sdk.Dec) ProxyRank {
+	return ProxyRank{
+		Profile:              profile,
+		TotalDelegatedTokens: totalDelegatedTokens,
+		Participation:        profile.Participation(),
+	}
+}
+
+// ProxyRanks is a collection of ProxyRank
+type ProxyRanks []ProxyRank
+
+// String returns a human readable string representation of the proxy ranks
+func (pr ProxyRanks) String() string {
+	var sb strings.Builder
+	for i, rank := range pr {
+		sb.WriteString(fmt.Sprintf("#%d %s (delegated tokens: %s, participation: %s, fee rate: %s)\n",
+			i+1, rank.Profile.ProxyAddress, rank.TotalDelegatedTokens, rank.Participation, rank.Profile.FeeRate))
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+// Sort sorts the proxy ranks in descending order by the given criterion, and the ties are broken by the proxy address
+func (pr ProxyRanks) Sort(sortBy string) error {
+	var greater func(i, j int) (gt, eq bool)
+	switch sortBy {
+	case ProxyRankByDelegatedTokens:
+		greater = func(i, j int) (bool, bool) {
+			return pr[i].TotalDelegatedTokens.GT(pr[j].TotalDelegatedTokens),
+				pr[i].TotalDelegatedTokens.Equal(pr[j].TotalDelegatedTokens)
+		}
+	case ProxyRankByParticipation:
+		greater = func(i, j int) (bool, bool) {
+			return pr[i].Participation.GT(pr[j].Participation), pr[i].Participation.Equal(pr[j].Participation)
+		}
+	default:
+		return ErrInvalidProxyRankCriterion(sortBy)
+	}
+
+	sort.SliceStable(pr, func(i, j int) bool {
+		gt, eq := greater(i, j)
+		if eq {
+			return pr[i].Profile.ProxyAddress.String() < pr[j].Profile.ProxyAddress.String()
+		}
+		return gt
+	})
+	return nil
+}
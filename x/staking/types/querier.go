@@ -17,6 +17,9 @@ const (
 	QueryProxy               = "proxy"
 	QueryValidatorAllShares  = "validatorAllShares"
 	QueryDelegator           = "delegator"
+	QueryProxyProfile        = "proxyProfile"
+	QueryProxyRanks          = "proxyRanks"
+	QueryValidatorSetPreview = "validatorSetPreview"
 )
 
 // QueryDelegatorParams defines the params for the following queries:
@@ -78,3 +81,15 @@ type QueryValidatorsParams struct {
 func NewQueryValidatorsParams(page, limit int, status string) QueryValidatorsParams {
 	return QueryValidatorsParams{page, limit, status}
 }
+
+// QueryProxyRanksParams defines the params for the following queries:
+// - 'custom/staking/proxyRanks'
+type QueryProxyRanksParams struct {
+	Page, Limit int
+	SortBy      string
+}
+
+// NewQueryProxyRanksParams creates a new instance of QueryProxyRanksParams
+func NewQueryProxyRanksParams(page, limit int, sortBy string) QueryProxyRanksParams {
+	return QueryProxyRanksParams{page, limit, sortBy}
+}
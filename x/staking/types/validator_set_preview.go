@@ -0,0 +1,103 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	// the amount of blocks ahead of the end of an epoch to emit the upcoming epoch event
+	EpochNoticeBlocks int64 = 10
+
+	ValidatorSetChangeEntering = "entering"
+	ValidatorSetChangeLeaving  = "leaving"
+	ValidatorSetChangePower    = "power"
+)
+
+// ValidatorPowerChange is a change of the consensus power of a validator at the end of the epoch
+type ValidatorPowerChange struct {
+	OperatorAddress sdk.ValAddress `json:"operator_address" yaml:"operator_address"`
+	Moniker         string         `json:"moniker" yaml:"moniker"`
+	Type            string         `json:"type" yaml:"type"`
+	OldPower        int64          `json:"old_power" yaml:"old_power"`
+	NewPower        int64          `json:"new_power" yaml:"new_power"`
+	PowerDelta      int64          `json:"power_delta" yaml:"power_delta"`
+}
+
+// NewValidatorPowerChange creates a new change of the consensus power of a validator
+func NewValidatorPowerChange(operator sdk.ValAddress, moniker, changeType string, oldPower,
+	newPower int64) ValidatorPowerChange {
+	return ValidatorPowerChange{
+		OperatorAddress: operator,
+		Moniker:         moniker,
+		Type:            changeType,
+		OldPower:        oldPower,
+		NewPower:        newPower,
+		PowerDelta:      newPower - oldPower,
+	}
+}
+
+// String returns a human readable string representation of a validator power change
+func (c ValidatorPowerChange) String() string {
+	return fmt.Sprintf("%s %s (%s): %d -> %d (%+d)", c.Type, c.OperatorAddress, c.Moniker, c.OldPower,
+		c.NewPower, c.PowerDelta)
+}
+
+// ValidatorSetPreview is the simulation of the validator set elected at the end of the current epoch from the
+// current shares
+type ValidatorSetPreview struct {
+	Height int64 `json:"height" yaml:"height"`
+	// the epoch in effect and the one taking effect after the current epoch ends
+	Epoch          uint16 `json:"epoch" yaml:"epoch"`
+	NextEpoch      uint16 `json:"next_epoch" yaml:"next_epoch"`
+	EndOfLastEpoch int64  `json:"end_of_last_epoch" yaml:"end_of_last_epoch"`
+	EndOfEpoch     int64  `json:"end_of_epoch" yaml:"end_of_epoch"`
+	BlocksToEnd    int64  `json:"blocks_to_end" yaml:"blocks_to_end"`
+
+	LastTotalPower int64                  `json:"last_total_power" yaml:"last_total_power"`
+	NewTotalPower  int64                  `json:"new_total_power" yaml:"new_total_power"`
+	Changes        []ValidatorPowerChange `json:"changes" yaml:"changes"`
+}
+
+// Entering returns the validators joining the validator set at the end of the epoch
+func (p ValidatorSetPreview) Entering() []ValidatorPowerChange {
+	return p.filter(ValidatorSetChangeEntering)
+}
+
+// Leaving returns the validators dropping out of the validator set at the end of the epoch
+func (p ValidatorSetPreview) Leaving() []ValidatorPowerChange {
+	return p.filter(ValidatorSetChangeLeaving)
+}
+
+func (p ValidatorSetPreview) filter(changeType string) (changes []ValidatorPowerChange) {
+	for _, change := range p.Changes {
+		if change.Type == changeType {
+			changes = append(changes, change)
+		}
+	}
+	return
+}
+
+// String returns a human readable string representation of a validator set preview
+func (p ValidatorSetPreview) String() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf(`Validator Set Preview
+  Height:               %d
+  Epoch:                %d
+  Next Epoch:           %d
+  End Of Last Epoch:    %d
+  End Of Epoch:         %d
+  Blocks To End:        %d
+  Last Total Power:     %d
+  New Total Power:      %d
+  Changes:`,
+		p.Height, p.Epoch, p.NextEpoch, p.EndOfLastEpoch, p.EndOfEpoch, p.BlocksToEnd, p.LastTotalPower,
+		p.NewTotalPower))
+	for _, change := range p.Changes {
+		sb.WriteString("\n    " + change.String())
+	}
+	return sb.String()
+}
@@ -28,6 +28,7 @@ func (v Validator) Export() ValidatorExported {
 		v.UnbondingHeight,
 		v.UnbondingCompletionTime,
 		v.MinSelfDelegation,
+		v.Commission,
 	}
 }
 
@@ -43,6 +44,7 @@ func (v Validator) Standardize() StandardizedValidator {
 		v.UnbondingHeight,
 		v.UnbondingCompletionTime,
 		v.MinSelfDelegation,
+		v.Commission,
 	}
 }
 
@@ -79,6 +81,7 @@ type StandardizedValidator struct {
 	UnbondingHeight         int64          `json:"unbonding_height" yaml:"unbonding_height"`
 	UnbondingCompletionTime time.Time      `json:"unbonding_time" yaml:"unbonding_time"`
 	MinSelfDelegation       sdk.Dec        `json:"min_self_delegation" yaml:"min_self_delegation"`
+	Commission              Commission     `json:"commission" yaml:"commission"`
 }
 
 // String returns a human readable string representation of a StandardizeValidator
@@ -97,10 +100,11 @@ func (sv StandardizedValidator) String() string {
   Description:                %s
   Unbonding Height:           %d
   Unbonding Completion Time:  %v
-  Minimum Self Delegation:    %v`,
+  Minimum Self Delegation:    %v
+  Commission:                 %s`,
 		sv.OperatorAddress, bechConsPubkey, sv.Jailed, sv.Status,
 		sv.DelegatorShares, sv.Description, sv.UnbondingHeight,
-		sv.UnbondingCompletionTime, sv.MinSelfDelegation)
+		sv.UnbondingCompletionTime, sv.MinSelfDelegation, sv.Commission)
 }
 
 // MarshalYAML implememts the text format for yaml marshaling
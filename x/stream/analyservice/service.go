@@ -19,3 +19,15 @@ func NewMysqlORM(url string) *backend.ORM {
 	}
 	return mysqlOrm
 }
+
+func NewPostgresORM(url string) *backend.ORM {
+	engineInfo := backend.OrmEngineInfo{
+		EngineType: orm.EngineTypePostgres,
+		ConnectStr: url,
+	}
+	postgresOrm, err := backend.NewORM(false, &engineInfo, nil)
+	if err != nil {
+		fmt.Println("error: ", err)
+	}
+	return postgresOrm
+}
@@ -11,24 +11,25 @@ import (
 
 // the data enqueue to mysql
 type DataAnalysis struct {
-	Height        int64                   `json:"height"`
-	Deals         []*backend.Deal         `json:"deals"`
-	FeeDetails    []*token.FeeDetail      `json:"fee_details"`
-	NewOrders     []*backend.Order        `json:"new_orders"`
-	UpdatedOrders []*backend.Order        `json:"updated_orders"`
-	Trans         []*backend.Transaction  `json:"trans"`
-	MatchResults  []*backend.MatchResult  `json:"match_results"`
-	DepthBook     keeper.BookRes          `json:"depth_book"`
-	AccStates     []token.AccountResponse `json:"account_states"`
-	SwapInfos     []*backend.SwapInfo     `json:"swap_infos"`
-	ClaimInfos    []*backend.ClaimInfo    `json:"swap_infos"`
+	Height         int64                    `json:"height"`
+	Deals          []*backend.Deal          `json:"deals"`
+	FeeDetails     []*token.FeeDetail       `json:"fee_details"`
+	NewOrders      []*backend.Order         `json:"new_orders"`
+	UpdatedOrders  []*backend.Order         `json:"updated_orders"`
+	Trans          []*backend.Transaction   `json:"trans"`
+	MatchResults   []*backend.MatchResult   `json:"match_results"`
+	DepthBook      keeper.BookRes           `json:"depth_book"`
+	AccStates      []token.AccountResponse  `json:"account_states"`
+	SwapInfos      []*backend.SwapInfo      `json:"swap_infos"`
+	ClaimInfos     []*backend.ClaimInfo     `json:"claim_infos"`
+	BalanceChanges []*backend.BalanceChange `json:"balance_changes"`
 }
 
 func (d *DataAnalysis) Empty() bool {
 	if len(d.Deals) == 0 && len(d.FeeDetails) == 0 && len(d.NewOrders) == 0 &&
 		len(d.UpdatedOrders) == 0 && len(d.Trans) == 0 && len(d.MatchResults) == 0 &&
 		len(d.DepthBook.Asks) == 0 && len(d.DepthBook.Bids) == 0 && len(d.AccStates) == 0 &&
-		len(d.SwapInfos) == 0 && len(d.ClaimInfos) == 0 {
+		len(d.SwapInfos) == 0 && len(d.ClaimInfos) == 0 && len(d.BalanceChanges) == 0 {
 		return true
 	}
 	return false
@@ -61,4 +62,6 @@ func (d *DataAnalysis) SetData(ctx sdk.Context, orderKeeper types.OrderKeeper,
 	d.Trans = cache.GetTransactions()
 	d.SwapInfos = cache.GetSwapInfos()
 	d.ClaimInfos = cache.GetClaimInfos()
+	d.BalanceChanges = backend.GenerateBalanceChanges(d.Height, ctx.BlockTime().Unix(), d.Trans, d.Deals,
+		d.SwapInfos, d.ClaimInfos, d.FeeDetails)
 }
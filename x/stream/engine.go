@@ -13,6 +13,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -36,6 +37,7 @@ const (
 	StreamPulsarKind    Kind = 0x03
 	StreamWebSocketKind Kind = 0x04
 	StreamKafkaKind     Kind = 0x05
+	StreamPostgresKind  Kind = 0x06
 
 	EngineNilKind       EngineKind = 0x00
 	EngineAnalysisKind  EngineKind = 0x01
@@ -46,6 +48,7 @@ const (
 
 var StreamKind2EngineKindMap = map[Kind]EngineKind{
 	StreamMysqlKind:     EngineAnalysisKind,
+	StreamPostgresKind:  EngineAnalysisKind,
 	StreamRedisKind:     EngineNotifyKind,
 	StreamPulsarKind:    EngineKlineKind,
 	StreamKafkaKind:     EngineKlineKind,
@@ -87,7 +90,7 @@ func (e *MySQLEngine) Write(data types.IStreamData, success *bool) {
 	}
 
 	results, err := e.orm.BatchInsertOrUpdate(enData.NewOrders, enData.UpdatedOrders, enData.Deals, enData.MatchResults,
-		enData.FeeDetails, enData.Trans, enData.SwapInfos, enData.ClaimInfos)
+		enData.FeeDetails, enData.Trans, enData.SwapInfos, enData.ClaimInfos, enData.BalanceChanges)
 	if err != nil {
 		e.logger.Error(fmt.Sprintf("MySqlEngine write failed: %s, results: %v", err.Error(), results))
 		*success = false
@@ -107,6 +110,54 @@ func (e *MySQLEngine) Write(data types.IStreamData, success *bool) {
 
 }
 
+type PostgresEngine struct {
+	url    string
+	logger log.Logger
+	orm    *backend.ORM
+}
+
+func NewPostgresEngine(url string, log log.Logger, cfg *appCfg.StreamConfig) (types.IStreamEngine, error) {
+	ormTmp := analyservice.NewPostgresORM(url)
+	log.Info("NewAnalysisService succeed")
+	// connect postgres through streamUrl
+	return &PostgresEngine{
+		url:    url,
+		logger: log,
+		orm:    ormTmp,
+	}, nil
+}
+
+func (e *PostgresEngine) URL() string {
+	return e.url
+}
+
+func (e *PostgresEngine) Write(data types.IStreamData, success *bool) {
+	e.logger.Debug("Entering PostgresEngine write")
+	enData, ok := data.(*analyservice.DataAnalysis)
+	if !ok {
+		panic(fmt.Sprintf("PostgresEngine Convert data %+v to DataAnalysis failed", data))
+	}
+
+	results, err := e.orm.BatchInsertOrUpdate(enData.NewOrders, enData.UpdatedOrders, enData.Deals, enData.MatchResults,
+		enData.FeeDetails, enData.Trans, enData.SwapInfos, enData.ClaimInfos, enData.BalanceChanges)
+	if err != nil {
+		e.logger.Error(fmt.Sprintf("PostgresEngine write failed: %s, results: %v", err.Error(), results))
+		*success = false
+
+		if pqerr, ok := err.(*pq.Error); ok {
+			e.logger.Error(fmt.Sprintf("PostgresError: %+v", err.Error()))
+			// duplicate key value violates unique constraint
+			if pqerr.Code == "23505" {
+				e.logger.Error(fmt.Sprintf("PostgresEngine write failed becoz 23505: %s, considered success, result: %+v", err.Error(), results))
+				*success = true
+			}
+		}
+	} else {
+		e.logger.Debug(fmt.Sprintf("PostgresEngine write result: %+v", results))
+		*success = true
+	}
+}
+
 type PulsarEngine struct {
 	url            string
 	logger         log.Logger
@@ -254,6 +305,7 @@ type EngineCreator func(url string, logger log.Logger, cfg *appCfg.StreamConfig)
 func GetEngineCreator(eKind EngineKind, sKind Kind) (EngineCreator, error) {
 	m := map[string]EngineCreator{
 		fmt.Sprintf("%d_%d", EngineAnalysisKind, StreamMysqlKind):      NewMySQLEngine,
+		fmt.Sprintf("%d_%d", EngineAnalysisKind, StreamPostgresKind):   NewPostgresEngine,
 		fmt.Sprintf("%d_%d", EngineNotifyKind, StreamRedisKind):        NewRedisEngine,
 		fmt.Sprintf("%d_%d", EngineKlineKind, StreamPulsarKind):        NewPulsarEngine,
 		fmt.Sprintf("%d_%d", EngineWebSocketKind, StreamWebSocketKind): websocket.NewEngine,
@@ -277,8 +329,8 @@ func ParseStreamEngineConfig(logger log.Logger, cfg *appCfg.StreamConfig) (map[E
 	for _, item := range list {
 		enginesConf := strings.Split(item, "|")
 
-		// Desktop Stream Engine Mode: mysql | websocket
-		// HA Stream Engine Mode: mysql | redis | pulsar(kafka)
+		// Desktop Stream Engine Mode: mysql(postgres) | websocket
+		// HA Stream Engine Mode: mysql(postgres) | redis | pulsar(kafka)
 
 		if len(enginesConf) != 3 {
 			return nil, fmt.Errorf("expected list in a form of \"engine_type:stream_type:stream_url\" pairs, given pair %s, list %s", item, list)
@@ -323,7 +375,11 @@ func StringToStreamKind(kind string) Kind {
 	kind = strings.ToLower(kind)
 	switch kind {
 	case "mysql":
+		EngineKind2StreamKindMap[EngineAnalysisKind] = StreamMysqlKind
 		return StreamMysqlKind
+	case "postgres":
+		EngineKind2StreamKindMap[EngineAnalysisKind] = StreamPostgresKind
+		return StreamPostgresKind
 	case "redis":
 		return StreamRedisKind
 	case "pulsar":
@@ -133,6 +133,12 @@ func TestStringToEngineKind(t *testing.T) {
 func TestStringToStreamKind(t *testing.T) {
 	kind := "Mysql"
 	require.Equal(t, StreamMysqlKind, StringToStreamKind(kind))
+	kind = "postgres"
+	require.Equal(t, StreamPostgresKind, StringToStreamKind(kind))
+	require.Equal(t, StreamPostgresKind, EngineKind2StreamKindMap[EngineAnalysisKind])
+	kind = "Mysql"
+	require.Equal(t, StreamMysqlKind, StringToStreamKind(kind))
+	require.Equal(t, StreamMysqlKind, EngineKind2StreamKindMap[EngineAnalysisKind])
 	kind = "redis"
 	require.Equal(t, StreamRedisKind, StringToStreamKind(kind))
 	kind = "pulsar"
@@ -0,0 +1,12 @@
+package types
+
+import (
+	govtypes "github.com/okex/okexchain/x/gov/types"
+)
+
+// the governance is allowed to manage the tokens owned by the governance module account
+func init() {
+	govtypes.RegisterExecutableMsg(MsgTransferOwnership{}, "okexchain/token/MsgTransferOwnership")
+	govtypes.RegisterExecutableMsg(MsgConfirmOwnership{}, "okexchain/token/MsgConfirmOwnership")
+	govtypes.RegisterExecutableMsg(MsgTokenModify{}, "okexchain/token/MsgModify")
+}